@@ -40,6 +40,22 @@ func (m *DecisionLogEntry_PlanResources) HashPB(hasher hash.Hash, ignore map[str
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *DecisionExplanation) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_audit_v1_DecisionExplanation_hashpb_sum(m, hasher, ignore)
+	}
+}
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *DecisionExplanation_Action) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_audit_v1_DecisionExplanation_Action_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *MetaValues) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -60,6 +60,64 @@ func cerbos_audit_v1_AccessLogEntry_hashpb_sum(m *AccessLogEntry, hasher hash.Ha
 	}
 }
 
+func cerbos_audit_v1_DecisionExplanation_Action_hashpb_sum(m *DecisionExplanation_Action, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.action"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Action))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Policy))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.message"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Message))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.condition"]; !ok {
+		if len(m.Condition) > 0 {
+			for _, v := range m.Condition {
+				if v != nil {
+					cerbos_engine_v1_Trace_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_audit_v1_DecisionExplanation_hashpb_sum(m *DecisionExplanation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.RequestId))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.resource_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.ResourceId))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				if v != nil {
+					cerbos_audit_v1_DecisionExplanation_Action_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs"]; !ok {
 		if len(m.Inputs) > 0 {
@@ -85,6 +143,20 @@ func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *DecisionLogEn
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
 
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.explanations"]; !ok {
+		if len(m.Explanations) > 0 {
+			for _, v := range m.Explanations {
+				if v != nil {
+					cerbos_audit_v1_DecisionExplanation_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.explanations_truncated"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExplanationsTruncated)))
+
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(m *DecisionLogEntry_PlanResources, hasher hash.Hash, ignore map[string]struct{}) {
@@ -163,6 +235,10 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *DecisionLogEntry, hasher has
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.break_glass"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.BreakGlass)))
+
+	}
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.metadata"]; !ok {
 		if len(m.Metadata) > 0 {
 			keys := make([]string, len(m.Metadata))
@@ -282,6 +358,16 @@ func cerbos_engine_v1_CheckOutput_ActionEffect_hashpb_sum(m *v1.CheckOutput_Acti
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.ActionEffect.remediation_hints"]; !ok {
+		if len(m.RemediationHints) > 0 {
+			for _, v := range m.RemediationHints {
+				if v != nil {
+					cerbos_engine_v1_RemediationHint_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v1.CheckOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -340,6 +426,22 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v1.CheckOutput, hasher hash.Hash
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.principal_scope_chain"]; !ok {
+		if len(m.PrincipalScopeChain) > 0 {
+			for _, v := range m.PrincipalScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.resource_scope_chain"]; !ok {
+		if len(m.ResourceScopeChain) > 0 {
+			for _, v := range m.ResourceScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v1.OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -559,6 +661,44 @@ func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ig
 	}
 }
 
+func cerbos_engine_v1_RemediationHint_hashpb_sum(m *v1.RemediationHint, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.roles"]; !ok {
+		if len(m.Roles) > 0 {
+			for _, v := range m.Roles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.derived_roles"]; !ok {
+		if len(m.DerivedRoles) > 0 {
+			for _, v := range m.DerivedRoles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Condition))
+
+	}
+}
+
+func cerbos_engine_v1_Resource_Ref_hashpb_sum(m *v1.Resource_Ref, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
@@ -595,6 +735,112 @@ func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, igno
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.parent"]; !ok {
+		if m.Parent != nil {
+			cerbos_engine_v1_Resource_Ref_hashpb_sum(m.Parent, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_engine_v1_Trace_Component_Variable_hashpb_sum(m *v1.Trace_Component_Variable, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Trace.Component.Variable.name"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Name))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Trace.Component.Variable.expr"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Expr))
+
+	}
+}
+
+func cerbos_engine_v1_Trace_Component_hashpb_sum(m *v1.Trace_Component, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Trace.Component.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Kind)))
+
+	}
+	if m.Details != nil {
+		if _, ok := ignore["cerbos.engine.v1.Trace.Component.details"]; !ok {
+			switch t := m.Details.(type) {
+			case *v1.Trace_Component_Action:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Action))
+
+			case *v1.Trace_Component_DerivedRole:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.DerivedRole))
+
+			case *v1.Trace_Component_Expr:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Expr))
+
+			case *v1.Trace_Component_Index:
+				_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(t.Index)))
+
+			case *v1.Trace_Component_Policy:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Policy))
+
+			case *v1.Trace_Component_Resource:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Resource))
+
+			case *v1.Trace_Component_Rule:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Rule))
+
+			case *v1.Trace_Component_Scope:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Scope))
+
+			case *v1.Trace_Component_Variable_:
+				if t.Variable != nil {
+					cerbos_engine_v1_Trace_Component_Variable_hashpb_sum(t.Variable, hasher, ignore)
+				}
+
+			case *v1.Trace_Component_Output:
+				_, _ = hasher.Write(protowire.AppendString(nil, t.Output))
+
+			}
+		}
+	}
+}
+
+func cerbos_engine_v1_Trace_Event_hashpb_sum(m *v1.Trace_Event, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Trace.Event.status"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Status)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Trace.Event.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Trace.Event.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Trace.Event.message"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Message))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Trace.Event.result"]; !ok {
+		if m.Result != nil {
+			google_protobuf_Value_hashpb_sum(m.Result, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_engine_v1_Trace_hashpb_sum(m *v1.Trace, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Trace.components"]; !ok {
+		if len(m.Components) > 0 {
+			for _, v := range m.Components {
+				if v != nil {
+					cerbos_engine_v1_Trace_Component_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.Trace.event"]; !ok {
+		if m.Event != nil {
+			cerbos_engine_v1_Trace_Event_hashpb_sum(m.Event, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_schema_v1_ValidationError_hashpb_sum(m *v11.ValidationError, hasher hash.Hash, ignore map[string]struct{}) {
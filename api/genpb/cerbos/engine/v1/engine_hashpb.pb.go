@@ -104,6 +104,14 @@ func (m *CheckOutput_ActionEffect) HashPB(hasher hash.Hash, ignore map[string]st
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *RemediationHint) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_engine_v1_RemediationHint_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *OutputEntry) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -120,6 +128,14 @@ func (m *Resource) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *Resource_Ref) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_engine_v1_Resource_Ref_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *Principal) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -84,6 +84,16 @@ func cerbos_engine_v1_CheckOutput_ActionEffect_hashpb_sum(m *CheckOutput_ActionE
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.ActionEffect.remediation_hints"]; !ok {
+		if len(m.RemediationHints) > 0 {
+			for _, v := range m.RemediationHints {
+				if v != nil {
+					cerbos_engine_v1_RemediationHint_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckOutput_hashpb_sum(m *CheckOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -142,6 +152,22 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *CheckOutput, hasher hash.Hash, i
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.principal_scope_chain"]; !ok {
+		if len(m.PrincipalScopeChain) > 0 {
+			for _, v := range m.PrincipalScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.resource_scope_chain"]; !ok {
+		if len(m.ResourceScopeChain) > 0 {
+			for _, v := range m.ResourceScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -406,6 +432,33 @@ func cerbos_engine_v1_Principal_hashpb_sum(m *Principal, hasher hash.Hash, ignor
 	}
 }
 
+func cerbos_engine_v1_RemediationHint_hashpb_sum(m *RemediationHint, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.roles"]; !ok {
+		if len(m.Roles) > 0 {
+			for _, v := range m.Roles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.derived_roles"]; !ok {
+		if len(m.DerivedRoles) > 0 {
+			for _, v := range m.DerivedRoles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Condition))
+
+	}
+}
+
 func cerbos_engine_v1_Request_Principal_hashpb_sum(m *Request_Principal, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Request.Principal.id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
@@ -491,6 +544,17 @@ func cerbos_engine_v1_Request_hashpb_sum(m *Request, hasher hash.Hash, ignore ma
 	}
 }
 
+func cerbos_engine_v1_Resource_Ref_hashpb_sum(m *Resource_Ref, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_engine_v1_Resource_hashpb_sum(m *Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
@@ -527,6 +591,12 @@ func cerbos_engine_v1_Resource_hashpb_sum(m *Resource, hasher hash.Hash, ignore
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.parent"]; !ok {
+		if m.Parent != nil {
+			cerbos_engine_v1_Resource_Ref_hashpb_sum(m.Parent, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_engine_v1_Runtime_hashpb_sum(m *Runtime, hasher hash.Hash, ignore map[string]struct{}) {
@@ -124,6 +124,17 @@ func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ig
 	}
 }
 
+func cerbos_engine_v1_Resource_Ref_hashpb_sum(m *v1.Resource_Ref, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
@@ -160,6 +171,12 @@ func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, igno
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.parent"]; !ok {
+		if m.Parent != nil {
+			cerbos_engine_v1_Resource_Ref_hashpb_sum(m.Parent, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_engine_v1_Trace_Component_Variable_hashpb_sum(m *v1.Trace_Component_Variable, hasher hash.Hash, ignore map[string]struct{}) {
@@ -324,6 +341,25 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *ExportVariables, hasher hash
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ExportVariables.rule_templates"]; !ok {
+		if len(m.RuleTemplates) > 0 {
+			keys := make([]string, len(m.RuleTemplates))
+			i := 0
+			for k := range m.RuleTemplates {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.RuleTemplates[k] != nil {
+					cerbos_policy_v1_RuleTemplate_hashpb_sum(m.RuleTemplates[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
@@ -597,6 +633,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *ResourcePolicy, hasher hash.H
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.templated_rules"]; !ok {
+		if len(m.TemplatedRules) > 0 {
+			for _, v := range m.TemplatedRules {
+				if v != nil {
+					cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -665,6 +711,64 @@ func cerbos_policy_v1_RoleDef_hashpb_sum(m *RoleDef, hasher hash.Hash, ignore ma
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.RoleDef.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(m *RuleTemplateInstance, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.template"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Template))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplate_hashpb_sum(m *RuleTemplate, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			for _, v := range m.Parameters {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.rule"]; !ok {
+		if m.Rule != nil {
+			cerbos_policy_v1_ResourceRule_hashpb_sum(m.Rule, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_IgnoreWhen_hashpb_sum(m *Schemas_IgnoreWhen, hasher hash.Hash, ignore map[string]struct{}) {
@@ -689,6 +793,10 @@ func cerbos_policy_v1_Schemas_Schema_hashpb_sum(m *Schemas_Schema, hasher hash.H
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Schemas.Schema.allow_additional_properties"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.AllowAdditionalProperties)))
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_hashpb_sum(m *Schemas, hasher hash.Hash, ignore map[string]struct{}) {
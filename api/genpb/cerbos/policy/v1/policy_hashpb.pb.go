@@ -32,6 +32,14 @@ func (m *ResourcePolicy) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *RuleTemplateInstance) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *ResourceRule) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -88,6 +96,14 @@ func (m *ExportVariables) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *RuleTemplate) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_policy_v1_RuleTemplate_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *Variables) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -88,6 +88,16 @@ func cerbos_engine_v1_CheckOutput_ActionEffect_hashpb_sum(m *v1.CheckOutput_Acti
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.ActionEffect.remediation_hints"]; !ok {
+		if len(m.RemediationHints) > 0 {
+			for _, v := range m.RemediationHints {
+				if v != nil {
+					cerbos_engine_v1_RemediationHint_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v1.CheckOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -146,6 +156,22 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v1.CheckOutput, hasher hash.Hash
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.principal_scope_chain"]; !ok {
+		if len(m.PrincipalScopeChain) > 0 {
+			for _, v := range m.PrincipalScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.resource_scope_chain"]; !ok {
+		if len(m.ResourceScopeChain) > 0 {
+			for _, v := range m.ResourceScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v1.OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -322,6 +348,33 @@ func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ig
 	}
 }
 
+func cerbos_engine_v1_RemediationHint_hashpb_sum(m *v1.RemediationHint, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.roles"]; !ok {
+		if len(m.Roles) > 0 {
+			for _, v := range m.Roles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.derived_roles"]; !ok {
+		if len(m.DerivedRoles) > 0 {
+			for _, v := range m.DerivedRoles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Condition))
+
+	}
+}
+
 func cerbos_engine_v1_Request_Principal_hashpb_sum(m *v1.Request_Principal, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Request.Principal.id"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
@@ -407,6 +460,17 @@ func cerbos_engine_v1_Request_hashpb_sum(m *v1.Request, hasher hash.Hash, ignore
 	}
 }
 
+func cerbos_engine_v1_Resource_Ref_hashpb_sum(m *v1.Resource_Ref, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
@@ -443,6 +507,12 @@ func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, igno
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.parent"]; !ok {
+		if m.Parent != nil {
+			cerbos_engine_v1_Resource_Ref_hashpb_sum(m.Parent, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_engine_v1_Trace_Component_Variable_hashpb_sum(m *v1.Trace_Component_Variable, hasher hash.Hash, ignore map[string]struct{}) {
@@ -607,6 +677,25 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *v11.ExportVariables, hasher
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ExportVariables.rule_templates"]; !ok {
+		if len(m.RuleTemplates) > 0 {
+			keys := make([]string, len(m.RuleTemplates))
+			i := 0
+			for k := range m.RuleTemplates {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.RuleTemplates[k] != nil {
+					cerbos_policy_v1_RuleTemplate_hashpb_sum(m.RuleTemplates[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *v11.Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
@@ -880,6 +969,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v11.ResourcePolicy, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.templated_rules"]; !ok {
+		if len(m.TemplatedRules) > 0 {
+			for _, v := range m.TemplatedRules {
+				if v != nil {
+					cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -948,6 +1047,64 @@ func cerbos_policy_v1_RoleDef_hashpb_sum(m *v11.RoleDef, hasher hash.Hash, ignor
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.RoleDef.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(m *v11.RuleTemplateInstance, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.template"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Template))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplate_hashpb_sum(m *v11.RuleTemplate, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			for _, v := range m.Parameters {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.rule"]; !ok {
+		if m.Rule != nil {
+			cerbos_policy_v1_ResourceRule_hashpb_sum(m.Rule, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_IgnoreWhen_hashpb_sum(m *v11.Schemas_IgnoreWhen, hasher hash.Hash, ignore map[string]struct{}) {
@@ -972,6 +1129,10 @@ func cerbos_policy_v1_Schemas_Schema_hashpb_sum(m *v11.Schemas_Schema, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Schemas.Schema.allow_additional_properties"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.AllowAdditionalProperties)))
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_hashpb_sum(m *v11.Schemas, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1979,6 +2140,21 @@ func cerbos_private_v1_ServerTestCase_PlaygroundEvaluateCall_hashpb_sum(m *Serve
 	}
 }
 
+func cerbos_private_v1_ServerTestCase_PlaygroundEvaluateExpressionCall_hashpb_sum(m *ServerTestCase_PlaygroundEvaluateExpressionCall, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.PlaygroundEvaluateExpressionCall.input"]; !ok {
+		if m.Input != nil {
+			cerbos_request_v1_PlaygroundEvaluateExpressionRequest_hashpb_sum(m.Input, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.private.v1.ServerTestCase.PlaygroundEvaluateExpressionCall.want_response"]; !ok {
+		if m.WantResponse != nil {
+			cerbos_response_v1_PlaygroundEvaluateExpressionResponse_hashpb_sum(m.WantResponse, hasher, ignore)
+		}
+
+	}
+}
+
 func cerbos_private_v1_ServerTestCase_PlaygroundProxyCall_hashpb_sum(m *ServerTestCase_PlaygroundProxyCall, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.private.v1.ServerTestCase.PlaygroundProxyCall.input"]; !ok {
 		if m.Input != nil {
@@ -2107,6 +2283,11 @@ func cerbos_private_v1_ServerTestCase_hashpb_sum(m *ServerTestCase, hasher hash.
 					cerbos_private_v1_ServerTestCase_CheckResourcesCall_hashpb_sum(t.CheckResources, hasher, ignore)
 				}
 
+			case *ServerTestCase_PlaygroundEvaluateExpression:
+				if t.PlaygroundEvaluateExpression != nil {
+					cerbos_private_v1_ServerTestCase_PlaygroundEvaluateExpressionCall_hashpb_sum(t.PlaygroundEvaluateExpression, hasher, ignore)
+				}
+
 			}
 		}
 	}
@@ -2364,6 +2545,16 @@ func cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m *v12.CheckResourcesReq
 		}
 
 	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.policy_overlay"]; !ok {
+		if len(m.PolicyOverlay) > 0 {
+			for _, v := range m.PolicyOverlay {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_request_v1_File_hashpb_sum(m *v12.File, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2408,6 +2599,58 @@ func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *v12.PlanResourcesReque
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.policy_overlay"]; !ok {
+		if len(m.PolicyOverlay) > 0 {
+			for _, v := range m.PolicyOverlay {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlaygroundEvaluateExpressionRequest_hashpb_sum(m *v12.PlaygroundEvaluateExpressionRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.playground_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PlaygroundId))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.expr"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Expr))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.principal"]; !ok {
+		if m.Principal != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.Principal, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.resource"]; !ok {
+		if m.Resource != nil {
+			cerbos_engine_v1_Resource_hashpb_sum(m.Resource, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.globals"]; !ok {
+		if len(m.Globals) > 0 {
+			keys := make([]string, len(m.Globals))
+			i := 0
+			for k := range m.Globals {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.Globals[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Globals[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_request_v1_PlaygroundEvaluateRequest_hashpb_sum(m *v12.PlaygroundEvaluateRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2791,6 +3034,35 @@ func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Meta_hashpb_sum(m *v1
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.principal_scope_chain"]; !ok {
+		if len(m.PrincipalScopeChain) > 0 {
+			for _, v := range m.PrincipalScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.resource_scope_chain"]; !ok {
+		if len(m.ResourceScopeChain) > 0 {
+			for _, v := range m.ResourceScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Remediation_hashpb_sum(m *v13.CheckResourcesResponse_ResultEntry_Remediation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Remediation.hints"]; !ok {
+		if len(m.Hints) > 0 {
+			for _, v := range m.Hints {
+				if v != nil {
+					cerbos_engine_v1_RemediationHint_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Resource_hashpb_sum(m *v13.CheckResourcesResponse_ResultEntry_Resource, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2862,6 +3134,25 @@ func cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m *v13.Che
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.remediation"]; !ok {
+		if len(m.Remediation) > 0 {
+			keys := make([]string, len(m.Remediation))
+			i := 0
+			for k := range m.Remediation {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.Remediation[k] != nil {
+					cerbos_response_v1_CheckResourcesResponse_ResultEntry_Remediation_hashpb_sum(m.Remediation[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *v13.CheckResourcesResponse, hasher hash.Hash, ignore map[string]struct{}) {
@@ -2933,6 +3224,29 @@ func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *v13.PlanResourcesRes
 	}
 }
 
+func cerbos_response_v1_PlaygroundEvaluateExpressionResponse_hashpb_sum(m *v13.PlaygroundEvaluateExpressionResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlaygroundEvaluateExpressionResponse.playground_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PlaygroundId))
+
+	}
+	if m.Outcome != nil {
+		if _, ok := ignore["cerbos.response.v1.PlaygroundEvaluateExpressionResponse.outcome"]; !ok {
+			switch t := m.Outcome.(type) {
+			case *v13.PlaygroundEvaluateExpressionResponse_Failure:
+				if t.Failure != nil {
+					cerbos_response_v1_PlaygroundFailure_hashpb_sum(t.Failure, hasher, ignore)
+				}
+
+			case *v13.PlaygroundEvaluateExpressionResponse_Success:
+				if t.Success != nil {
+					google_protobuf_Value_hashpb_sum(t.Success, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_PlaygroundEvaluateResponse_EvalResultList_hashpb_sum(m *v13.PlaygroundEvaluateResponse_EvalResultList, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.results"]; !ok {
 		if len(m.Results) > 0 {
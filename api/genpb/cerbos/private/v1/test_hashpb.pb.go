@@ -80,6 +80,14 @@ func (m *ServerTestCase_PlaygroundEvaluateCall) HashPB(hasher hash.Hash, ignore
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *ServerTestCase_PlaygroundEvaluateExpressionCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_private_v1_ServerTestCase_PlaygroundEvaluateExpressionCall_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *ServerTestCase_PlaygroundProxyCall) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -93,6 +93,17 @@ func cerbos_engine_v1_Principal_hashpb_sum(m *v1.Principal, hasher hash.Hash, ig
 	}
 }
 
+func cerbos_engine_v1_Resource_Ref_hashpb_sum(m *v1.Resource_Ref, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
@@ -129,6 +140,12 @@ func cerbos_engine_v1_Resource_hashpb_sum(m *v1.Resource, hasher hash.Hash, igno
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.parent"]; !ok {
+		if m.Parent != nil {
+			cerbos_engine_v1_Resource_Ref_hashpb_sum(m.Parent, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Condition_hashpb_sum(m *v11.Condition, hasher hash.Hash, ignore map[string]struct{}) {
@@ -193,6 +210,25 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *v11.ExportVariables, hasher
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ExportVariables.rule_templates"]; !ok {
+		if len(m.RuleTemplates) > 0 {
+			keys := make([]string, len(m.RuleTemplates))
+			i := 0
+			for k := range m.RuleTemplates {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.RuleTemplates[k] != nil {
+					cerbos_policy_v1_RuleTemplate_hashpb_sum(m.RuleTemplates[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *v11.Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
@@ -466,6 +502,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v11.ResourcePolicy, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.templated_rules"]; !ok {
+		if len(m.TemplatedRules) > 0 {
+			for _, v := range m.TemplatedRules {
+				if v != nil {
+					cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v11.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -534,6 +580,64 @@ func cerbos_policy_v1_RoleDef_hashpb_sum(m *v11.RoleDef, hasher hash.Hash, ignor
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.RoleDef.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(m *v11.RuleTemplateInstance, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.template"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Template))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplate_hashpb_sum(m *v11.RuleTemplate, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			for _, v := range m.Parameters {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.rule"]; !ok {
+		if m.Rule != nil {
+			cerbos_policy_v1_ResourceRule_hashpb_sum(m.Rule, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_IgnoreWhen_hashpb_sum(m *v11.Schemas_IgnoreWhen, hasher hash.Hash, ignore map[string]struct{}) {
@@ -558,6 +662,10 @@ func cerbos_policy_v1_Schemas_Schema_hashpb_sum(m *v11.Schemas_Schema, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Schemas.Schema.allow_additional_properties"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.AllowAdditionalProperties)))
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_hashpb_sum(m *v11.Schemas, hasher hash.Hash, ignore map[string]struct{}) {
@@ -802,6 +910,16 @@ func cerbos_request_v1_CheckResourcesRequest_hashpb_sum(m *CheckResourcesRequest
 		}
 
 	}
+	if _, ok := ignore["cerbos.request.v1.CheckResourcesRequest.policy_overlay"]; !ok {
+		if len(m.PolicyOverlay) > 0 {
+			for _, v := range m.PolicyOverlay {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_request_v1_DeleteSchemaRequest_hashpb_sum(m *DeleteSchemaRequest, hasher hash.Hash, ignore map[string]struct{}) {
@@ -848,6 +966,9 @@ func cerbos_request_v1_File_hashpb_sum(m *File, hasher hash.Hash, ignore map[str
 	}
 }
 
+func cerbos_request_v1_GetCompilationDiagnosticsRequest_hashpb_sum(m *GetCompilationDiagnosticsRequest, hasher hash.Hash, ignore map[string]struct{}) {
+}
+
 func cerbos_request_v1_GetPolicyRequest_hashpb_sum(m *GetPolicyRequest, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.request.v1.GetPolicyRequest.id"]; !ok {
 		if len(m.Id) > 0 {
@@ -933,6 +1054,13 @@ func cerbos_request_v1_ListPoliciesRequest_hashpb_sum(m *ListPoliciesRequest, ha
 	}
 }
 
+func cerbos_request_v1_ListSchemaReferencesRequest_hashpb_sum(m *ListSchemaReferencesRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.ListSchemaReferencesRequest.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_request_v1_ListSchemasRequest_hashpb_sum(m *ListSchemasRequest, hasher hash.Hash, ignore map[string]struct{}) {
 }
 
@@ -967,6 +1095,58 @@ func cerbos_request_v1_PlanResourcesRequest_hashpb_sum(m *PlanResourcesRequest,
 		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.IncludeMeta)))
 
 	}
+	if _, ok := ignore["cerbos.request.v1.PlanResourcesRequest.policy_overlay"]; !ok {
+		if len(m.PolicyOverlay) > 0 {
+			for _, v := range m.PolicyOverlay {
+				if v != nil {
+					cerbos_policy_v1_Policy_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_request_v1_PlaygroundEvaluateExpressionRequest_hashpb_sum(m *PlaygroundEvaluateExpressionRequest, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.playground_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PlaygroundId))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.expr"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Expr))
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.principal"]; !ok {
+		if m.Principal != nil {
+			cerbos_engine_v1_Principal_hashpb_sum(m.Principal, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.resource"]; !ok {
+		if m.Resource != nil {
+			cerbos_engine_v1_Resource_hashpb_sum(m.Resource, hasher, ignore)
+		}
+
+	}
+	if _, ok := ignore["cerbos.request.v1.PlaygroundEvaluateExpressionRequest.globals"]; !ok {
+		if len(m.Globals) > 0 {
+			keys := make([]string, len(m.Globals))
+			i := 0
+			for k := range m.Globals {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.Globals[k] != nil {
+					google_protobuf_Value_hashpb_sum(m.Globals[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_request_v1_PlaygroundEvaluateRequest_hashpb_sum(m *PlaygroundEvaluateRequest, hasher hash.Hash, ignore map[string]struct{}) {
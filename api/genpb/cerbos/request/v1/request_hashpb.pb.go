@@ -120,6 +120,14 @@ func (m *PlaygroundEvaluateRequest) HashPB(hasher hash.Hash, ignore map[string]s
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *PlaygroundEvaluateExpressionRequest) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_request_v1_PlaygroundEvaluateExpressionRequest_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *PlaygroundProxyRequest) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -208,6 +216,14 @@ func (m *DeleteSchemaRequest) HashPB(hasher hash.Hash, ignore map[string]struct{
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *ListSchemaReferencesRequest) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_request_v1_ListSchemaReferencesRequest_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *ReloadStoreRequest) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
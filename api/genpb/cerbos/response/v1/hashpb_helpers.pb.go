@@ -64,6 +64,64 @@ func cerbos_audit_v1_AccessLogEntry_hashpb_sum(m *v1.AccessLogEntry, hasher hash
 	}
 }
 
+func cerbos_audit_v1_DecisionExplanation_Action_hashpb_sum(m *v1.DecisionExplanation_Action, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.action"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Action))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.effect"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Effect)))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.policy"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Policy))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.scope"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.message"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Message))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.Action.condition"]; !ok {
+		if len(m.Condition) > 0 {
+			for _, v := range m.Condition {
+				if v != nil {
+					cerbos_engine_v1_Trace_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
+func cerbos_audit_v1_DecisionExplanation_hashpb_sum(m *v1.DecisionExplanation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.request_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.RequestId))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.resource_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.ResourceId))
+
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionExplanation.actions"]; !ok {
+		if len(m.Actions) > 0 {
+			for _, v := range m.Actions {
+				if v != nil {
+					cerbos_audit_v1_DecisionExplanation_Action_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *v1.DecisionLogEntry_CheckResources, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.inputs"]; !ok {
 		if len(m.Inputs) > 0 {
@@ -89,6 +147,20 @@ func cerbos_audit_v1_DecisionLogEntry_CheckResources_hashpb_sum(m *v1.DecisionLo
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
 
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.explanations"]; !ok {
+		if len(m.Explanations) > 0 {
+			for _, v := range m.Explanations {
+				if v != nil {
+					cerbos_audit_v1_DecisionExplanation_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.CheckResources.explanations_truncated"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.ExplanationsTruncated)))
+
+	}
 }
 
 func cerbos_audit_v1_DecisionLogEntry_PlanResources_hashpb_sum(m *v1.DecisionLogEntry_PlanResources, hasher hash.Hash, ignore map[string]struct{}) {
@@ -167,6 +239,10 @@ func cerbos_audit_v1_DecisionLogEntry_hashpb_sum(m *v1.DecisionLogEntry, hasher
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.break_glass"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.BreakGlass)))
+
+	}
 	if _, ok := ignore["cerbos.audit.v1.DecisionLogEntry.metadata"]; !ok {
 		if len(m.Metadata) > 0 {
 			keys := make([]string, len(m.Metadata))
@@ -286,6 +362,16 @@ func cerbos_engine_v1_CheckOutput_ActionEffect_hashpb_sum(m *v11.CheckOutput_Act
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.ActionEffect.remediation_hints"]; !ok {
+		if len(m.RemediationHints) > 0 {
+			for _, v := range m.RemediationHints {
+				if v != nil {
+					cerbos_engine_v1_RemediationHint_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v11.CheckOutput, hasher hash.Hash, ignore map[string]struct{}) {
@@ -344,6 +430,22 @@ func cerbos_engine_v1_CheckOutput_hashpb_sum(m *v11.CheckOutput, hasher hash.Has
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.principal_scope_chain"]; !ok {
+		if len(m.PrincipalScopeChain) > 0 {
+			for _, v := range m.PrincipalScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.CheckOutput.resource_scope_chain"]; !ok {
+		if len(m.ResourceScopeChain) > 0 {
+			for _, v := range m.ResourceScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
 }
 
 func cerbos_engine_v1_OutputEntry_hashpb_sum(m *v11.OutputEntry, hasher hash.Hash, ignore map[string]struct{}) {
@@ -563,6 +665,44 @@ func cerbos_engine_v1_Principal_hashpb_sum(m *v11.Principal, hasher hash.Hash, i
 	}
 }
 
+func cerbos_engine_v1_RemediationHint_hashpb_sum(m *v11.RemediationHint, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.rule"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Rule))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.roles"]; !ok {
+		if len(m.Roles) > 0 {
+			for _, v := range m.Roles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.derived_roles"]; !ok {
+		if len(m.DerivedRoles) > 0 {
+			for _, v := range m.DerivedRoles {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.engine.v1.RemediationHint.condition"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Condition))
+
+	}
+}
+
+func cerbos_engine_v1_Resource_Ref_hashpb_sum(m *v11.Resource_Ref, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.kind"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
+
+	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.Ref.id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Id))
+
+	}
+}
+
 func cerbos_engine_v1_Resource_hashpb_sum(m *v11.Resource, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.engine.v1.Resource.kind"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Kind))
@@ -599,6 +739,12 @@ func cerbos_engine_v1_Resource_hashpb_sum(m *v11.Resource, hasher hash.Hash, ign
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Scope))
 
 	}
+	if _, ok := ignore["cerbos.engine.v1.Resource.parent"]; !ok {
+		if m.Parent != nil {
+			cerbos_engine_v1_Resource_Ref_hashpb_sum(m.Parent, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_engine_v1_Trace_Component_Variable_hashpb_sum(m *v11.Trace_Component_Variable, hasher hash.Hash, ignore map[string]struct{}) {
@@ -763,6 +909,25 @@ func cerbos_policy_v1_ExportVariables_hashpb_sum(m *v12.ExportVariables, hasher
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.policy.v1.ExportVariables.rule_templates"]; !ok {
+		if len(m.RuleTemplates) > 0 {
+			keys := make([]string, len(m.RuleTemplates))
+			i := 0
+			for k := range m.RuleTemplates {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.RuleTemplates[k] != nil {
+					cerbos_policy_v1_RuleTemplate_hashpb_sum(m.RuleTemplates[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_Match_ExprList_hashpb_sum(m *v12.Match_ExprList, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1036,6 +1201,16 @@ func cerbos_policy_v1_ResourcePolicy_hashpb_sum(m *v12.ResourcePolicy, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.ResourcePolicy.templated_rules"]; !ok {
+		if len(m.TemplatedRules) > 0 {
+			for _, v := range m.TemplatedRules {
+				if v != nil {
+					cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_policy_v1_ResourceRule_hashpb_sum(m *v12.ResourceRule, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1104,6 +1279,64 @@ func cerbos_policy_v1_RoleDef_hashpb_sum(m *v12.RoleDef, hasher hash.Hash, ignor
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.RoleDef.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplateInstance_hashpb_sum(m *v12.RuleTemplateInstance, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.template"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Template))
+
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplateInstance.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			keys := make([]string, len(m.Parameters))
+			i := 0
+			for k := range m.Parameters {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				_, _ = hasher.Write(protowire.AppendString(nil, m.Parameters[k]))
+
+			}
+		}
+	}
+}
+
+func cerbos_policy_v1_RuleTemplate_hashpb_sum(m *v12.RuleTemplate, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.parameters"]; !ok {
+		if len(m.Parameters) > 0 {
+			for _, v := range m.Parameters {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.policy.v1.RuleTemplate.rule"]; !ok {
+		if m.Rule != nil {
+			cerbos_policy_v1_ResourceRule_hashpb_sum(m.Rule, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_IgnoreWhen_hashpb_sum(m *v12.Schemas_IgnoreWhen, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1128,6 +1361,10 @@ func cerbos_policy_v1_Schemas_Schema_hashpb_sum(m *v12.Schemas_Schema, hasher ha
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Schemas.Schema.allow_additional_properties"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.AllowAdditionalProperties)))
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_hashpb_sum(m *v12.Schemas, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1659,6 +1896,35 @@ func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Meta_hashpb_sum(m *Ch
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.principal_scope_chain"]; !ok {
+		if len(m.PrincipalScopeChain) > 0 {
+			for _, v := range m.PrincipalScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Meta.resource_scope_chain"]; !ok {
+		if len(m.ResourceScopeChain) > 0 {
+			for _, v := range m.ResourceScopeChain {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+}
+
+func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Remediation_hashpb_sum(m *CheckResourcesResponse_ResultEntry_Remediation, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.Remediation.hints"]; !ok {
+		if len(m.Hints) > 0 {
+			for _, v := range m.Hints {
+				if v != nil {
+					cerbos_engine_v1_RemediationHint_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_response_v1_CheckResourcesResponse_ResultEntry_Resource_hashpb_sum(m *CheckResourcesResponse_ResultEntry_Resource, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1730,6 +1996,25 @@ func cerbos_response_v1_CheckResourcesResponse_ResultEntry_hashpb_sum(m *CheckRe
 			}
 		}
 	}
+	if _, ok := ignore["cerbos.response.v1.CheckResourcesResponse.ResultEntry.remediation"]; !ok {
+		if len(m.Remediation) > 0 {
+			keys := make([]string, len(m.Remediation))
+			i := 0
+			for k := range m.Remediation {
+				keys[i] = k
+				i++
+			}
+
+			sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+			for _, k := range keys {
+				if m.Remediation[k] != nil {
+					cerbos_response_v1_CheckResourcesResponse_ResultEntry_Remediation_hashpb_sum(m.Remediation[k], hasher, ignore)
+				}
+
+			}
+		}
+	}
 }
 
 func cerbos_response_v1_CheckResourcesResponse_hashpb_sum(m *CheckResourcesResponse, hasher hash.Hash, ignore map[string]struct{}) {
@@ -1770,6 +2055,55 @@ func cerbos_response_v1_EnablePolicyResponse_hashpb_sum(m *EnablePolicyResponse,
 	}
 }
 
+func cerbos_response_v1_GetCompilationDiagnosticsResponse_Diagnostic_Position_hashpb_sum(m *GetCompilationDiagnosticsResponse_Diagnostic_Position, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.Position.line"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Line)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.Position.column"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Column)))
+
+	}
+}
+
+func cerbos_response_v1_GetCompilationDiagnosticsResponse_Diagnostic_hashpb_sum(m *GetCompilationDiagnosticsResponse_Diagnostic, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.file"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.File))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.severity"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Severity)))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.error"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Error))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.description"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.Description))
+
+	}
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.Diagnostic.position"]; !ok {
+		if m.Position != nil {
+			cerbos_response_v1_GetCompilationDiagnosticsResponse_Diagnostic_Position_hashpb_sum(m.Position, hasher, ignore)
+		}
+
+	}
+}
+
+func cerbos_response_v1_GetCompilationDiagnosticsResponse_hashpb_sum(m *GetCompilationDiagnosticsResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.GetCompilationDiagnosticsResponse.diagnostics"]; !ok {
+		if len(m.Diagnostics) > 0 {
+			for _, v := range m.Diagnostics {
+				if v != nil {
+					cerbos_response_v1_GetCompilationDiagnosticsResponse_Diagnostic_hashpb_sum(v, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_GetPolicyResponse_hashpb_sum(m *GetPolicyResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.GetPolicyResponse.policies"]; !ok {
 		if len(m.Policies) > 0 {
@@ -1826,6 +2160,17 @@ func cerbos_response_v1_ListPoliciesResponse_hashpb_sum(m *ListPoliciesResponse,
 	}
 }
 
+func cerbos_response_v1_ListSchemaReferencesResponse_hashpb_sum(m *ListSchemaReferencesResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.ListSchemaReferencesResponse.policy_ids"]; !ok {
+		if len(m.PolicyIds) > 0 {
+			for _, v := range m.PolicyIds {
+				_, _ = hasher.Write(protowire.AppendString(nil, v))
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_ListSchemasResponse_hashpb_sum(m *ListSchemasResponse, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.ListSchemasResponse.schema_ids"]; !ok {
 		if len(m.SchemaIds) > 0 {
@@ -1889,6 +2234,29 @@ func cerbos_response_v1_PlanResourcesResponse_hashpb_sum(m *PlanResourcesRespons
 	}
 }
 
+func cerbos_response_v1_PlaygroundEvaluateExpressionResponse_hashpb_sum(m *PlaygroundEvaluateExpressionResponse, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.response.v1.PlaygroundEvaluateExpressionResponse.playground_id"]; !ok {
+		_, _ = hasher.Write(protowire.AppendString(nil, m.PlaygroundId))
+
+	}
+	if m.Outcome != nil {
+		if _, ok := ignore["cerbos.response.v1.PlaygroundEvaluateExpressionResponse.outcome"]; !ok {
+			switch t := m.Outcome.(type) {
+			case *PlaygroundEvaluateExpressionResponse_Failure:
+				if t.Failure != nil {
+					cerbos_response_v1_PlaygroundFailure_hashpb_sum(t.Failure, hasher, ignore)
+				}
+
+			case *PlaygroundEvaluateExpressionResponse_Success:
+				if t.Success != nil {
+					google_protobuf_Value_hashpb_sum(t.Success, hasher, ignore)
+				}
+
+			}
+		}
+	}
+}
+
 func cerbos_response_v1_PlaygroundEvaluateResponse_EvalResultList_hashpb_sum(m *PlaygroundEvaluateResponse_EvalResultList, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.response.v1.PlaygroundEvaluateResponse.EvalResultList.results"]; !ok {
 		if len(m.Results) > 0 {
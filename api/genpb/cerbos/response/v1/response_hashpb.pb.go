@@ -120,6 +120,14 @@ func (m *CheckResourcesResponse_ResultEntry_Meta_EffectMeta) HashPB(hasher hash.
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *CheckResourcesResponse_ResultEntry_Remediation) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_response_v1_CheckResourcesResponse_ResultEntry_Remediation_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *PlaygroundFailure) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -184,6 +192,14 @@ func (m *PlaygroundEvaluateResponse_EvalResultList) HashPB(hasher hash.Hash, ign
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *PlaygroundEvaluateExpressionResponse) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_response_v1_PlaygroundEvaluateExpressionResponse_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *PlaygroundProxyResponse) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -271,3 +287,35 @@ func (m *DeleteSchemaResponse) HashPB(hasher hash.Hash, ignore map[string]struct
 		cerbos_response_v1_DeleteSchemaResponse_hashpb_sum(m, hasher, ignore)
 	}
 }
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *ListSchemaReferencesResponse) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_response_v1_ListSchemaReferencesResponse_hashpb_sum(m, hasher, ignore)
+	}
+}
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *GetCompilationDiagnosticsResponse) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_response_v1_GetCompilationDiagnosticsResponse_hashpb_sum(m, hasher, ignore)
+	}
+}
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *GetCompilationDiagnosticsResponse_Diagnostic) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_response_v1_GetCompilationDiagnosticsResponse_Diagnostic_hashpb_sum(m, hasher, ignore)
+	}
+}
+
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *GetCompilationDiagnosticsResponse_Diagnostic_Position) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_response_v1_GetCompilationDiagnosticsResponse_Diagnostic_Position_hashpb_sum(m, hasher, ignore)
+	}
+}
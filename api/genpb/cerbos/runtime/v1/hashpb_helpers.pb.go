@@ -37,6 +37,10 @@ func cerbos_policy_v1_Schemas_Schema_hashpb_sum(m *v1.Schemas_Schema, hasher has
 		}
 
 	}
+	if _, ok := ignore["cerbos.policy.v1.Schemas.Schema.allow_additional_properties"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, protowire.EncodeBool(m.AllowAdditionalProperties)))
+
+	}
 }
 
 func cerbos_policy_v1_Schemas_hashpb_sum(m *v1.Schemas, hasher hash.Hash, ignore map[string]struct{}) {
@@ -54,6 +58,17 @@ func cerbos_policy_v1_Schemas_hashpb_sum(m *v1.Schemas, hasher hash.Hash, ignore
 	}
 }
 
+func cerbos_runtime_v1_CompileErrors_Err_Position_hashpb_sum(m *CompileErrors_Err_Position, hasher hash.Hash, ignore map[string]struct{}) {
+	if _, ok := ignore["cerbos.runtime.v1.CompileErrors.Err.Position.line"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Line)))
+
+	}
+	if _, ok := ignore["cerbos.runtime.v1.CompileErrors.Err.Position.column"]; !ok {
+		_, _ = hasher.Write(protowire.AppendVarint(nil, uint64(m.Column)))
+
+	}
+}
+
 func cerbos_runtime_v1_CompileErrors_Err_hashpb_sum(m *CompileErrors_Err, hasher hash.Hash, ignore map[string]struct{}) {
 	if _, ok := ignore["cerbos.runtime.v1.CompileErrors.Err.file"]; !ok {
 		_, _ = hasher.Write(protowire.AppendString(nil, m.File))
@@ -67,6 +82,12 @@ func cerbos_runtime_v1_CompileErrors_Err_hashpb_sum(m *CompileErrors_Err, hasher
 		_, _ = hasher.Write(protowire.AppendString(nil, m.Description))
 
 	}
+	if _, ok := ignore["cerbos.runtime.v1.CompileErrors.Err.position"]; !ok {
+		if m.Position != nil {
+			cerbos_runtime_v1_CompileErrors_Err_Position_hashpb_sum(m.Position, hasher, ignore)
+		}
+
+	}
 }
 
 func cerbos_runtime_v1_CompileErrors_hashpb_sum(m *CompileErrors, hasher hash.Hash, ignore map[string]struct{}) {
@@ -176,6 +176,14 @@ func (m *CompileErrors_Err) HashPB(hasher hash.Hash, ignore map[string]struct{})
 	}
 }
 
+// HashPB computes a hash of the message using the given hash function
+// The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
+func (m *CompileErrors_Err_Position) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
+	if m != nil {
+		cerbos_runtime_v1_CompileErrors_Err_Position_hashpb_sum(m, hasher, ignore)
+	}
+}
+
 // HashPB computes a hash of the message using the given hash function
 // The ignore set must contain fully-qualified field names (pkg.msg.field) that should be ignored from the hash
 func (m *IndexBuildErrors) HashPB(hasher hash.Hash, ignore map[string]struct{}) {
@@ -129,7 +129,7 @@ var file_cerbos_svc_v1_svc_proto_rawDesc = []byte{
 	0x6c, 0x61, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x21, 0x92,
 	0x41, 0x1e, 0x12, 0x1c, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x20, 0x50, 0x6f, 0x6c, 0x69, 0x63,
 	0x79, 0x20, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x32, 0xc5, 0x0f, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x32, 0xfa, 0x12, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc9, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4f,
 	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e,
 	0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76,
@@ -241,116 +241,162 @@ var file_cerbos_svc_v1_svc_proto_rawDesc = []byte{
 	0x12, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x62,
 	0x0f, 0x0a, 0x0d, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x9c, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x92, 0x41, 0x1f, 0x12, 0x0c, 0x52, 0x65, 0x6c, 0x6f,
-	0x61, 0x64, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x0f, 0x0a, 0x0d, 0x0a, 0x09, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
-	0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x72, 0x65,
-	0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x22, 0x92, 0x41, 0x1f, 0x12, 0x1d, 0x43, 0x65, 0x72, 0x62, 0x6f,
-	0x73, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0xf7, 0x04, 0x0a, 0x17, 0x43, 0x65, 0x72,
-	0x62, 0x6f, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x65, 0x72, 0x62,
-	0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x87,
-	0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x65, 0x73,
-	0x74, 0x12, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a,
-	0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61,
-	0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12,
-	0x2c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0xd2, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2e,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x58, 0x92, 0x41, 0x35, 0x12, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x20, 0x61, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x62, 0x0f, 0x0a, 0x0d, 0x0a, 0x09, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
+	0x12, 0x18, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xdd, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x33, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
 	0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x29, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x92, 0x41, 0x2e, 0x12, 0x1b, 0x47, 0x65, 0x74, 0x20, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x62, 0x0f, 0x0a, 0x0d, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0b, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x92, 0x41, 0x1f, 0x12,
+	0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x0f, 0x0a,
+	0x0d, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x22, 0x92, 0x41, 0x1f, 0x12, 0x1d,
+	0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0xba, 0x06,
+	0x0a, 0x17, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x12, 0x50, 0x6c,
+	0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x2c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e,
 	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x1a, 0x10, 0xfa, 0xd2, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
-	0x41, 0x4c, 0x42, 0xe1, 0x01, 0x92, 0x41, 0x7b, 0x12, 0x3f, 0x0a, 0x06, 0x43, 0x65, 0x72, 0x62,
-	0x6f, 0x73, 0x22, 0x2d, 0x0a, 0x06, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x12, 0x12, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x64, 0x65, 0x76,
-	0x1a, 0x0f, 0x69, 0x6e, 0x66, 0x6f, 0x40, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x64, 0x65,
-	0x76, 0x32, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2a, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5a, 0x11, 0x0a, 0x0f, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12,
-	0x02, 0x08, 0x01, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x76, 0x63, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2f, 0x63, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x62, 0x2f, 0x63,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x2f, 0x73, 0x76, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x76, 0x63,
-	0x76, 0x31, 0xaa, 0x02, 0x11, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x2e,
-	0x56, 0x31, 0x2e, 0x53, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c,
+	0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x12, 0x97, 0x01,
+	0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0xc0, 0x01, 0x0a, 0x1c, 0x50, 0x6c, 0x61, 0x79,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x38, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x79,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x5f,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x8b, 0x01, 0x0a, 0x0f, 0x50,
+	0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x29,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01,
+	0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x1a, 0x10, 0xfa, 0xd2, 0xe4, 0x93, 0x02, 0x0a,
+	0x12, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x42, 0xe1, 0x01, 0x92, 0x41, 0x7b,
+	0x12, 0x3f, 0x0a, 0x06, 0x43, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x22, 0x2d, 0x0a, 0x06, 0x43, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x12, 0x12, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x63, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x1a, 0x0f, 0x69, 0x6e, 0x66, 0x6f, 0x40, 0x63,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x32, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x2a, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x11, 0x0a, 0x0f, 0x0a, 0x09, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x02, 0x08, 0x01, 0x0a, 0x15, 0x64, 0x65, 0x76,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x76, 0x63, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x2f, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x65, 0x6e, 0x70, 0x62, 0x2f, 0x63, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x2f, 0x73, 0x76,
+	0x63, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x76, 0x63, 0x76, 0x31, 0xaa, 0x02, 0x11, 0x43, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0x2e, 0x53, 0x76, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_cerbos_svc_v1_svc_proto_goTypes = []interface{}{
-	(*v1.CheckResourceSetRequest)(nil),      // 0: cerbos.request.v1.CheckResourceSetRequest
-	(*v1.CheckResourceBatchRequest)(nil),    // 1: cerbos.request.v1.CheckResourceBatchRequest
-	(*v1.CheckResourcesRequest)(nil),        // 2: cerbos.request.v1.CheckResourcesRequest
-	(*v1.ServerInfoRequest)(nil),            // 3: cerbos.request.v1.ServerInfoRequest
-	(*v1.PlanResourcesRequest)(nil),         // 4: cerbos.request.v1.PlanResourcesRequest
-	(*v1.AddOrUpdatePolicyRequest)(nil),     // 5: cerbos.request.v1.AddOrUpdatePolicyRequest
-	(*v1.ListPoliciesRequest)(nil),          // 6: cerbos.request.v1.ListPoliciesRequest
-	(*v1.GetPolicyRequest)(nil),             // 7: cerbos.request.v1.GetPolicyRequest
-	(*v1.DisablePolicyRequest)(nil),         // 8: cerbos.request.v1.DisablePolicyRequest
-	(*v1.EnablePolicyRequest)(nil),          // 9: cerbos.request.v1.EnablePolicyRequest
-	(*v1.ListAuditLogEntriesRequest)(nil),   // 10: cerbos.request.v1.ListAuditLogEntriesRequest
-	(*v1.AddOrUpdateSchemaRequest)(nil),     // 11: cerbos.request.v1.AddOrUpdateSchemaRequest
-	(*v1.ListSchemasRequest)(nil),           // 12: cerbos.request.v1.ListSchemasRequest
-	(*v1.GetSchemaRequest)(nil),             // 13: cerbos.request.v1.GetSchemaRequest
-	(*v1.DeleteSchemaRequest)(nil),          // 14: cerbos.request.v1.DeleteSchemaRequest
-	(*v1.ReloadStoreRequest)(nil),           // 15: cerbos.request.v1.ReloadStoreRequest
-	(*v1.PlaygroundValidateRequest)(nil),    // 16: cerbos.request.v1.PlaygroundValidateRequest
-	(*v1.PlaygroundTestRequest)(nil),        // 17: cerbos.request.v1.PlaygroundTestRequest
-	(*v1.PlaygroundEvaluateRequest)(nil),    // 18: cerbos.request.v1.PlaygroundEvaluateRequest
-	(*v1.PlaygroundProxyRequest)(nil),       // 19: cerbos.request.v1.PlaygroundProxyRequest
-	(*v11.CheckResourceSetResponse)(nil),    // 20: cerbos.response.v1.CheckResourceSetResponse
-	(*v11.CheckResourceBatchResponse)(nil),  // 21: cerbos.response.v1.CheckResourceBatchResponse
-	(*v11.CheckResourcesResponse)(nil),      // 22: cerbos.response.v1.CheckResourcesResponse
-	(*v11.ServerInfoResponse)(nil),          // 23: cerbos.response.v1.ServerInfoResponse
-	(*v11.PlanResourcesResponse)(nil),       // 24: cerbos.response.v1.PlanResourcesResponse
-	(*v11.AddOrUpdatePolicyResponse)(nil),   // 25: cerbos.response.v1.AddOrUpdatePolicyResponse
-	(*v11.ListPoliciesResponse)(nil),        // 26: cerbos.response.v1.ListPoliciesResponse
-	(*v11.GetPolicyResponse)(nil),           // 27: cerbos.response.v1.GetPolicyResponse
-	(*v11.DisablePolicyResponse)(nil),       // 28: cerbos.response.v1.DisablePolicyResponse
-	(*v11.EnablePolicyResponse)(nil),        // 29: cerbos.response.v1.EnablePolicyResponse
-	(*v11.ListAuditLogEntriesResponse)(nil), // 30: cerbos.response.v1.ListAuditLogEntriesResponse
-	(*v11.AddOrUpdateSchemaResponse)(nil),   // 31: cerbos.response.v1.AddOrUpdateSchemaResponse
-	(*v11.ListSchemasResponse)(nil),         // 32: cerbos.response.v1.ListSchemasResponse
-	(*v11.GetSchemaResponse)(nil),           // 33: cerbos.response.v1.GetSchemaResponse
-	(*v11.DeleteSchemaResponse)(nil),        // 34: cerbos.response.v1.DeleteSchemaResponse
-	(*v11.ReloadStoreResponse)(nil),         // 35: cerbos.response.v1.ReloadStoreResponse
-	(*v11.PlaygroundValidateResponse)(nil),  // 36: cerbos.response.v1.PlaygroundValidateResponse
-	(*v11.PlaygroundTestResponse)(nil),      // 37: cerbos.response.v1.PlaygroundTestResponse
-	(*v11.PlaygroundEvaluateResponse)(nil),  // 38: cerbos.response.v1.PlaygroundEvaluateResponse
-	(*v11.PlaygroundProxyResponse)(nil),     // 39: cerbos.response.v1.PlaygroundProxyResponse
+	(*v1.CheckResourceSetRequest)(nil),               // 0: cerbos.request.v1.CheckResourceSetRequest
+	(*v1.CheckResourceBatchRequest)(nil),             // 1: cerbos.request.v1.CheckResourceBatchRequest
+	(*v1.CheckResourcesRequest)(nil),                 // 2: cerbos.request.v1.CheckResourcesRequest
+	(*v1.ServerInfoRequest)(nil),                     // 3: cerbos.request.v1.ServerInfoRequest
+	(*v1.PlanResourcesRequest)(nil),                  // 4: cerbos.request.v1.PlanResourcesRequest
+	(*v1.AddOrUpdatePolicyRequest)(nil),              // 5: cerbos.request.v1.AddOrUpdatePolicyRequest
+	(*v1.ListPoliciesRequest)(nil),                   // 6: cerbos.request.v1.ListPoliciesRequest
+	(*v1.GetPolicyRequest)(nil),                      // 7: cerbos.request.v1.GetPolicyRequest
+	(*v1.DisablePolicyRequest)(nil),                  // 8: cerbos.request.v1.DisablePolicyRequest
+	(*v1.EnablePolicyRequest)(nil),                   // 9: cerbos.request.v1.EnablePolicyRequest
+	(*v1.ListAuditLogEntriesRequest)(nil),            // 10: cerbos.request.v1.ListAuditLogEntriesRequest
+	(*v1.AddOrUpdateSchemaRequest)(nil),              // 11: cerbos.request.v1.AddOrUpdateSchemaRequest
+	(*v1.ListSchemasRequest)(nil),                    // 12: cerbos.request.v1.ListSchemasRequest
+	(*v1.GetSchemaRequest)(nil),                      // 13: cerbos.request.v1.GetSchemaRequest
+	(*v1.DeleteSchemaRequest)(nil),                   // 14: cerbos.request.v1.DeleteSchemaRequest
+	(*v1.ListSchemaReferencesRequest)(nil),           // 15: cerbos.request.v1.ListSchemaReferencesRequest
+	(*v1.GetCompilationDiagnosticsRequest)(nil),      // 16: cerbos.request.v1.GetCompilationDiagnosticsRequest
+	(*v1.ReloadStoreRequest)(nil),                    // 17: cerbos.request.v1.ReloadStoreRequest
+	(*v1.PlaygroundValidateRequest)(nil),             // 18: cerbos.request.v1.PlaygroundValidateRequest
+	(*v1.PlaygroundTestRequest)(nil),                 // 19: cerbos.request.v1.PlaygroundTestRequest
+	(*v1.PlaygroundEvaluateRequest)(nil),             // 20: cerbos.request.v1.PlaygroundEvaluateRequest
+	(*v1.PlaygroundEvaluateExpressionRequest)(nil),   // 21: cerbos.request.v1.PlaygroundEvaluateExpressionRequest
+	(*v1.PlaygroundProxyRequest)(nil),                // 22: cerbos.request.v1.PlaygroundProxyRequest
+	(*v11.CheckResourceSetResponse)(nil),             // 23: cerbos.response.v1.CheckResourceSetResponse
+	(*v11.CheckResourceBatchResponse)(nil),           // 24: cerbos.response.v1.CheckResourceBatchResponse
+	(*v11.CheckResourcesResponse)(nil),               // 25: cerbos.response.v1.CheckResourcesResponse
+	(*v11.ServerInfoResponse)(nil),                   // 26: cerbos.response.v1.ServerInfoResponse
+	(*v11.PlanResourcesResponse)(nil),                // 27: cerbos.response.v1.PlanResourcesResponse
+	(*v11.AddOrUpdatePolicyResponse)(nil),            // 28: cerbos.response.v1.AddOrUpdatePolicyResponse
+	(*v11.ListPoliciesResponse)(nil),                 // 29: cerbos.response.v1.ListPoliciesResponse
+	(*v11.GetPolicyResponse)(nil),                    // 30: cerbos.response.v1.GetPolicyResponse
+	(*v11.DisablePolicyResponse)(nil),                // 31: cerbos.response.v1.DisablePolicyResponse
+	(*v11.EnablePolicyResponse)(nil),                 // 32: cerbos.response.v1.EnablePolicyResponse
+	(*v11.ListAuditLogEntriesResponse)(nil),          // 33: cerbos.response.v1.ListAuditLogEntriesResponse
+	(*v11.AddOrUpdateSchemaResponse)(nil),            // 34: cerbos.response.v1.AddOrUpdateSchemaResponse
+	(*v11.ListSchemasResponse)(nil),                  // 35: cerbos.response.v1.ListSchemasResponse
+	(*v11.GetSchemaResponse)(nil),                    // 36: cerbos.response.v1.GetSchemaResponse
+	(*v11.DeleteSchemaResponse)(nil),                 // 37: cerbos.response.v1.DeleteSchemaResponse
+	(*v11.ListSchemaReferencesResponse)(nil),         // 38: cerbos.response.v1.ListSchemaReferencesResponse
+	(*v11.GetCompilationDiagnosticsResponse)(nil),    // 39: cerbos.response.v1.GetCompilationDiagnosticsResponse
+	(*v11.ReloadStoreResponse)(nil),                  // 40: cerbos.response.v1.ReloadStoreResponse
+	(*v11.PlaygroundValidateResponse)(nil),           // 41: cerbos.response.v1.PlaygroundValidateResponse
+	(*v11.PlaygroundTestResponse)(nil),               // 42: cerbos.response.v1.PlaygroundTestResponse
+	(*v11.PlaygroundEvaluateResponse)(nil),           // 43: cerbos.response.v1.PlaygroundEvaluateResponse
+	(*v11.PlaygroundEvaluateExpressionResponse)(nil), // 44: cerbos.response.v1.PlaygroundEvaluateExpressionResponse
+	(*v11.PlaygroundProxyResponse)(nil),              // 45: cerbos.response.v1.PlaygroundProxyResponse
 }
 var file_cerbos_svc_v1_svc_proto_depIdxs = []int32{
 	0,  // 0: cerbos.svc.v1.CerbosService.CheckResourceSet:input_type -> cerbos.request.v1.CheckResourceSetRequest
@@ -368,33 +414,39 @@ var file_cerbos_svc_v1_svc_proto_depIdxs = []int32{
 	12, // 12: cerbos.svc.v1.CerbosAdminService.ListSchemas:input_type -> cerbos.request.v1.ListSchemasRequest
 	13, // 13: cerbos.svc.v1.CerbosAdminService.GetSchema:input_type -> cerbos.request.v1.GetSchemaRequest
 	14, // 14: cerbos.svc.v1.CerbosAdminService.DeleteSchema:input_type -> cerbos.request.v1.DeleteSchemaRequest
-	15, // 15: cerbos.svc.v1.CerbosAdminService.ReloadStore:input_type -> cerbos.request.v1.ReloadStoreRequest
-	16, // 16: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:input_type -> cerbos.request.v1.PlaygroundValidateRequest
-	17, // 17: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:input_type -> cerbos.request.v1.PlaygroundTestRequest
-	18, // 18: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:input_type -> cerbos.request.v1.PlaygroundEvaluateRequest
-	19, // 19: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:input_type -> cerbos.request.v1.PlaygroundProxyRequest
-	20, // 20: cerbos.svc.v1.CerbosService.CheckResourceSet:output_type -> cerbos.response.v1.CheckResourceSetResponse
-	21, // 21: cerbos.svc.v1.CerbosService.CheckResourceBatch:output_type -> cerbos.response.v1.CheckResourceBatchResponse
-	22, // 22: cerbos.svc.v1.CerbosService.CheckResources:output_type -> cerbos.response.v1.CheckResourcesResponse
-	23, // 23: cerbos.svc.v1.CerbosService.ServerInfo:output_type -> cerbos.response.v1.ServerInfoResponse
-	24, // 24: cerbos.svc.v1.CerbosService.PlanResources:output_type -> cerbos.response.v1.PlanResourcesResponse
-	25, // 25: cerbos.svc.v1.CerbosAdminService.AddOrUpdatePolicy:output_type -> cerbos.response.v1.AddOrUpdatePolicyResponse
-	26, // 26: cerbos.svc.v1.CerbosAdminService.ListPolicies:output_type -> cerbos.response.v1.ListPoliciesResponse
-	27, // 27: cerbos.svc.v1.CerbosAdminService.GetPolicy:output_type -> cerbos.response.v1.GetPolicyResponse
-	28, // 28: cerbos.svc.v1.CerbosAdminService.DisablePolicy:output_type -> cerbos.response.v1.DisablePolicyResponse
-	29, // 29: cerbos.svc.v1.CerbosAdminService.EnablePolicy:output_type -> cerbos.response.v1.EnablePolicyResponse
-	30, // 30: cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries:output_type -> cerbos.response.v1.ListAuditLogEntriesResponse
-	31, // 31: cerbos.svc.v1.CerbosAdminService.AddOrUpdateSchema:output_type -> cerbos.response.v1.AddOrUpdateSchemaResponse
-	32, // 32: cerbos.svc.v1.CerbosAdminService.ListSchemas:output_type -> cerbos.response.v1.ListSchemasResponse
-	33, // 33: cerbos.svc.v1.CerbosAdminService.GetSchema:output_type -> cerbos.response.v1.GetSchemaResponse
-	34, // 34: cerbos.svc.v1.CerbosAdminService.DeleteSchema:output_type -> cerbos.response.v1.DeleteSchemaResponse
-	35, // 35: cerbos.svc.v1.CerbosAdminService.ReloadStore:output_type -> cerbos.response.v1.ReloadStoreResponse
-	36, // 36: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:output_type -> cerbos.response.v1.PlaygroundValidateResponse
-	37, // 37: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:output_type -> cerbos.response.v1.PlaygroundTestResponse
-	38, // 38: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:output_type -> cerbos.response.v1.PlaygroundEvaluateResponse
-	39, // 39: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:output_type -> cerbos.response.v1.PlaygroundProxyResponse
-	20, // [20:40] is the sub-list for method output_type
-	0,  // [0:20] is the sub-list for method input_type
+	15, // 15: cerbos.svc.v1.CerbosAdminService.ListSchemaReferences:input_type -> cerbos.request.v1.ListSchemaReferencesRequest
+	16, // 16: cerbos.svc.v1.CerbosAdminService.GetCompilationDiagnostics:input_type -> cerbos.request.v1.GetCompilationDiagnosticsRequest
+	17, // 17: cerbos.svc.v1.CerbosAdminService.ReloadStore:input_type -> cerbos.request.v1.ReloadStoreRequest
+	18, // 18: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:input_type -> cerbos.request.v1.PlaygroundValidateRequest
+	19, // 19: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:input_type -> cerbos.request.v1.PlaygroundTestRequest
+	20, // 20: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:input_type -> cerbos.request.v1.PlaygroundEvaluateRequest
+	21, // 21: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluateExpression:input_type -> cerbos.request.v1.PlaygroundEvaluateExpressionRequest
+	22, // 22: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:input_type -> cerbos.request.v1.PlaygroundProxyRequest
+	23, // 23: cerbos.svc.v1.CerbosService.CheckResourceSet:output_type -> cerbos.response.v1.CheckResourceSetResponse
+	24, // 24: cerbos.svc.v1.CerbosService.CheckResourceBatch:output_type -> cerbos.response.v1.CheckResourceBatchResponse
+	25, // 25: cerbos.svc.v1.CerbosService.CheckResources:output_type -> cerbos.response.v1.CheckResourcesResponse
+	26, // 26: cerbos.svc.v1.CerbosService.ServerInfo:output_type -> cerbos.response.v1.ServerInfoResponse
+	27, // 27: cerbos.svc.v1.CerbosService.PlanResources:output_type -> cerbos.response.v1.PlanResourcesResponse
+	28, // 28: cerbos.svc.v1.CerbosAdminService.AddOrUpdatePolicy:output_type -> cerbos.response.v1.AddOrUpdatePolicyResponse
+	29, // 29: cerbos.svc.v1.CerbosAdminService.ListPolicies:output_type -> cerbos.response.v1.ListPoliciesResponse
+	30, // 30: cerbos.svc.v1.CerbosAdminService.GetPolicy:output_type -> cerbos.response.v1.GetPolicyResponse
+	31, // 31: cerbos.svc.v1.CerbosAdminService.DisablePolicy:output_type -> cerbos.response.v1.DisablePolicyResponse
+	32, // 32: cerbos.svc.v1.CerbosAdminService.EnablePolicy:output_type -> cerbos.response.v1.EnablePolicyResponse
+	33, // 33: cerbos.svc.v1.CerbosAdminService.ListAuditLogEntries:output_type -> cerbos.response.v1.ListAuditLogEntriesResponse
+	34, // 34: cerbos.svc.v1.CerbosAdminService.AddOrUpdateSchema:output_type -> cerbos.response.v1.AddOrUpdateSchemaResponse
+	35, // 35: cerbos.svc.v1.CerbosAdminService.ListSchemas:output_type -> cerbos.response.v1.ListSchemasResponse
+	36, // 36: cerbos.svc.v1.CerbosAdminService.GetSchema:output_type -> cerbos.response.v1.GetSchemaResponse
+	37, // 37: cerbos.svc.v1.CerbosAdminService.DeleteSchema:output_type -> cerbos.response.v1.DeleteSchemaResponse
+	38, // 38: cerbos.svc.v1.CerbosAdminService.ListSchemaReferences:output_type -> cerbos.response.v1.ListSchemaReferencesResponse
+	39, // 39: cerbos.svc.v1.CerbosAdminService.GetCompilationDiagnostics:output_type -> cerbos.response.v1.GetCompilationDiagnosticsResponse
+	40, // 40: cerbos.svc.v1.CerbosAdminService.ReloadStore:output_type -> cerbos.response.v1.ReloadStoreResponse
+	41, // 41: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundValidate:output_type -> cerbos.response.v1.PlaygroundValidateResponse
+	42, // 42: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundTest:output_type -> cerbos.response.v1.PlaygroundTestResponse
+	43, // 43: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluate:output_type -> cerbos.response.v1.PlaygroundEvaluateResponse
+	44, // 44: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundEvaluateExpression:output_type -> cerbos.response.v1.PlaygroundEvaluateExpressionResponse
+	45, // 45: cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy:output_type -> cerbos.response.v1.PlaygroundProxyResponse
+	23, // [23:46] is the sub-list for method output_type
+	0,  // [0:23] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -262,17 +262,19 @@ var CerbosService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CerbosAdminService_AddOrUpdatePolicy_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdatePolicy"
-	CerbosAdminService_ListPolicies_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/ListPolicies"
-	CerbosAdminService_GetPolicy_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/GetPolicy"
-	CerbosAdminService_DisablePolicy_FullMethodName       = "/cerbos.svc.v1.CerbosAdminService/DisablePolicy"
-	CerbosAdminService_EnablePolicy_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/EnablePolicy"
-	CerbosAdminService_ListAuditLogEntries_FullMethodName = "/cerbos.svc.v1.CerbosAdminService/ListAuditLogEntries"
-	CerbosAdminService_AddOrUpdateSchema_FullMethodName   = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdateSchema"
-	CerbosAdminService_ListSchemas_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/ListSchemas"
-	CerbosAdminService_GetSchema_FullMethodName           = "/cerbos.svc.v1.CerbosAdminService/GetSchema"
-	CerbosAdminService_DeleteSchema_FullMethodName        = "/cerbos.svc.v1.CerbosAdminService/DeleteSchema"
-	CerbosAdminService_ReloadStore_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/ReloadStore"
+	CerbosAdminService_AddOrUpdatePolicy_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdatePolicy"
+	CerbosAdminService_ListPolicies_FullMethodName              = "/cerbos.svc.v1.CerbosAdminService/ListPolicies"
+	CerbosAdminService_GetPolicy_FullMethodName                 = "/cerbos.svc.v1.CerbosAdminService/GetPolicy"
+	CerbosAdminService_DisablePolicy_FullMethodName             = "/cerbos.svc.v1.CerbosAdminService/DisablePolicy"
+	CerbosAdminService_EnablePolicy_FullMethodName              = "/cerbos.svc.v1.CerbosAdminService/EnablePolicy"
+	CerbosAdminService_ListAuditLogEntries_FullMethodName       = "/cerbos.svc.v1.CerbosAdminService/ListAuditLogEntries"
+	CerbosAdminService_AddOrUpdateSchema_FullMethodName         = "/cerbos.svc.v1.CerbosAdminService/AddOrUpdateSchema"
+	CerbosAdminService_ListSchemas_FullMethodName               = "/cerbos.svc.v1.CerbosAdminService/ListSchemas"
+	CerbosAdminService_GetSchema_FullMethodName                 = "/cerbos.svc.v1.CerbosAdminService/GetSchema"
+	CerbosAdminService_DeleteSchema_FullMethodName              = "/cerbos.svc.v1.CerbosAdminService/DeleteSchema"
+	CerbosAdminService_ListSchemaReferences_FullMethodName      = "/cerbos.svc.v1.CerbosAdminService/ListSchemaReferences"
+	CerbosAdminService_GetCompilationDiagnostics_FullMethodName = "/cerbos.svc.v1.CerbosAdminService/GetCompilationDiagnostics"
+	CerbosAdminService_ReloadStore_FullMethodName               = "/cerbos.svc.v1.CerbosAdminService/ReloadStore"
 )
 
 // CerbosAdminServiceClient is the client API for CerbosAdminService service.
@@ -289,6 +291,8 @@ type CerbosAdminServiceClient interface {
 	ListSchemas(ctx context.Context, in *v1.ListSchemasRequest, opts ...grpc.CallOption) (*v11.ListSchemasResponse, error)
 	GetSchema(ctx context.Context, in *v1.GetSchemaRequest, opts ...grpc.CallOption) (*v11.GetSchemaResponse, error)
 	DeleteSchema(ctx context.Context, in *v1.DeleteSchemaRequest, opts ...grpc.CallOption) (*v11.DeleteSchemaResponse, error)
+	ListSchemaReferences(ctx context.Context, in *v1.ListSchemaReferencesRequest, opts ...grpc.CallOption) (*v11.ListSchemaReferencesResponse, error)
+	GetCompilationDiagnostics(ctx context.Context, in *v1.GetCompilationDiagnosticsRequest, opts ...grpc.CallOption) (*v11.GetCompilationDiagnosticsResponse, error)
 	ReloadStore(ctx context.Context, in *v1.ReloadStoreRequest, opts ...grpc.CallOption) (*v11.ReloadStoreResponse, error)
 }
 
@@ -413,6 +417,24 @@ func (c *cerbosAdminServiceClient) DeleteSchema(ctx context.Context, in *v1.Dele
 	return out, nil
 }
 
+func (c *cerbosAdminServiceClient) ListSchemaReferences(ctx context.Context, in *v1.ListSchemaReferencesRequest, opts ...grpc.CallOption) (*v11.ListSchemaReferencesResponse, error) {
+	out := new(v11.ListSchemaReferencesResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_ListSchemaReferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cerbosAdminServiceClient) GetCompilationDiagnostics(ctx context.Context, in *v1.GetCompilationDiagnosticsRequest, opts ...grpc.CallOption) (*v11.GetCompilationDiagnosticsResponse, error) {
+	out := new(v11.GetCompilationDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, CerbosAdminService_GetCompilationDiagnostics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cerbosAdminServiceClient) ReloadStore(ctx context.Context, in *v1.ReloadStoreRequest, opts ...grpc.CallOption) (*v11.ReloadStoreResponse, error) {
 	out := new(v11.ReloadStoreResponse)
 	err := c.cc.Invoke(ctx, CerbosAdminService_ReloadStore_FullMethodName, in, out, opts...)
@@ -436,6 +458,8 @@ type CerbosAdminServiceServer interface {
 	ListSchemas(context.Context, *v1.ListSchemasRequest) (*v11.ListSchemasResponse, error)
 	GetSchema(context.Context, *v1.GetSchemaRequest) (*v11.GetSchemaResponse, error)
 	DeleteSchema(context.Context, *v1.DeleteSchemaRequest) (*v11.DeleteSchemaResponse, error)
+	ListSchemaReferences(context.Context, *v1.ListSchemaReferencesRequest) (*v11.ListSchemaReferencesResponse, error)
+	GetCompilationDiagnostics(context.Context, *v1.GetCompilationDiagnosticsRequest) (*v11.GetCompilationDiagnosticsResponse, error)
 	ReloadStore(context.Context, *v1.ReloadStoreRequest) (*v11.ReloadStoreResponse, error)
 	mustEmbedUnimplementedCerbosAdminServiceServer()
 }
@@ -474,6 +498,12 @@ func (UnimplementedCerbosAdminServiceServer) GetSchema(context.Context, *v1.GetS
 func (UnimplementedCerbosAdminServiceServer) DeleteSchema(context.Context, *v1.DeleteSchemaRequest) (*v11.DeleteSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchema not implemented")
 }
+func (UnimplementedCerbosAdminServiceServer) ListSchemaReferences(context.Context, *v1.ListSchemaReferencesRequest) (*v11.ListSchemaReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemaReferences not implemented")
+}
+func (UnimplementedCerbosAdminServiceServer) GetCompilationDiagnostics(context.Context, *v1.GetCompilationDiagnosticsRequest) (*v11.GetCompilationDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompilationDiagnostics not implemented")
+}
 func (UnimplementedCerbosAdminServiceServer) ReloadStore(context.Context, *v1.ReloadStoreRequest) (*v11.ReloadStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadStore not implemented")
 }
@@ -673,6 +703,42 @@ func _CerbosAdminService_DeleteSchema_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosAdminService_ListSchemaReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListSchemaReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).ListSchemaReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_ListSchemaReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).ListSchemaReferences(ctx, req.(*v1.ListSchemaReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CerbosAdminService_GetCompilationDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetCompilationDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosAdminServiceServer).GetCompilationDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosAdminService_GetCompilationDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosAdminServiceServer).GetCompilationDiagnostics(ctx, req.(*v1.GetCompilationDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CerbosAdminService_ReloadStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.ReloadStoreRequest)
 	if err := dec(in); err != nil {
@@ -734,6 +800,14 @@ var CerbosAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSchema",
 			Handler:    _CerbosAdminService_DeleteSchema_Handler,
 		},
+		{
+			MethodName: "ListSchemaReferences",
+			Handler:    _CerbosAdminService_ListSchemaReferences_Handler,
+		},
+		{
+			MethodName: "GetCompilationDiagnostics",
+			Handler:    _CerbosAdminService_GetCompilationDiagnostics_Handler,
+		},
 		{
 			MethodName: "ReloadStore",
 			Handler:    _CerbosAdminService_ReloadStore_Handler,
@@ -750,10 +824,11 @@ var CerbosAdminService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CerbosPlaygroundService_PlaygroundValidate_FullMethodName = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundValidate"
-	CerbosPlaygroundService_PlaygroundTest_FullMethodName     = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundTest"
-	CerbosPlaygroundService_PlaygroundEvaluate_FullMethodName = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundEvaluate"
-	CerbosPlaygroundService_PlaygroundProxy_FullMethodName    = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundProxy"
+	CerbosPlaygroundService_PlaygroundValidate_FullMethodName           = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundValidate"
+	CerbosPlaygroundService_PlaygroundTest_FullMethodName               = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundTest"
+	CerbosPlaygroundService_PlaygroundEvaluate_FullMethodName           = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundEvaluate"
+	CerbosPlaygroundService_PlaygroundEvaluateExpression_FullMethodName = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundEvaluateExpression"
+	CerbosPlaygroundService_PlaygroundProxy_FullMethodName              = "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundProxy"
 )
 
 // CerbosPlaygroundServiceClient is the client API for CerbosPlaygroundService service.
@@ -763,6 +838,7 @@ type CerbosPlaygroundServiceClient interface {
 	PlaygroundValidate(ctx context.Context, in *v1.PlaygroundValidateRequest, opts ...grpc.CallOption) (*v11.PlaygroundValidateResponse, error)
 	PlaygroundTest(ctx context.Context, in *v1.PlaygroundTestRequest, opts ...grpc.CallOption) (*v11.PlaygroundTestResponse, error)
 	PlaygroundEvaluate(ctx context.Context, in *v1.PlaygroundEvaluateRequest, opts ...grpc.CallOption) (*v11.PlaygroundEvaluateResponse, error)
+	PlaygroundEvaluateExpression(ctx context.Context, in *v1.PlaygroundEvaluateExpressionRequest, opts ...grpc.CallOption) (*v11.PlaygroundEvaluateExpressionResponse, error)
 	PlaygroundProxy(ctx context.Context, in *v1.PlaygroundProxyRequest, opts ...grpc.CallOption) (*v11.PlaygroundProxyResponse, error)
 }
 
@@ -801,6 +877,15 @@ func (c *cerbosPlaygroundServiceClient) PlaygroundEvaluate(ctx context.Context,
 	return out, nil
 }
 
+func (c *cerbosPlaygroundServiceClient) PlaygroundEvaluateExpression(ctx context.Context, in *v1.PlaygroundEvaluateExpressionRequest, opts ...grpc.CallOption) (*v11.PlaygroundEvaluateExpressionResponse, error) {
+	out := new(v11.PlaygroundEvaluateExpressionResponse)
+	err := c.cc.Invoke(ctx, CerbosPlaygroundService_PlaygroundEvaluateExpression_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cerbosPlaygroundServiceClient) PlaygroundProxy(ctx context.Context, in *v1.PlaygroundProxyRequest, opts ...grpc.CallOption) (*v11.PlaygroundProxyResponse, error) {
 	out := new(v11.PlaygroundProxyResponse)
 	err := c.cc.Invoke(ctx, CerbosPlaygroundService_PlaygroundProxy_FullMethodName, in, out, opts...)
@@ -817,6 +902,7 @@ type CerbosPlaygroundServiceServer interface {
 	PlaygroundValidate(context.Context, *v1.PlaygroundValidateRequest) (*v11.PlaygroundValidateResponse, error)
 	PlaygroundTest(context.Context, *v1.PlaygroundTestRequest) (*v11.PlaygroundTestResponse, error)
 	PlaygroundEvaluate(context.Context, *v1.PlaygroundEvaluateRequest) (*v11.PlaygroundEvaluateResponse, error)
+	PlaygroundEvaluateExpression(context.Context, *v1.PlaygroundEvaluateExpressionRequest) (*v11.PlaygroundEvaluateExpressionResponse, error)
 	PlaygroundProxy(context.Context, *v1.PlaygroundProxyRequest) (*v11.PlaygroundProxyResponse, error)
 	mustEmbedUnimplementedCerbosPlaygroundServiceServer()
 }
@@ -834,6 +920,9 @@ func (UnimplementedCerbosPlaygroundServiceServer) PlaygroundTest(context.Context
 func (UnimplementedCerbosPlaygroundServiceServer) PlaygroundEvaluate(context.Context, *v1.PlaygroundEvaluateRequest) (*v11.PlaygroundEvaluateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaygroundEvaluate not implemented")
 }
+func (UnimplementedCerbosPlaygroundServiceServer) PlaygroundEvaluateExpression(context.Context, *v1.PlaygroundEvaluateExpressionRequest) (*v11.PlaygroundEvaluateExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaygroundEvaluateExpression not implemented")
+}
 func (UnimplementedCerbosPlaygroundServiceServer) PlaygroundProxy(context.Context, *v1.PlaygroundProxyRequest) (*v11.PlaygroundProxyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaygroundProxy not implemented")
 }
@@ -905,6 +994,24 @@ func _CerbosPlaygroundService_PlaygroundEvaluate_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CerbosPlaygroundService_PlaygroundEvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PlaygroundEvaluateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CerbosPlaygroundServiceServer).PlaygroundEvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CerbosPlaygroundService_PlaygroundEvaluateExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CerbosPlaygroundServiceServer).PlaygroundEvaluateExpression(ctx, req.(*v1.PlaygroundEvaluateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CerbosPlaygroundService_PlaygroundProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.PlaygroundProxyRequest)
 	if err := dec(in); err != nil {
@@ -942,6 +1049,10 @@ var CerbosPlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlaygroundEvaluate",
 			Handler:    _CerbosPlaygroundService_PlaygroundEvaluate_Handler,
 		},
+		{
+			MethodName: "PlaygroundEvaluateExpression",
+			Handler:    _CerbosPlaygroundService_PlaygroundEvaluateExpression_Handler,
+		},
 		{
 			MethodName: "PlaygroundProxy",
 			Handler:    _CerbosPlaygroundService_PlaygroundProxy_Handler,
@@ -37,6 +37,7 @@ type AdminClient interface {
 	DeleteSchema(ctx context.Context, ids ...string) (uint32, error)
 	ListSchemas(ctx context.Context) ([]string, error)
 	GetSchema(ctx context.Context, ids ...string) ([]*schemav1.Schema, error)
+	ListSchemaReferences(ctx context.Context, id string) ([]string, error)
 	ReloadStore(ctx context.Context, wait bool) error
 }
 
@@ -305,6 +306,22 @@ func (c *GrpcAdminClient) GetSchema(ctx context.Context, ids ...string) ([]*sche
 	return res.Schemas, nil
 }
 
+func (c *GrpcAdminClient) ListSchemaReferences(ctx context.Context, id string) ([]string, error) {
+	req := &requestv1.ListSchemaReferencesRequest{
+		Id: id,
+	}
+	if err := validator.Validate(req); err != nil {
+		return nil, fmt.Errorf("could not validate list schema references request: %w", err)
+	}
+
+	res, err := c.client.ListSchemaReferences(ctx, req, grpc.PerRPCCredentials(c.creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not list schema references: %w", err)
+	}
+
+	return res.PolicyIds, nil
+}
+
 func (c *GrpcAdminClient) ReloadStore(ctx context.Context, wait bool) error {
 	req := &requestv1.ReloadStoreRequest{
 		Wait: wait,
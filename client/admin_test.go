@@ -21,6 +21,7 @@ import (
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/client/testutil"
 	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/test"
 )
@@ -155,6 +156,33 @@ func TestListPolicies(t *testing.T) {
 	})
 }
 
+func TestListSchemaReferences(t *testing.T) {
+	ac, ps := setUpAdminClientAndPolicySet(t)
+
+	const schemaID = "resources/leave_request.json"
+	var want []string
+	for _, p := range ps.GetPolicies() {
+		for _, ref := range policy.SchemaReferences(p) {
+			if ref == "cerbos:///"+schemaID {
+				want = append(want, namer.PolicyKey(p))
+			}
+		}
+	}
+	require.GreaterOrEqual(t, len(want), 2)
+
+	t.Run("should list all policies referencing the schema", func(t *testing.T) {
+		have, err := ac.ListSchemaReferences(context.Background(), schemaID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, want, have)
+	})
+
+	t.Run("should return empty list for unreferenced schema", func(t *testing.T) {
+		have, err := ac.ListSchemaReferences(context.Background(), "unreferenced.json")
+		require.NoError(t, err)
+		require.Empty(t, have)
+	})
+}
+
 func TestFilterPolicies(t *testing.T) {
 	ac, ps := setUpAdminClientAndPolicySet(t)
 
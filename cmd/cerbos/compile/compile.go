@@ -104,7 +104,7 @@ func (c *Cmd) Run(k *kong.Kong) error {
 	}
 	schemaMgr := internalschema.NewFromConf(ctx, store, internalschema.NewConf(enforcement))
 
-	if err := compile.BatchCompile(idx.GetAllCompilationUnits(ctx), schemaMgr); err != nil {
+	if err := compile.BatchCompile(idx.GetAllCompilationUnits(ctx), schemaMgr, compile.OptionsFromConf(compile.DefaultConf())...); err != nil {
 		compErr := new(compile.ErrorList)
 		if errors.As(err, &compErr) {
 			return internalcompile.Display(p, *compErr, c.Output, colorLevel)
@@ -111,6 +111,12 @@ func (c *Cmd) Run() error {
 	if err := tracing.Init(ctx); err != nil {
 		return err
 	}
+	defer func() {
+		// ctx is already cancelled when the server shuts down so the spans must be flushed using a fresh context.
+		if err := tracing.Shutdown(context.Background()); err != nil {
+			log.Warnw("Failed to export pending spans", "error", err)
+		}
+	}()
 
 	if err := server.Start(ctx, c.ZPagesEnabled); err != nil {
 		log.Errorw("Failed to start server", "error", err)
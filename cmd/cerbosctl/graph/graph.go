@@ -0,0 +1,266 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/alecthomas/kong"
+	"go.uber.org/zap"
+
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const help = `# Write the dependency graph of all the policies in a directory
+cerbosctl graph path/to/policies > policies.dot
+
+# Render the graph with Graphviz
+cerbosctl graph path/to/policies | dot -Tsvg > policies.svg
+
+# Only include the policies and schemas directly connected to a policy
+cerbosctl graph path/to/policies --focus=resource.leave_request.vdefault
+
+# Include the policies up to two levels away from a policy
+cerbosctl graph path/to/policies --focus=resource.leave_request.vdefault --depth=2`
+
+// Cmd writes the policy dependency graph of a local policy repository in Graphviz DOT format.
+// It works on a directory instead of the server, so it doesn't require the Admin API.
+type Cmd struct {
+	Dir   string `arg:"" help:"Policy repository directory" type:"path"`
+	Focus string `help:"Only include the neighbourhood of the policy with this ID (for example: resource.leave_request.vdefault)"`
+	Depth uint   `help:"Maximum distance from the focused policy" default:"1"`
+}
+
+func (c *Cmd) Run(k *kong.Kong) error {
+	fsys, err := util.OpenDirectoryFS(c.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to open policy repository at %q: %w", c.Dir, err)
+	}
+
+	ctx := context.Background()
+	idx, err := index.Build(ctx, fsys, index.WithBuildFailureLogLevel(zap.DebugLevel))
+	if err != nil {
+		idxErr := new(index.BuildError)
+		if errors.As(err, &idxErr) {
+			return fmt.Errorf("failed to load policy repository at %q: %w (run `cerbos compile` for details)", c.Dir, idxErr)
+		}
+
+		return fmt.Errorf("failed to load policy repository at %q: %w", c.Dir, err)
+	}
+	defer idx.Close()
+
+	g := build(idx.GetAllCompilationUnits(ctx))
+	if c.Focus != "" {
+		if g, err = g.neighbourhood(c.Focus, c.Depth); err != nil {
+			return err
+		}
+	}
+
+	return g.writeDOT(k.Stdout)
+}
+
+func (c *Cmd) Help() string {
+	return help
+}
+
+type edgeKind int
+
+const (
+	edgeImport edgeKind = iota
+	edgeSchema
+	edgeParentScope
+)
+
+func (ek edgeKind) label() string {
+	switch ek {
+	case edgeImport:
+		return "imports"
+	case edgeSchema:
+		return "schema"
+	case edgeParentScope:
+		return "parent scope"
+	default:
+		return ""
+	}
+}
+
+type node struct {
+	id    string
+	label string
+	shape string
+}
+
+type edge struct {
+	from string
+	to   string
+	kind edgeKind
+}
+
+// policyGraph holds the policies and schemas as nodes and the references between them as edges.
+type policyGraph struct {
+	nodes map[string]node
+	edges map[edge]struct{}
+}
+
+func newPolicyGraph() *policyGraph {
+	return &policyGraph{nodes: make(map[string]node), edges: make(map[edge]struct{})}
+}
+
+// build creates the graph from the compilation units produced by the index.
+// The index has already resolved the imports and scope ancestors, so each unit contains every policy that the main policy refers to.
+func build(units <-chan *policy.CompilationUnit) *policyGraph {
+	g := newPolicyGraph()
+	for unit := range units {
+		for _, p := range unit.Definitions {
+			pw := policy.Wrap(p)
+			from := namer.PolicyKeyFromFQN(pw.FQN)
+			g.addNode(node{id: from, label: from, shape: nodeShape(pw.Kind)})
+
+			for _, dep := range policy.Dependencies(p) {
+				g.addEdge(edge{from: from, to: namer.PolicyKeyFromFQN(dep), kind: edgeImport})
+			}
+
+			for _, ref := range policy.SchemaReferences(p) {
+				to := "schema:" + ref
+				g.addNode(node{id: to, label: ref, shape: "note"})
+				g.addEdge(edge{from: from, to: to, kind: edgeSchema})
+			}
+
+			if ancestors := policy.Ancestors(p); len(ancestors) > 0 {
+				if parent, ok := unit.Definitions[ancestors[0]]; ok {
+					g.addEdge(edge{from: from, to: namer.PolicyKey(parent), kind: edgeParentScope})
+				}
+			}
+		}
+	}
+
+	return g
+}
+
+func nodeShape(kind policy.Kind) string {
+	switch kind {
+	case policy.ResourceKind:
+		return "box"
+	case policy.PrincipalKind:
+		return "ellipse"
+	case policy.DerivedRolesKind:
+		return "diamond"
+	case policy.ExportVariablesKind:
+		return "hexagon"
+	default:
+		return "plaintext"
+	}
+}
+
+func (g *policyGraph) addNode(n node) {
+	g.nodes[n.id] = n
+}
+
+func (g *policyGraph) addEdge(e edge) {
+	g.edges[e] = struct{}{}
+}
+
+// neighbourhood returns the subgraph of the nodes that are at most depth edges away from the given node, regardless of the edge direction.
+func (g *policyGraph) neighbourhood(id string, depth uint) (*policyGraph, error) {
+	if _, ok := g.nodes[id]; !ok {
+		return nil, fmt.Errorf("policy %q not found", id)
+	}
+
+	adjacent := make(map[string][]string)
+	for e := range g.edges {
+		adjacent[e.from] = append(adjacent[e.from], e.to)
+		adjacent[e.to] = append(adjacent[e.to], e.from)
+	}
+
+	visited := map[string]struct{}{id: {}}
+	frontier := []string{id}
+	for i := uint(0); i < depth && len(frontier) > 0; i++ {
+		var next []string
+		for _, n := range frontier {
+			for _, a := range adjacent[n] {
+				if _, ok := visited[a]; !ok {
+					visited[a] = struct{}{}
+					next = append(next, a)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	sub := newPolicyGraph()
+	for n := range visited {
+		sub.addNode(g.nodes[n])
+	}
+
+	for e := range g.edges {
+		_, fromOK := visited[e.from]
+		_, toOK := visited[e.to]
+		if fromOK && toOK {
+			sub.addEdge(e)
+		}
+	}
+
+	return sub, nil
+}
+
+// writeDOT writes the graph in Graphviz DOT format. Nodes and edges are sorted to produce stable output.
+func (g *policyGraph) writeDOT(w io.Writer) error {
+	nodeIDs := make([]string, 0, len(g.nodes))
+	for id := range g.nodes {
+		nodeIDs = append(nodeIDs, id)
+	}
+	sort.Strings(nodeIDs)
+
+	edges := make([]edge, 0, len(g.edges))
+	for e := range g.edges {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+
+		if edges[i].to != edges[j].to {
+			return edges[i].to < edges[j].to
+		}
+
+		return edges[i].kind < edges[j].kind
+	})
+
+	ew := &errWriter{w: w}
+	ew.printf("digraph policies {\n")
+	ew.printf("  rankdir=LR;\n")
+	for _, id := range nodeIDs {
+		n := g.nodes[id]
+		ew.printf("  %s [label=%s, shape=%s];\n", strconv.Quote(n.id), strconv.Quote(n.label), n.shape)
+	}
+
+	for _, e := range edges {
+		ew.printf("  %s -> %s [label=%s];\n", strconv.Quote(e.from), strconv.Quote(e.to), strconv.Quote(e.kind.label()))
+	}
+	ew.printf("}\n")
+
+	return ew.err
+}
+
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...any) {
+	if ew.err != nil {
+		return
+	}
+
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
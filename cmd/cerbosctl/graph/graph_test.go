@@ -0,0 +1,81 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package graph_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/require"
+
+	cmdclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/root"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestGraphCmd(t *testing.T) {
+	dir := test.PathToDir(t, "store")
+
+	run := func(t *testing.T, args ...string) (string, error) {
+		t.Helper()
+
+		out := new(bytes.Buffer)
+		cli := &root.Cli{}
+		p, err := kong.New(cli, kong.Name("cerbosctl"), kong.Writers(out, out))
+		require.NoError(t, err)
+
+		kctx, err := p.Parse(append([]string{"graph", dir}, args...))
+		require.NoError(t, err)
+		require.False(t, cli.RequiresServer(kctx.Command()))
+
+		err = kctx.Run(&cli.Globals, &cmdclient.Context{})
+		return out.String(), err
+	}
+
+	t.Run("full", func(t *testing.T) {
+		out, err := run(t)
+		require.NoError(t, err)
+
+		require.Contains(t, out, "digraph policies {")
+		require.Contains(t, out, `"resource.leave_request.vdefault" [label="resource.leave_request.vdefault", shape=box];`)
+		require.Contains(t, out, `"principal.donald_duck.vdefault" [label="principal.donald_duck.vdefault", shape=ellipse];`)
+		require.Contains(t, out, `"derived_roles.alpha" [label="derived_roles.alpha", shape=diamond];`)
+		require.Contains(t, out, `"export_variables.foobar" [label="export_variables.foobar", shape=hexagon];`)
+		require.Contains(t, out, `"schema:cerbos:///principal.json" [label="cerbos:///principal.json", shape=note];`)
+
+		require.Contains(t, out, `"resource.leave_request.vdefault" -> "derived_roles.alpha" [label="imports"];`)
+		require.Contains(t, out, `"resource.leave_request.vdefault" -> "schema:cerbos:///principal.json" [label="schema"];`)
+		require.Contains(t, out, `"derived_roles.import_variables" -> "export_variables.foobar" [label="imports"];`)
+		require.Contains(t, out, `"principal.scrooge_mcduck.vdefault" -> "export_variables.foobar" [label="imports"];`)
+		require.Contains(t, out, `"principal.donald_duck.vdefault/acme.hr" -> "principal.donald_duck.vdefault/acme" [label="parent scope"];`)
+
+		again, err := run(t)
+		require.NoError(t, err)
+		require.Equal(t, out, again, "Output must be stable")
+	})
+
+	t.Run("focus", func(t *testing.T) {
+		out, err := run(t, "--focus=export_variables.foobar")
+		require.NoError(t, err)
+
+		require.Contains(t, out, `"derived_roles.import_variables" -> "export_variables.foobar" [label="imports"];`)
+		require.Contains(t, out, `"resource.import_variables.vdefault" -> "export_variables.foobar" [label="imports"];`)
+		require.NotContains(t, out, `"resource.leave_request.vdefault"`)
+	})
+
+	t.Run("focus_depth", func(t *testing.T) {
+		out, err := run(t, "--focus=principal.donald_duck.vdefault/acme.hr", "--depth=2")
+		require.NoError(t, err)
+
+		require.Contains(t, out, `"principal.donald_duck.vdefault/acme.hr" -> "principal.donald_duck.vdefault/acme" [label="parent scope"];`)
+		require.Contains(t, out, `"principal.donald_duck.vdefault/acme" -> "principal.donald_duck.vdefault" [label="parent scope"];`)
+		require.NotContains(t, out, `"principal.donald_duck.v20210210"`)
+	})
+
+	t.Run("unknown_focus", func(t *testing.T) {
+		_, err := run(t, "--focus=resource.wibble.vdefault")
+		require.Error(t, err)
+	})
+}
@@ -18,18 +18,21 @@ func main() {
 		kong.UsageOnError(),
 	)
 
-	c, err := client.GetClient(&cli.Globals)
-	if err != nil {
-		ctx.Fatalf("failed to get the client: %v", err)
-	}
+	clientCtx := &client.Context{}
+	if cli.RequiresServer(ctx.Command()) {
+		c, err := client.GetClient(&cli.Globals)
+		if err != nil {
+			ctx.Fatalf("failed to get the client: %v", err)
+		}
+
+		ac, err := client.GetAdminClient(&cli.Globals)
+		if err != nil {
+			ctx.Fatalf("failed to get the admin client: %v", err)
+		}
 
-	ac, err := client.GetAdminClient(&cli.Globals)
-	if err != nil {
-		ctx.Fatalf("failed to get the admin client: %v", err)
+		clientCtx.Client = c
+		clientCtx.AdminClient = ac
 	}
 
-	ctx.FatalIfErrorf(ctx.Run(&cli.Globals, &client.Context{
-		Client:      c,
-		AdminClient: ac,
-	}))
+	ctx.FatalIfErrorf(ctx.Run(&cli.Globals, clientCtx))
 }
@@ -0,0 +1,292 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"github.com/olekukonko/tablewriter"
+	"go.uber.org/zap"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const (
+	formatTable = "table"
+	formatCSV   = "csv"
+	formatJSON  = "json"
+
+	accessReviewHelp = `# List the grants conferred by all the policies in a directory
+cerbosctl report access-review path/to/policies
+
+# Only include the grants for a resource kind and write them as CSV
+cerbosctl report access-review path/to/policies --resource=leave_request --format=csv > grants.csv`
+)
+
+// Cmd generates reports from a local policy repository.
+type Cmd struct {
+	AccessReview AccessReviewCmd `cmd:"" name:"access-review" help:"List the actions that each role and principal is granted on each resource kind"`
+}
+
+// AccessReviewCmd lists the grants conferred by the policies in a local policy repository.
+// The grants are derived from the compiled policies without evaluating any requests, so conditional grants are only marked as such.
+type AccessReviewCmd struct {
+	Dir      string   `arg:"" help:"Policy repository directory" type:"path"`
+	Format   string   `help:"Output format" default:"table" enum:"table,csv,json"`
+	Resource []string `help:"Only include grants on these resource kinds"`
+}
+
+func (c *AccessReviewCmd) Run(k *kong.Kong) error {
+	fsys, err := util.OpenDirectoryFS(c.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to open policy repository at %q: %w", c.Dir, err)
+	}
+
+	ctx := context.Background()
+	idx, err := index.Build(ctx, fsys, index.WithBuildFailureLogLevel(zap.DebugLevel))
+	if err != nil {
+		idxErr := new(index.BuildError)
+		if errors.As(err, &idxErr) {
+			return fmt.Errorf("failed to load policy repository at %q: %w (run `cerbos compile` for details)", c.Dir, idxErr)
+		}
+
+		return fmt.Errorf("failed to load policy repository at %q: %w", c.Dir, err)
+	}
+	defer idx.Close()
+
+	schemaMgr := schema.NewNopManager()
+	compileOpts := compile.OptionsFromConf(compile.DefaultConf())
+	var grants []Grant
+	for unit := range idx.GetAllCompilationUnits(ctx) {
+		rps, err := compile.Compile(unit, schemaMgr, compileOpts...)
+		if err != nil {
+			return fmt.Errorf("failed to compile %q: %w (run `cerbos compile` for details)", unit.MainSourceFile(), err)
+		}
+
+		grants = append(grants, collectGrants(rps)...)
+	}
+
+	grants = filterGrants(grants, c.Resource)
+	sortGrants(grants)
+
+	return writeGrants(k.Stdout, c.Format, grants)
+}
+
+func (c *AccessReviewCmd) Help() string {
+	return accessReviewHelp
+}
+
+// Grant is an action that a role or a principal is allowed or denied on a resource kind.
+type Grant struct {
+	// Role or principal ID (prefixed with `principal:`) that the grant applies to.
+	Grantee string `json:"grantee"`
+	// DerivedRole that confers the grant, if the grant comes from a derived role.
+	DerivedRole string `json:"derivedRole,omitempty"`
+	Resource    string `json:"resource"`
+	Version     string `json:"version"`
+	Scope       string `json:"scope,omitempty"`
+	Action      string `json:"action"`
+	Effect      string `json:"effect"`
+	Rule        string `json:"rule"`
+	// Conditional is true if the rule or the derived role has a condition.
+	Conditional bool `json:"conditional"`
+}
+
+// collectGrants lists the grants conferred by the policy at the most specific scope of the policy set.
+// The policies at the ancestor scopes have their own compilation units, so they are collected separately.
+func collectGrants(rps *runtimev1.RunnablePolicySet) []Grant {
+	switch ps := rps.GetPolicySet().(type) {
+	case *runtimev1.RunnablePolicySet_ResourcePolicy:
+		return resourcePolicyGrants(ps.ResourcePolicy)
+	case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+		return principalPolicyGrants(ps.PrincipalPolicy)
+	default:
+		return nil
+	}
+}
+
+func resourcePolicyGrants(rps *runtimev1.RunnableResourcePolicySet) []Grant {
+	if len(rps.Policies) == 0 {
+		return nil
+	}
+
+	p := rps.Policies[0]
+	var grants []Grant
+	for _, rule := range p.Rules {
+		template := Grant{
+			Resource:    rps.Meta.Resource,
+			Version:     rps.Meta.Version,
+			Scope:       p.Scope,
+			Effect:      effectName(rule.Effect),
+			Rule:        namer.RuleFQN(rps.Meta, p.Scope, rule.Name),
+			Conditional: rule.Condition != nil,
+		}
+
+		for action := range rule.Actions {
+			for role := range rule.Roles {
+				g := template
+				g.Grantee = role
+				g.Action = action
+				grants = append(grants, g)
+			}
+
+			for drName := range rule.DerivedRoles {
+				dr, ok := p.DerivedRoles[drName]
+				if !ok {
+					continue
+				}
+
+				for role := range dr.ParentRoles {
+					g := template
+					g.Grantee = role
+					g.DerivedRole = drName
+					g.Action = action
+					g.Conditional = template.Conditional || dr.Condition != nil
+					grants = append(grants, g)
+				}
+			}
+		}
+	}
+
+	return grants
+}
+
+func principalPolicyGrants(pps *runtimev1.RunnablePrincipalPolicySet) []Grant {
+	if len(pps.Policies) == 0 {
+		return nil
+	}
+
+	p := pps.Policies[0]
+	var grants []Grant
+	for resource, rules := range p.ResourceRules {
+		for _, rule := range rules.ActionRules {
+			grants = append(grants, Grant{
+				Grantee:     "principal:" + pps.Meta.Principal,
+				Resource:    resource,
+				Version:     pps.Meta.Version,
+				Scope:       p.Scope,
+				Action:      rule.Action,
+				Effect:      effectName(rule.Effect),
+				Rule:        namer.RuleFQN(pps.Meta, p.Scope, rule.Name),
+				Conditional: rule.Condition != nil,
+			})
+		}
+	}
+
+	return grants
+}
+
+func effectName(effect effectv1.Effect) string {
+	return strings.TrimPrefix(effect.String(), "EFFECT_")
+}
+
+func filterGrants(grants []Grant, resources []string) []Grant {
+	if len(resources) == 0 {
+		return grants
+	}
+
+	include := make(map[string]struct{}, len(resources))
+	for _, r := range resources {
+		include[r] = struct{}{}
+	}
+
+	filtered := grants[:0]
+	for _, g := range grants {
+		if _, ok := include[g.Resource]; ok {
+			filtered = append(filtered, g)
+		}
+	}
+
+	return filtered
+}
+
+func sortGrants(grants []Grant) {
+	sort.Slice(grants, func(i, j int) bool {
+		a, b := grants[i], grants[j]
+		switch {
+		case a.Resource != b.Resource:
+			return a.Resource < b.Resource
+		case a.Version != b.Version:
+			return a.Version < b.Version
+		case a.Scope != b.Scope:
+			return a.Scope < b.Scope
+		case a.Grantee != b.Grantee:
+			return a.Grantee < b.Grantee
+		case a.Action != b.Action:
+			return a.Action < b.Action
+		case a.DerivedRole != b.DerivedRole:
+			return a.DerivedRole < b.DerivedRole
+		default:
+			return a.Rule < b.Rule
+		}
+	})
+}
+
+var grantColumns = []string{"Grantee", "Derived role", "Resource", "Version", "Scope", "Action", "Effect", "Conditional", "Rule"}
+
+func (g Grant) row() []string {
+	return []string{g.Grantee, g.DerivedRole, g.Resource, g.Version, g.Scope, g.Action, g.Effect, strconv.FormatBool(g.Conditional), g.Rule}
+}
+
+func writeGrants(w io.Writer, format string, grants []Grant) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if grants == nil {
+			grants = []Grant{}
+		}
+		return enc.Encode(grants)
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(grantColumns); err != nil {
+			return err
+		}
+
+		for _, g := range grants {
+			if err := cw.Write(g.row()); err != nil {
+				return err
+			}
+		}
+
+		cw.Flush()
+		return cw.Error()
+	default:
+		tw := tablewriter.NewWriter(w)
+		tw.SetAutoWrapText(false)
+		tw.SetAutoFormatHeaders(true)
+		tw.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+		tw.SetAlignment(tablewriter.ALIGN_LEFT)
+		tw.SetCenterSeparator("")
+		tw.SetColumnSeparator("")
+		tw.SetRowSeparator("")
+		tw.SetHeaderLine(false)
+		tw.SetBorder(false)
+		tw.SetTablePadding("\t")
+		tw.SetNoWhiteSpace(true)
+		tw.SetHeader(grantColumns)
+
+		for _, g := range grants {
+			tw.Append(g.row())
+		}
+
+		tw.Render()
+		return nil
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/require"
+
+	cmdclient "github.com/cerbos/cerbos/cmd/cerbosctl/internal/client"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/report"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/root"
+)
+
+const (
+	derivedRoles = `---
+apiVersion: api.cerbos.dev/v1
+derivedRoles:
+  name: common_roles
+  definitions:
+    - name: owner
+      parentRoles: ["user"]
+      condition:
+        match:
+          expr: R.attr.owner == P.id
+`
+
+	resourcePolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: album
+  importDerivedRoles:
+    - common_roles
+  rules:
+    - name: owner-edit
+      actions: ["edit", "delete"]
+      derivedRoles: ["owner"]
+      effect: EFFECT_ALLOW
+    - name: public-view
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: R.attr.public == true
+    - name: admin-all
+      actions: ["*"]
+      roles: ["admin"]
+      effect: EFFECT_ALLOW
+`
+
+	principalPolicy = `---
+apiVersion: api.cerbos.dev/v1
+principalPolicy:
+  version: default
+  principal: bugs_bunny
+  rules:
+    - resource: album
+      actions:
+        - name: no-delete
+          action: delete
+          effect: EFFECT_DENY
+`
+)
+
+func TestAccessReviewCmd(t *testing.T) {
+	dir := t.TempDir()
+	for name, contents := range map[string]string{
+		"derived_roles.yaml": derivedRoles,
+		"album.yaml":         resourcePolicy,
+		"bugs_bunny.yaml":    principalPolicy,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o600))
+	}
+
+	run := func(t *testing.T, args ...string) string {
+		t.Helper()
+
+		out := new(bytes.Buffer)
+		cli := &root.Cli{}
+		p, err := kong.New(cli, kong.Name("cerbosctl"), kong.Writers(out, out))
+		require.NoError(t, err)
+
+		kctx, err := p.Parse(append([]string{"report", "access-review", dir}, args...))
+		require.NoError(t, err)
+		require.False(t, cli.RequiresServer(kctx.Command()))
+
+		require.NoError(t, kctx.Run(&cli.Globals, &cmdclient.Context{}))
+		return out.String()
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var have []report.Grant
+		require.NoError(t, json.Unmarshal([]byte(run(t, "--format=json")), &have))
+
+		want := []report.Grant{
+			{Grantee: "admin", Resource: "album", Version: "default", Action: "*", Effect: "ALLOW", Rule: "resource.album.vdefault#admin-all"},
+			{Grantee: "principal:bugs_bunny", Resource: "album", Version: "default", Action: "delete", Effect: "DENY", Rule: "principal.bugs_bunny.vdefault#no-delete"},
+			{Grantee: "user", DerivedRole: "owner", Resource: "album", Version: "default", Action: "delete", Effect: "ALLOW", Rule: "resource.album.vdefault#owner-edit", Conditional: true},
+			{Grantee: "user", DerivedRole: "owner", Resource: "album", Version: "default", Action: "edit", Effect: "ALLOW", Rule: "resource.album.vdefault#owner-edit", Conditional: true},
+			{Grantee: "user", Resource: "album", Version: "default", Action: "view", Effect: "ALLOW", Rule: "resource.album.vdefault#public-view", Conditional: true},
+		}
+		require.Equal(t, want, have)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		out := run(t, "--format=csv")
+		require.Contains(t, out, "Grantee,Derived role,Resource,Version,Scope,Action,Effect,Conditional,Rule\n")
+		require.Contains(t, out, "user,owner,album,default,,edit,ALLOW,true,resource.album.vdefault#owner-edit\n")
+	})
+
+	t.Run("table", func(t *testing.T) {
+		out := run(t)
+		require.Contains(t, out, "GRANTEE")
+		require.Contains(t, out, "resource.album.vdefault#public-view")
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		var have []report.Grant
+		require.NoError(t, json.Unmarshal([]byte(run(t, "--format=json", "--resource=photo")), &have))
+		require.Empty(t, have)
+	})
+
+	t.Run("disabled_cel_extension", func(t *testing.T) {
+		extDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(extDir, "album.yaml"), []byte(`---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: album
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: sets.contains(R.attr.tags, ["public"])
+`), 0o600))
+
+		cli := &root.Cli{}
+		p, err := kong.New(cli, kong.Name("cerbosctl"), kong.Writers(new(bytes.Buffer), new(bytes.Buffer)))
+		require.NoError(t, err)
+
+		kctx, err := p.Parse([]string{"report", "access-review", extDir})
+		require.NoError(t, err)
+		require.ErrorContains(t, kctx.Run(&cli.Globals, &cmdclient.Context{}), "failed to compile")
+	})
+}
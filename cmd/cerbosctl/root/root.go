@@ -4,14 +4,18 @@
 package root
 
 import (
+	"strings"
+
 	"github.com/cerbos/cerbos/cmd/cerbosctl/audit"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/decisions"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/del"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/disable"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/enable"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/get"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/graph"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/internal/flagset"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/put"
+	"github.com/cerbos/cerbos/cmd/cerbosctl/report"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/store"
 	"github.com/cerbos/cerbos/cmd/cerbosctl/version"
 )
@@ -47,6 +51,13 @@ type Cli struct {
 	Put       put.Cmd       `cmd:"" help:"Put policies or schemas"`
 	Decisions decisions.Cmd `cmd:"" help:"Interactive decision log viewer"`
 	Audit     audit.Cmd     `cmd:"" help:"View audit logs"`
+	Graph     graph.Cmd     `cmd:"" help:"Write the policy dependency graph of a local policy repository in Graphviz DOT format"`
+	Report    report.Cmd    `cmd:"" help:"Generate reports from a local policy repository"`
+}
+
+// RequiresServer returns false for the commands that work on local files and don't connect to a Cerbos server.
+func (c *Cli) RequiresServer(command string) bool {
+	return !strings.HasPrefix(command, "graph") && !strings.HasPrefix(command, "report")
 }
 
 func (c *Cli) Help() string {
@@ -13,6 +13,8 @@ import (
 
 const (
 	ConfKey = "audit"
+
+	defaultExplanationsMaxSizeBytes = 16 * 1024
 )
 
 // Conf is optional configuration for Audit.
@@ -35,6 +37,15 @@ type confHolder struct {
 	DecisionLogsEnabled bool `yaml:"decisionLogsEnabled" conf:",example=false"`
 	// DecisionLogFilters define the filters to apply while producing decision logs.
 	DecisionLogFilters DecisionLogFilters `yaml:"decisionLogFilters"`
+	// DecisionLogExplanations defines whether and how explanations of the decisions are included in decision logs.
+	DecisionLogExplanations DecisionLogExplanations `yaml:"decisionLogExplanations"`
+}
+
+type DecisionLogExplanations struct {
+	// Enabled includes the rules that determined the effect of each action, and the results of their conditions, in CheckResources decision logs.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// MaxSizeBytes is the maximum total size of the explanations in a single decision log entry. Explanations that don't fit are dropped and the entry is marked as truncated. Set to 0 to remove the limit.
+	MaxSizeBytes uint `yaml:"maxSizeBytes" conf:",example=16384"`
 }
 
 type DecisionLogFilters struct {
@@ -83,7 +94,11 @@ func (c *Conf) UnmarshalYAML(unmarshal func(any) error) error {
 		return fmt.Errorf("failed to marshal audit config [%v]: %w", confMap, err)
 	}
 
-	c.confHolder = confHolder{AccessLogsEnabled: true, DecisionLogsEnabled: true}
+	c.confHolder = confHolder{
+		AccessLogsEnabled:       true,
+		DecisionLogsEnabled:     true,
+		DecisionLogExplanations: DecisionLogExplanations{MaxSizeBytes: defaultExplanationsMaxSizeBytes},
+	}
 	return yaml.Unmarshal(yamlBytes, &c.confHolder)
 }
 
@@ -94,6 +109,7 @@ func (c *Conf) Key() string {
 func (c *Conf) SetDefaults() {
 	c.AccessLogsEnabled = true
 	c.DecisionLogsEnabled = true
+	c.DecisionLogExplanations.MaxSizeBytes = defaultExplanationsMaxSizeBytes
 }
 
 func GetConf() (*Conf, error) {
@@ -46,6 +46,20 @@ func CallIDFromContext(ctx context.Context) (ID, bool) {
 	return id, true
 }
 
+type forceDecisionLogCtxKeyType struct{}
+
+var forceDecisionLogCtxKey = forceDecisionLogCtxKeyType{}
+
+// NewContextWithForcedDecisionLog returns a context that causes decision log entries written with it to be logged even if decision logs are disabled.
+func NewContextWithForcedDecisionLog(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceDecisionLogCtxKey, true)
+}
+
+func decisionLogForced(ctx context.Context) bool {
+	forced, _ := ctx.Value(forceDecisionLogCtxKey).(bool)
+	return forced
+}
+
 func PeerFromContext(ctx context.Context) *auditv1.Peer {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
@@ -42,6 +42,11 @@ func NewDecisionLogEntryFilterFromConf(conf *Conf) DecisionLogEntryFilter {
 			return nil
 		}
 
+		// Break-glass decisions must always be logged.
+		if entry.BreakGlass {
+			return entry
+		}
+
 		switch mt := entry.Method.(type) {
 		case *auditv1.DecisionLogEntry_CheckResources_:
 			if cr := filterCheckResources(mt.CheckResources); cr != nil {
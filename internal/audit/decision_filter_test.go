@@ -90,6 +90,26 @@ func TestDecisionLogEntryFilter(t *testing.T) {
 			input: mkPlanResourcesLogEntry(enginev1.PlanResourcesFilter_KIND_CONDITIONAL),
 			want:  mkPlanResourcesLogEntry(enginev1.PlanResourcesFilter_KIND_CONDITIONAL),
 		},
+		{
+			name: "CheckResources/OnlyDenyResponses/BreakGlass",
+			filters: DecisionLogFilters{
+				CheckResources: CheckResourcesFilter{
+					IgnoreAllowAll: true,
+				},
+			},
+			input: withBreakGlass(mkCheckResourcesLogEntry(false)),
+			want:  withBreakGlass(mkCheckResourcesLogEntry(false)),
+		},
+		{
+			name: "PlanResources/IgnoreAll/BreakGlass",
+			filters: DecisionLogFilters{
+				PlanResources: PlanResourcesFilter{
+					IgnoreAll: true,
+				},
+			},
+			input: withBreakGlass(mkPlanResourcesLogEntry(enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED)),
+			want:  withBreakGlass(mkPlanResourcesLogEntry(enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED)),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -105,6 +125,11 @@ func TestDecisionLogEntryFilter(t *testing.T) {
 	}
 }
 
+func withBreakGlass(entry *auditv1.DecisionLogEntry) *auditv1.DecisionLogEntry {
+	entry.BreakGlass = true
+	return entry
+}
+
 func mkCheckResourcesLogEntry(includeDeny bool) *auditv1.DecisionLogEntry {
 	outputs := []*enginev1.CheckOutput{
 		{
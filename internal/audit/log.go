@@ -151,7 +151,8 @@ func (lw *logWrapper) WriteAccessLogEntry(ctx context.Context, entry AccessLogEn
 }
 
 func (lw *logWrapper) WriteDecisionLogEntry(ctx context.Context, entry DecisionLogEntryMaker) error {
-	if !lw.conf.DecisionLogsEnabled {
+	// Forced entries are written even if decision logs are disabled, as long as an audit backend is configured.
+	if lw.backend == nil || (!lw.conf.DecisionLogsEnabled && !decisionLogForced(ctx)) {
 		return nil
 	}
 
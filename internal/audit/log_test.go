@@ -11,6 +11,7 @@ import (
 
 	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
 	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/config"
 )
 
 func TestNopLog(t *testing.T) {
@@ -36,7 +37,75 @@ func TestNopLog(t *testing.T) {
 		require.False(t, recordMakerCalled)
 	})
 
+	t.Run("writeForcedDecisionLogEntry", func(t *testing.T) {
+		recordMakerCalled := false
+		err := log.WriteDecisionLogEntry(audit.NewContextWithForcedDecisionLog(context.Background()), func() (*auditv1.DecisionLogEntry, error) {
+			recordMakerCalled = true
+			return &auditv1.DecisionLogEntry{}, nil
+		})
+		require.NoError(t, err)
+		require.False(t, recordMakerCalled)
+	})
+
 	t.Run("close", func(_ *testing.T) {
 		log.Close()
 	})
 }
+
+func TestForcedDecisionLog(t *testing.T) {
+	backend := &countingBackend{}
+	audit.RegisterBackend("counting", func(context.Context, *config.Wrapper, audit.DecisionLogEntryFilter) (audit.Log, error) {
+		return backend, nil
+	})
+
+	confW, err := config.WrapperFromMap(map[string]any{
+		"audit": map[string]any{
+			"enabled":             true,
+			"backend":             "counting",
+			"decisionLogsEnabled": false,
+		},
+	})
+	require.NoError(t, err)
+
+	log, err := audit.NewLogFromConf(context.Background(), confW)
+	require.NoError(t, err)
+
+	mkEntry := func() (*auditv1.DecisionLogEntry, error) {
+		return &auditv1.DecisionLogEntry{BreakGlass: true}, nil
+	}
+
+	require.NoError(t, log.WriteDecisionLogEntry(context.Background(), mkEntry))
+	require.Zero(t, backend.decisions, "Decision must not be logged when decision logs are disabled")
+
+	require.NoError(t, log.WriteDecisionLogEntry(audit.NewContextWithForcedDecisionLog(context.Background()), mkEntry))
+	require.Equal(t, 1, backend.decisions, "Forced decision must be logged")
+}
+
+type countingBackend struct {
+	decisions int
+}
+
+func (b *countingBackend) Backend() string {
+	return "counting"
+}
+
+func (b *countingBackend) Enabled() bool {
+	return true
+}
+
+func (b *countingBackend) WriteAccessLogEntry(context.Context, audit.AccessLogEntryMaker) error {
+	return nil
+}
+
+func (b *countingBackend) WriteDecisionLogEntry(_ context.Context, maker audit.DecisionLogEntryMaker) error {
+	if _, err := maker(); err != nil {
+		return err
+	}
+
+	b.decisions++
+	return nil
+}
+
+func (b *countingBackend) Close() error {
+	return nil
+}
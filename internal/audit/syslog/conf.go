@@ -0,0 +1,120 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/audit"
+)
+
+const (
+	confKey = audit.ConfKey + ".syslog"
+
+	NetworkUDP = "udp"
+	NetworkTCP = "tcp"
+	NetworkTLS = "tls"
+
+	defaultAppName  = "cerbos"
+	defaultFacility = "local0"
+	defaultNetwork  = NetworkUDP
+	defaultTimeout  = 5 * time.Second
+)
+
+var facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// Conf is optional configuration for syslog Audit.
+type Conf struct {
+	// TLS configures the TLS connection when the network is "tls".
+	TLS *TLSConf `yaml:"tls"`
+	// Network is the transport used to reach the syslog server. Valid values are "udp" (default), "tcp" and "tls".
+	Network string `yaml:"network" conf:",example=udp"`
+	// Address is the host:port of the syslog server.
+	Address string `yaml:"address" conf:"required,example=\"localhost:514\""`
+	// AppName is the APP-NAME reported in the syslog messages. Defaults to "cerbos".
+	AppName string `yaml:"appName" conf:",example=cerbos"`
+	// Facility is the syslog facility of the messages. Valid values are kern, user, mail, daemon, auth, syslog, lpr, news, uucp, cron, authpriv, ftp and local0 (default) to local7.
+	Facility string `yaml:"facility" conf:",example=local0"`
+	// Timeout is the maximum time to wait for connecting to the server and writing a message. Defaults to 5s.
+	Timeout time.Duration `yaml:"timeout" conf:",example=5s"`
+}
+
+type TLSConf struct {
+	// CAPath is the path to the CA certificate used to verify the server.
+	CAPath string `yaml:"caPath" conf:"required,example=/path/to/ca.crt"`
+	// CertPath is the path to the client certificate.
+	CertPath string `yaml:"certPath" conf:",example=/path/to/tls.cert"`
+	// KeyPath is the path to the client key.
+	KeyPath string `yaml:"keyPath" conf:",example=/path/to/tls.key"`
+	// InsecureSkipVerify controls whether the server's certificate chain and host name are verified. Default is false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify" conf:",example=false"`
+}
+
+func (c *Conf) Key() string {
+	return confKey
+}
+
+func (c *Conf) SetDefaults() {
+	c.Network = defaultNetwork
+	c.AppName = defaultAppName
+	c.Facility = defaultFacility
+	c.Timeout = defaultTimeout
+}
+
+func (c *Conf) Validate() (outErr error) {
+	switch c.Network {
+	case NetworkUDP, NetworkTCP:
+	case NetworkTLS:
+		if c.TLS == nil || strings.TrimSpace(c.TLS.CAPath) == "" {
+			outErr = multierr.Append(outErr, errors.New("tls.caPath must be set when the network is tls"))
+		}
+	default:
+		outErr = multierr.Append(outErr, fmt.Errorf("invalid network %q: valid values are udp, tcp and tls", c.Network))
+	}
+
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		outErr = multierr.Append(outErr, fmt.Errorf("invalid address %q: %w", c.Address, err))
+	}
+
+	if _, ok := facilities[c.Facility]; !ok {
+		outErr = multierr.Append(outErr, fmt.Errorf("invalid facility %q", c.Facility))
+	}
+
+	if strings.TrimSpace(c.AppName) == "" {
+		outErr = multierr.Append(outErr, errors.New("appName must not be empty"))
+	}
+
+	if c.Timeout <= 0 {
+		outErr = multierr.Append(outErr, errors.New("timeout must be positive"))
+	}
+
+	return outErr
+}
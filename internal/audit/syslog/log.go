@@ -0,0 +1,107 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+const Backend = "syslog"
+
+func init() {
+	audit.RegisterBackend(Backend, func(_ context.Context, confW *config.Wrapper, decisionFilter audit.DecisionLogEntryFilter) (audit.Log, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, fmt.Errorf("failed to read syslog audit log configuration: %w", err)
+		}
+
+		return NewLog(conf, decisionFilter)
+	})
+}
+
+// Log writes audit entries to a syslog server as RFC 5424 messages.
+// A summary of each entry is included as structured data and the full entry is the JSON encoded message body.
+type Log struct {
+	transport      *transport
+	decisionFilter audit.DecisionLogEntryFilter
+	header         header
+}
+
+func NewLog(conf *Conf, decisionFilter audit.DecisionLogEntryFilter) (*Log, error) {
+	t, err := newTransport(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = nilValue
+	}
+
+	return &Log{
+		transport:      t,
+		decisionFilter: decisionFilter,
+		header: header{
+			hostname: hostname,
+			appName:  conf.AppName,
+			procID:   strconv.Itoa(os.Getpid()),
+			facility: facilities[conf.Facility],
+		},
+	}, nil
+}
+
+func (l *Log) Backend() string {
+	return Backend
+}
+
+func (l *Log) Enabled() bool {
+	return true
+}
+
+func (l *Log) WriteAccessLogEntry(_ context.Context, record audit.AccessLogEntryMaker) error {
+	rec, err := record()
+	if err != nil {
+		return err
+	}
+
+	body, err := protojson.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal access log entry: %w", err)
+	}
+
+	return l.transport.write(l.header.format(entryTime(rec.Timestamp), audit.KindAccess, accessLogSD(rec), body))
+}
+
+func (l *Log) WriteDecisionLogEntry(_ context.Context, record audit.DecisionLogEntryMaker) error {
+	rec, err := record()
+	if err != nil {
+		return err
+	}
+
+	if l.decisionFilter != nil {
+		rec = l.decisionFilter(rec)
+		if rec == nil {
+			return nil
+		}
+	}
+
+	body, err := protojson.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal decision log entry: %w", err)
+	}
+
+	return l.transport.write(l.header.format(entryTime(rec.Timestamp), audit.KindDecision, decisionLogSD(rec), body))
+}
+
+func (l *Log) Close() error {
+	return l.transport.Close()
+}
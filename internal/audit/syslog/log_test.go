@@ -0,0 +1,234 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/audit/syslog"
+)
+
+const timeout = 5 * time.Second
+
+var ts = time.Date(2023, 6, 1, 10, 30, 15, 123456000, time.UTC)
+
+func TestDecisionLogMessage(t *testing.T) {
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	// local0 (16) * 8 + info (6) = 134
+	wantHeader := fmt.Sprintf(`<134>1 2023-06-01T10:30:15.123456Z %s cerbos %d decision `, hostname, os.Getpid())
+	wantSD := `[decision@32473 callId="01H0000000000000000000000" peer="10.0.0.1:4242" method="CheckResources" principal="alice" resources="1" allowed="1" denied="1"]`
+
+	checkMessage := func(t *testing.T, msg string) {
+		t.Helper()
+
+		require.True(t, strings.HasPrefix(msg, wantHeader), "Unexpected header in %q", msg)
+		rest := strings.TrimPrefix(msg, wantHeader)
+		require.True(t, strings.HasPrefix(rest, wantSD), "Unexpected structured data in %q", rest)
+
+		var entry auditv1.DecisionLogEntry
+		require.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(rest, wantSD+" ")), &entry))
+		require.Equal(t, "01H0000000000000000000000", entry.CallId)
+	}
+
+	t.Run("udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+
+		log := mkLog(t, syslog.NetworkUDP, conn.LocalAddr().String())
+		require.NoError(t, log.WriteDecisionLogEntry(context.Background(), mkDecisionLogEntry))
+
+		buf := make([]byte, 64*1024)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(timeout)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+
+		checkMessage(t, string(buf[:n]))
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = lis.Close() })
+
+		log := mkLog(t, syslog.NetworkTCP, lis.Addr().String())
+		require.NoError(t, log.WriteDecisionLogEntry(context.Background(), mkDecisionLogEntry))
+
+		conn, err := lis.Accept()
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+
+		checkMessage(t, readFramed(t, bufio.NewReader(conn)))
+	})
+}
+
+func TestAccessLogMessage(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	log := mkLog(t, syslog.NetworkUDP, conn.LocalAddr().String())
+	require.NoError(t, log.WriteAccessLogEntry(context.Background(), func() (*auditv1.AccessLogEntry, error) {
+		return &auditv1.AccessLogEntry{
+			CallId:     "01H0000000000000000000000",
+			Timestamp:  timestamppb.New(ts),
+			Peer:       &auditv1.Peer{Address: "10.0.0.1:4242"},
+			Method:     "/cerbos.svc.v1.CerbosService/CheckResources",
+			StatusCode: 0,
+		}, nil
+	}))
+
+	buf := make([]byte, 64*1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(timeout)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	re := regexp.MustCompile(`^<134>1 2023-06-01T10:30:15\.123456Z \S+ cerbos \d+ access \[access@32473 callId="01H0000000000000000000000" method="/cerbos\.svc\.v1\.CerbosService/CheckResources" statusCode="0" peer="10\.0\.0\.1:4242"\] \{.+\}$`)
+	require.Regexp(t, re, string(buf[:n]))
+}
+
+func TestReconnect(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+
+	log := mkLog(t, syslog.NetworkTCP, lis.Addr().String())
+	require.NoError(t, log.WriteDecisionLogEntry(context.Background(), mkDecisionLogEntry))
+
+	// Receive the first message and drop the connection.
+	conn, err := lis.Accept()
+	require.NoError(t, err)
+	readFramed(t, bufio.NewReader(conn))
+	require.NoError(t, conn.Close())
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		if c, err := lis.Accept(); err == nil {
+			accepted <- c
+		}
+	}()
+
+	// Writes to the broken connection don't necessarily fail straight away, so keep writing until the client reconnects.
+	var newConn net.Conn
+	require.Eventually(t, func() bool {
+		_ = log.WriteDecisionLogEntry(context.Background(), mkDecisionLogEntry)
+		select {
+		case newConn = <-accepted:
+			return true
+		default:
+			return false
+		}
+	}, timeout, 10*time.Millisecond)
+	t.Cleanup(func() { _ = newConn.Close() })
+
+	require.Contains(t, readFramed(t, bufio.NewReader(newConn)), "[decision@32473 ")
+}
+
+func TestEscaping(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	log := mkLog(t, syslog.NetworkUDP, conn.LocalAddr().String())
+	require.NoError(t, log.WriteDecisionLogEntry(context.Background(), func() (*auditv1.DecisionLogEntry, error) {
+		entry, _ := mkDecisionLogEntry()
+		entry.GetCheckResources().Inputs[0].Principal.Id = `a"b\c]d`
+		return entry, nil
+	}))
+
+	buf := make([]byte, 64*1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(timeout)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	require.Contains(t, string(buf[:n]), `principal="a\"b\\c\]d"`)
+}
+
+func mkLog(t *testing.T, network, address string) *syslog.Log {
+	t.Helper()
+
+	conf := &syslog.Conf{}
+	conf.SetDefaults()
+	conf.Network = network
+	conf.Address = address
+	require.NoError(t, conf.Validate())
+
+	log, err := syslog.NewLog(conf, audit.NewDecisionLogEntryFilterFromConf(&audit.Conf{}))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = log.Close() })
+
+	require.Equal(t, syslog.Backend, log.Backend())
+	require.True(t, log.Enabled())
+
+	return log
+}
+
+// readFramed reads a message framed using octet counting.
+func readFramed(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+
+	lenStr, err := r.ReadString(' ')
+	require.NoError(t, err)
+
+	n, err := strconv.Atoi(strings.TrimSuffix(lenStr, " "))
+	require.NoError(t, err)
+
+	msg := make([]byte, n)
+	_, err = io.ReadFull(r, msg)
+	require.NoError(t, err)
+
+	return string(msg)
+}
+
+func mkDecisionLogEntry() (*auditv1.DecisionLogEntry, error) {
+	inputs := []*enginev1.CheckInput{
+		{
+			RequestId: "test",
+			Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Actions:   []string{"view", "edit"},
+		},
+	}
+	outputs := []*enginev1.CheckOutput{
+		{
+			RequestId:  "test",
+			ResourceId: "doc1",
+			Actions: map[string]*enginev1.CheckOutput_ActionEffect{
+				"view": {Effect: effectv1.Effect_EFFECT_ALLOW, Policy: "resource.document.vdefault"},
+				"edit": {Effect: effectv1.Effect_EFFECT_DENY, Policy: "resource.document.vdefault"},
+			},
+		},
+	}
+
+	return &auditv1.DecisionLogEntry{
+		CallId:    "01H0000000000000000000000",
+		Timestamp: timestamppb.New(ts),
+		Peer:      &auditv1.Peer{Address: "10.0.0.1:4242"},
+		Method: &auditv1.DecisionLogEntry_CheckResources_{
+			CheckResources: &auditv1.DecisionLogEntry_CheckResources{
+				Inputs:  inputs,
+				Outputs: outputs,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+)
+
+const (
+	// The SD-ID of the structured data elements uses the private enterprise number reserved for documentation (RFC 5612).
+	sdIDSuffix = "@32473"
+
+	severityInfo = 6
+	nilValue     = "-"
+	version      = "1"
+	timeFormat   = "2006-01-02T15:04:05.000000Z07:00"
+
+	maxHostnameLen = 255
+	maxAppNameLen  = 48
+	maxProcIDLen   = 128
+)
+
+// header holds the fields of the RFC 5424 header that are the same for all messages.
+type header struct {
+	hostname string
+	appName  string
+	procID   string
+	facility int
+}
+
+type sdParam struct {
+	name  string
+	value string
+}
+
+type sdElement struct {
+	id     string
+	params []sdParam
+}
+
+func (e *sdElement) add(name, value string) {
+	if value != "" {
+		e.params = append(e.params, sdParam{name: name, value: value})
+	}
+}
+
+// format produces the RFC 5424 representation of a message.
+func (h header) format(ts time.Time, msgID string, sd sdElement, msg []byte) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteByte('<')
+	buf.WriteString(strconv.Itoa(h.facility*8 + severityInfo)) //nolint:gomnd
+	buf.WriteByte('>')
+	buf.WriteString(version)
+	buf.WriteByte(' ')
+	if ts.IsZero() {
+		buf.WriteString(nilValue)
+	} else {
+		buf.WriteString(ts.UTC().Format(timeFormat))
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(headerField(h.hostname, maxHostnameLen))
+	buf.WriteByte(' ')
+	buf.WriteString(headerField(h.appName, maxAppNameLen))
+	buf.WriteByte(' ')
+	buf.WriteString(headerField(h.procID, maxProcIDLen))
+	buf.WriteByte(' ')
+	buf.WriteString(msgID)
+	buf.WriteByte(' ')
+
+	buf.WriteByte('[')
+	buf.WriteString(sd.id)
+	for _, p := range sd.params {
+		buf.WriteByte(' ')
+		buf.WriteString(p.name)
+		buf.WriteString(`="`)
+		buf.WriteString(escapeParamValue(p.value))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte(']')
+
+	if len(msg) > 0 {
+		buf.WriteByte(' ')
+		buf.Write(msg)
+	}
+
+	return buf.Bytes()
+}
+
+func entryTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+// headerField replaces characters that are not allowed in header fields and truncates the value to the maximum length.
+func headerField(value string, maxLen int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 { //nolint:gomnd
+			return '_'
+		}
+		return r
+	}, value)
+
+	if value == "" {
+		return nilValue
+	}
+
+	if len(value) > maxLen {
+		return value[:maxLen]
+	}
+
+	return value
+}
+
+var paramValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func escapeParamValue(value string) string {
+	return paramValueEscaper.Replace(value)
+}
+
+func accessLogSD(entry *auditv1.AccessLogEntry) sdElement {
+	sd := sdElement{id: "access" + sdIDSuffix}
+	sd.add("callId", entry.CallId)
+	sd.add("method", entry.Method)
+	sd.add("statusCode", strconv.FormatUint(uint64(entry.StatusCode), 10))
+	sd.add("peer", entry.Peer.GetAddress())
+
+	return sd
+}
+
+func decisionLogSD(entry *auditv1.DecisionLogEntry) sdElement {
+	sd := sdElement{id: "decision" + sdIDSuffix}
+	sd.add("callId", entry.CallId)
+	sd.add("peer", entry.Peer.GetAddress())
+	if entry.BreakGlass {
+		sd.add("breakGlass", "true")
+	}
+
+	switch m := entry.Method.(type) {
+	case *auditv1.DecisionLogEntry_CheckResources_:
+		sd.add("method", "CheckResources")
+		if inputs := m.CheckResources.Inputs; len(inputs) > 0 {
+			sd.add("principal", inputs[0].Principal.GetId())
+		}
+
+		var allowed, denied int
+		for _, out := range m.CheckResources.Outputs {
+			for _, ae := range out.Actions {
+				if ae.Effect == effectv1.Effect_EFFECT_ALLOW {
+					allowed++
+				} else {
+					denied++
+				}
+			}
+		}
+		sd.add("resources", strconv.Itoa(len(m.CheckResources.Inputs)))
+		sd.add("allowed", strconv.Itoa(allowed))
+		sd.add("denied", strconv.Itoa(denied))
+		sd.add("error", m.CheckResources.Error)
+
+	case *auditv1.DecisionLogEntry_PlanResources_:
+		sd.add("method", "PlanResources")
+		sd.add("principal", m.PlanResources.Input.GetPrincipal().GetId())
+		sd.add("kind", m.PlanResources.Output.GetKind())
+		sd.add("action", m.PlanResources.Output.GetAction())
+		if filter := m.PlanResources.Output.GetFilter(); filter != nil {
+			sd.add("filter", filter.Kind.String())
+		}
+		sd.add("error", m.PlanResources.Error)
+	}
+
+	return sd
+}
@@ -0,0 +1,157 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// transport delivers syslog messages to the server, reconnecting whenever a write fails.
+// Messages sent over stream transports (TCP and TLS) are framed using octet counting as described in RFC 6587 and RFC 5425.
+type transport struct {
+	conn      net.Conn
+	tlsConf   *tls.Config
+	network   string
+	address   string
+	timeout   time.Duration
+	mu        sync.Mutex
+	streaming bool
+}
+
+func newTransport(conf *Conf) (*transport, error) {
+	t := &transport{
+		network:   conf.Network,
+		address:   conf.Address,
+		timeout:   conf.Timeout,
+		streaming: conf.Network != NetworkUDP,
+	}
+
+	if conf.Network == NetworkTLS {
+		tlsConf, err := newTLSConfig(conf.TLS)
+		if err != nil {
+			return nil, err
+		}
+		t.tlsConf = tlsConf
+	}
+
+	return t, nil
+}
+
+func (t *transport) write(msg []byte) error {
+	if t.streaming {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// If the connection was broken, the first write fails and the message is retried on a new connection.
+	if t.conn != nil {
+		if err := t.writeToConn(msg); err == nil {
+			return nil
+		}
+		t.closeConn()
+	}
+
+	if err := t.connect(); err != nil {
+		return err
+	}
+
+	if err := t.writeToConn(msg); err != nil {
+		t.closeConn()
+		return fmt.Errorf("failed to write to syslog server: %w", err)
+	}
+
+	return nil
+}
+
+func (t *transport) writeToConn(msg []byte) error {
+	if err := t.conn.SetWriteDeadline(time.Now().Add(t.timeout)); err != nil {
+		return err
+	}
+
+	_, err := t.conn.Write(msg)
+	return err
+}
+
+func (t *transport) connect() error {
+	var conn net.Conn
+	var err error
+
+	dialer := &net.Dialer{Timeout: t.timeout}
+	switch t.network {
+	case NetworkTLS:
+		conn, err = tls.DialWithDialer(dialer, "tcp", t.address, t.tlsConf)
+	default:
+		conn, err = dialer.Dial(t.network, t.address)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog server %q: %w", t.address, err)
+	}
+
+	t.conn = conn
+	return nil
+}
+
+func (t *transport) closeConn() {
+	if t.conn != nil {
+		_ = t.conn.Close()
+		t.conn = nil
+	}
+}
+
+func (t *transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.conn == nil {
+		return nil
+	}
+
+	err := t.conn.Close()
+	t.conn = nil
+	return err
+}
+
+func newTLSConfig(conf *TLSConf) (*tls.Config, error) {
+	if conf == nil {
+		return nil, errors.New("TLS configuration is required")
+	}
+
+	caCert, err := os.ReadFile(conf.CAPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("failed to append CA certificate")
+	}
+
+	// #nosec G402
+	tlsConf := &tls.Config{
+		RootCAs:            caCertPool,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conf.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if conf.CertPath != "" || conf.KeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertPath, conf.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConf, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
@@ -30,11 +31,18 @@ func New(ctx context.Context) (*AuxData, error) {
 }
 
 func NewFromConf(ctx context.Context, conf *Conf) *AuxData {
-	return &AuxData{jwt: newJWTHelper(ctx, conf.JWT)}
+	jh := newJWTHelper(ctx, conf.JWT, conf.CircuitBreaker)
+	jh.deadlineMargin = conf.DeadlineMargin
+	return &AuxData{jwt: jh}
 }
 
 func NewWithoutVerification(ctx context.Context) *AuxData {
-	return &AuxData{jwt: newJWTHelper(ctx, &JWTConf{DisableVerification: true})}
+	return &AuxData{jwt: newJWTHelper(ctx, &JWTConf{DisableVerification: true}, nil)}
+}
+
+// VerifiesJWT returns true if JWTs are verified before their claims are made available to the engine.
+func (ad *AuxData) VerifiesJWT() bool {
+	return ad.jwt.verify
 }
 
 // Extract auxiliary data and convert to format expected by the engine.
@@ -53,3 +61,15 @@ func (ad *AuxData) Extract(ctx context.Context, adProto *requestv1.AuxData) (*en
 
 	return &enginev1.AuxData{Jwt: jwtPB}, nil
 }
+
+// resolverContext derives the context to use for calling an external resolver.
+// If the parent context has a deadline, the derived context expires margin earlier so that there's time left to finish processing the request.
+// If the remaining time is shorter than the margin, the returned context is already expired and the resolver call fails fast.
+func resolverContext(ctx context.Context, margin time.Duration) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok || margin <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithDeadline(ctx, deadline.Add(-margin))
+}
@@ -0,0 +1,169 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+type breakerState int64
+
+const (
+	breakerClosed breakerState = iota
+	breakerHalfOpen
+	breakerOpen
+)
+
+// circuitBreaker stops calls to a failing resolver after a number of consecutive failures.
+// Once the breaker has been open for the configured duration, a single call is let through as a probe.
+// The breaker closes if the probe succeeds and opens again if it fails.
+type circuitBreaker struct {
+	openedAt     time.Time
+	now          func() time.Time
+	name         string
+	threshold    uint
+	failures     uint
+	generation   uint64
+	openDuration time.Duration
+	state        breakerState
+	probing      bool
+	mu           sync.Mutex
+}
+
+// breakerTicket identifies a call allowed by the breaker. The outcome of a call is ignored if the breaker has changed state
+// since the call was allowed, so that slow calls can't override the outcome of the calls made after them.
+type breakerTicket struct {
+	generation uint64
+	probe      bool
+}
+
+func newCircuitBreaker(name string, conf *CircuitBreakerConf) *circuitBreaker {
+	cb := &circuitBreaker{
+		name:         name,
+		now:          time.Now,
+		threshold:    conf.FailureThreshold,
+		openDuration: conf.OpenDuration,
+	}
+
+	if cb.threshold == 0 {
+		cb.threshold = defaultFailureThreshold
+	}
+
+	if cb.openDuration <= 0 {
+		cb.openDuration = defaultOpenDuration
+	}
+
+	cb.recordState()
+	return cb
+}
+
+// allow reports whether a call to the resolver should be attempted. The returned ticket must be passed to record with the outcome of the call.
+func (cb *circuitBreaker) allow() (breakerTicket, bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breakerOpen:
+		if cb.now().Sub(cb.openedAt) < cb.openDuration {
+			return breakerTicket{}, false
+		}
+		cb.setState(breakerHalfOpen)
+		cb.probing = true
+		return breakerTicket{generation: cb.generation, probe: true}, true
+	case breakerHalfOpen:
+		if cb.probing {
+			return breakerTicket{}, false
+		}
+		cb.probing = true
+		return breakerTicket{generation: cb.generation, probe: true}, true
+	default:
+		return breakerTicket{generation: cb.generation}, true
+	}
+}
+
+// record updates the breaker with the outcome of a call allowed by allow.
+func (cb *circuitBreaker) record(ticket breakerTicket, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	// The call started before the breaker last changed state, so its outcome is stale.
+	if ticket.generation != cb.generation {
+		return
+	}
+
+	if ticket.probe {
+		cb.probing = false
+	}
+
+	switch {
+	case err == nil:
+		cb.failures = 0
+		cb.setState(breakerClosed)
+	case errors.Is(err, context.Canceled):
+		// The caller went away, which says nothing about the health of the resolver.
+	case ticket.probe:
+		cb.trip()
+	default:
+		cb.failures++
+		if cb.failures >= cb.threshold {
+			cb.trip()
+		}
+	}
+}
+
+func (cb *circuitBreaker) trip() {
+	cb.openedAt = cb.now()
+	cb.setState(breakerOpen)
+}
+
+func (cb *circuitBreaker) setState(state breakerState) {
+	if cb.state == state {
+		return
+	}
+
+	cb.state = state
+	cb.generation++
+	cb.recordState()
+}
+
+func (cb *circuitBreaker) recordState() {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyAuxDataResolver, cb.name)},
+		metrics.AuxDataCircuitBreakerState.M(int64(cb.state)),
+	)
+}
+
+// breakerKeySet guards a keyset that is fetched from an external source with a circuit breaker.
+type breakerKeySet struct {
+	inner   keySet
+	breaker *circuitBreaker
+}
+
+func (bks breakerKeySet) keySet(ctx context.Context) (jwk.Set, []any, error) {
+	ticket, ok := bks.breaker.allow()
+	if !ok {
+		return nil, nil, errCircuitOpen
+	}
+
+	ks, opts, err := bks.inner.keySet(ctx)
+	bks.breaker.record(ticket, err)
+
+	return ks, opts, err
+}
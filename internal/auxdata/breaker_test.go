@@ -0,0 +1,186 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package auxdata
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/require"
+
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+)
+
+var errResolverDown = errors.New("resolver down")
+
+type fakeResolver struct {
+	err   error
+	calls int
+}
+
+func (fr *fakeResolver) keySet(context.Context) (jwk.Set, []any, error) {
+	fr.calls++
+	if fr.err != nil {
+		return nil, nil, fr.err
+	}
+
+	return jwk.NewSet(), nil, nil
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	resolver := &fakeResolver{err: errResolverDown}
+	breaker := newCircuitBreaker("test", &CircuitBreakerConf{FailureThreshold: 3, OpenDuration: time.Minute})
+	breaker.now = func() time.Time { return now }
+	ks := breakerKeySet{inner: resolver, breaker: breaker}
+
+	call := func() error {
+		_, _, err := ks.keySet(context.Background())
+		return err
+	}
+
+	// Failures below the threshold are passed through.
+	for i := 0; i < 3; i++ {
+		require.ErrorIs(t, call(), errResolverDown)
+	}
+	require.Equal(t, breakerOpen, breaker.state)
+	require.Equal(t, 3, resolver.calls)
+
+	// The resolver is not called while the circuit is open.
+	require.ErrorIs(t, call(), errCircuitOpen)
+	require.Equal(t, 3, resolver.calls)
+
+	// A failed probe opens the circuit again.
+	now = now.Add(time.Minute)
+	require.ErrorIs(t, call(), errResolverDown)
+	require.Equal(t, 4, resolver.calls)
+	require.Equal(t, breakerOpen, breaker.state)
+	require.ErrorIs(t, call(), errCircuitOpen)
+
+	// A successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	resolver.err = nil
+	require.NoError(t, call())
+	require.Equal(t, breakerClosed, breaker.state)
+
+	require.NoError(t, call())
+	require.Equal(t, 6, resolver.calls)
+
+	// The failure count starts again after recovering.
+	resolver.err = errResolverDown
+	for i := 0; i < 2; i++ {
+		require.ErrorIs(t, call(), errResolverDown)
+	}
+	require.Equal(t, breakerClosed, breaker.state)
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker("test", &CircuitBreakerConf{FailureThreshold: 1, OpenDuration: time.Minute})
+	breaker.now = func() time.Time { return now }
+
+	allow := func(t *testing.T) breakerTicket {
+		t.Helper()
+
+		ticket, ok := breaker.allow()
+		require.True(t, ok)
+		return ticket
+	}
+
+	breaker.record(allow(t), errResolverDown)
+	_, ok := breaker.allow()
+	require.False(t, ok)
+
+	now = now.Add(time.Minute)
+	probe := allow(t)
+	require.True(t, probe.probe, "A probe must be allowed after the open duration")
+	_, ok = breaker.allow()
+	require.False(t, ok, "Only one probe must be in flight")
+
+	// A cancelled probe doesn't count as a failure and lets another probe through.
+	breaker.record(probe, context.Canceled)
+	require.Equal(t, breakerHalfOpen, breaker.state)
+	require.True(t, allow(t).probe)
+}
+
+func TestCircuitBreakerStaleOutcomes(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker("test", &CircuitBreakerConf{FailureThreshold: 1, OpenDuration: time.Minute})
+	breaker.now = func() time.Time { return now }
+
+	// Two calls start while the breaker is closed and the first one trips it.
+	slowSuccess, ok := breaker.allow()
+	require.True(t, ok)
+	slowFailure, ok := breaker.allow()
+	require.True(t, ok)
+	tripping, ok := breaker.allow()
+	require.True(t, ok)
+	breaker.record(tripping, errResolverDown)
+	require.Equal(t, breakerOpen, breaker.state)
+
+	now = now.Add(time.Minute)
+	probe, ok := breaker.allow()
+	require.True(t, ok)
+	require.True(t, probe.probe)
+
+	// Outcomes of the calls that started before the breaker tripped don't affect the probe.
+	breaker.record(slowSuccess, nil)
+	require.Equal(t, breakerHalfOpen, breaker.state, "A stale success must not close the breaker")
+
+	breaker.record(slowFailure, errResolverDown)
+	require.Equal(t, breakerHalfOpen, breaker.state, "A stale failure must not open the breaker")
+	_, ok = breaker.allow()
+	require.False(t, ok, "A stale failure must not let a second probe through")
+
+	breaker.record(probe, errResolverDown)
+	require.Equal(t, breakerOpen, breaker.state)
+
+	// A probe from an earlier half-open period is stale as well.
+	now = now.Add(time.Minute)
+	nextProbe, ok := breaker.allow()
+	require.True(t, ok)
+	breaker.record(probe, nil)
+	require.Equal(t, breakerHalfOpen, breaker.state)
+
+	breaker.record(nextProbe, nil)
+	require.Equal(t, breakerClosed, breaker.state)
+}
+
+func TestExtract_CircuitBreakerFailureMode(t *testing.T) {
+	mkHelper := func(failOpen bool) *jwtHelper {
+		breaker := newCircuitBreaker("test", &CircuitBreakerConf{FailureThreshold: 1})
+		return &jwtHelper{
+			verify:   true,
+			failOpen: failOpen,
+			keySets: map[string]keySet{
+				"remote": breakerKeySet{inner: &fakeResolver{err: errResolverDown}, breaker: breaker},
+			},
+		}
+	}
+
+	input := &requestv1.AuxData_JWT{Token: mkSignedToken(t, time.Now().Add(1*time.Hour))}
+
+	t.Run("closed", func(t *testing.T) {
+		jh := mkHelper(false)
+
+		_, err := jh.extract(context.Background(), input)
+		require.ErrorIs(t, err, errResolverDown)
+
+		_, err = jh.extract(context.Background(), input)
+		require.ErrorIs(t, err, errCircuitOpen)
+	})
+
+	t.Run("open", func(t *testing.T) {
+		jh := mkHelper(true)
+
+		for i := 0; i < 2; i++ {
+			have, err := jh.extract(context.Background(), input)
+			require.NoError(t, err)
+			require.Nil(t, have)
+		}
+	})
+}
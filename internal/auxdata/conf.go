@@ -12,12 +12,31 @@ import (
 
 const (
 	confKey = "auxData"
+
+	// FailureModeClosed fails requests that need an unavailable resolver.
+	FailureModeClosed = "closed"
+	// FailureModeOpen evaluates requests that need an unavailable resolver without the data it would have provided.
+	FailureModeOpen = "open"
 )
 
 // Conf is optional configuration for Auxdata.
 type Conf struct {
 	// JWT holds the configuration for JWTs used as an auxiliary data source for the engine.
 	JWT *JWTConf `yaml:"jwt"`
+	// DeadlineMargin is the amount of time to reserve from the request deadline when calling external resolvers such as remote keysets.
+	// Resolvers are given a context that expires this much earlier than the request so that a slow resolver doesn't cause the whole request to time out.
+	DeadlineMargin time.Duration `yaml:"deadlineMargin" conf:",example=50ms"`
+	// CircuitBreaker configures a circuit breaker around each external resolver, so that requests don't wait for a resolver that keeps failing.
+	CircuitBreaker *CircuitBreakerConf `yaml:"circuitBreaker"`
+}
+
+type CircuitBreakerConf struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit. Defaults to 5.
+	FailureThreshold uint `yaml:"failureThreshold" conf:",example=5"`
+	// OpenDuration is how long the circuit stays open before a single call is let through to probe the resolver. Defaults to 30s.
+	OpenDuration time.Duration `yaml:"openDuration" conf:",example=30s"`
+	// FailureMode defines how requests are handled when the resolver fails or the circuit is open. Valid values are "closed" (default) to fail the request and "open" to evaluate the request without the data from the resolver.
+	FailureMode string `yaml:"failureMode" conf:",example=closed"`
 }
 
 type JWTConf struct {
@@ -70,8 +89,24 @@ func (c *Conf) Key() string {
 }
 
 func (c *Conf) Validate() (errs error) {
+	if c.DeadlineMargin < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("deadlineMargin must not be negative"))
+	}
+
+	if cb := c.CircuitBreaker; cb != nil {
+		if cb.OpenDuration < 0 {
+			errs = multierr.Append(errs, fmt.Errorf("circuitBreaker.openDuration must not be negative"))
+		}
+
+		switch cb.FailureMode {
+		case "", FailureModeClosed, FailureModeOpen:
+		default:
+			errs = multierr.Append(errs, fmt.Errorf("circuitBreaker.failureMode must be one of [%s, %s]", FailureModeClosed, FailureModeOpen))
+		}
+	}
+
 	if c.JWT == nil {
-		return nil
+		return errs
 	}
 
 	if c.JWT.CacheSize == 0 {
@@ -34,6 +34,7 @@ var (
 	cacheEntry          = struct{}{}
 	errNilLocalKeySet   = errors.New("nil local keyset")
 	errNoKeySetToVerify = errors.New("cannot determine keyset to use for validating the JWT")
+	errKeySetFetch      = errors.New("failed to retrieve keyset")
 )
 
 type jwtHelper struct {
@@ -41,9 +42,11 @@ type jwtHelper struct {
 	cache          *cache.Cache[string, struct{}]
 	verify         bool
 	acceptableSkew time.Duration
+	deadlineMargin time.Duration
+	failOpen       bool
 }
 
-func newJWTHelper(ctx context.Context, conf *JWTConf) *jwtHelper {
+func newJWTHelper(ctx context.Context, conf *JWTConf, breakerConf *CircuitBreakerConf) *jwtHelper {
 	jh := &jwtHelper{verify: true}
 
 	if conf == nil {
@@ -81,6 +84,9 @@ func newJWTHelper(ctx context.Context, conf *JWTConf) *jwtHelper {
 					jwkCache = jwk.NewCache(ctx, jwk.WithErrSink(httprc.ErrSinkFunc(errSink)))
 				}
 				jh.keySets[ks.ID] = newRemoteKeySet(jwkCache, ks.Remote, opts)
+				if breakerConf != nil {
+					jh.keySets[ks.ID] = breakerKeySet{inner: jh.keySets[ks.ID], breaker: newCircuitBreaker("jwks:"+ks.ID, breakerConf)}
+				}
 			case ks.Local != nil:
 				jh.keySets[ks.ID] = newLocalKeySet(ks.Local, opts)
 			}
@@ -89,6 +95,8 @@ func newJWTHelper(ctx context.Context, conf *JWTConf) *jwtHelper {
 		if conf.CacheSize > 0 {
 			jh.cache = cache.New[string, struct{}]("jwt", uint(conf.CacheSize))
 		}
+
+		jh.failOpen = breakerConf != nil && breakerConf.FailureMode == FailureModeOpen
 	}
 
 	return jh
@@ -112,6 +120,10 @@ func (j *jwtHelper) extract(ctx context.Context, auxJWT *requestv1.AuxData_JWT)
 
 	parseOpts, err := j.parseOptions(ctx, auxJWT, cacheKey)
 	if err != nil {
+		if j.failOpen && errors.Is(err, errKeySetFetch) {
+			logging.FromContext(ctx).Named("auxdata").Warn("Ignoring JWT because the keyset is unavailable", zap.Error(err))
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -153,9 +165,12 @@ func (j *jwtHelper) parseOptions(ctx context.Context, auxJWT *requestv1.AuxData_
 		ks = ksDef
 	}
 
-	jwks, jwksOpts, err := ks.keySet(ctx)
+	ksCtx, cancel := resolverContext(ctx, j.deadlineMargin)
+	defer cancel()
+
+	jwks, jwksOpts, err := ks.keySet(ksCtx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve keyset: %w", err)
+		return nil, fmt.Errorf("%w: %w", errKeySetFetch, err)
 	}
 
 	return append(opts, jwt.WithKeySet(jwks, jwksOpts...), jwt.WithValidate(true)), nil
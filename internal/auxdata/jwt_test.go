@@ -164,7 +164,7 @@ func TestExtract_MultipleKeySets(t *testing.T) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	t.Cleanup(cancelFn)
 
-	jh := newJWTHelper(ctx, conf)
+	jh := newJWTHelper(ctx, conf, nil)
 
 	testCases := []struct {
 		name   string
@@ -262,7 +262,7 @@ func TestExtract_SingleKeySet(t *testing.T) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	t.Cleanup(cancelFn)
 
-	jh := newJWTHelper(ctx, conf)
+	jh := newJWTHelper(ctx, conf, nil)
 
 	testCases := []struct {
 		name   string
@@ -311,7 +311,7 @@ func TestExtract_NoKeySets(t *testing.T) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	t.Cleanup(cancelFn)
 
-	jh := newJWTHelper(ctx, nil)
+	jh := newJWTHelper(ctx, nil, nil)
 
 	testCases := []struct {
 		name   string
@@ -367,6 +367,52 @@ func mkSignedToken(t *testing.T, expiry time.Time) string {
 	return string(tokenBytes)
 }
 
+func TestResolverDeadline(t *testing.T) {
+	const margin = 100 * time.Millisecond
+
+	var haveCtx context.Context
+	ks := localKeySet(func(ctx context.Context) (jwk.Set, []any, error) {
+		haveCtx = ctx
+		return jwk.NewSet(), nil, ctx.Err()
+	})
+
+	jh := &jwtHelper{
+		keySets:        map[string]keySet{"ks": ks},
+		verify:         true,
+		deadlineMargin: margin,
+	}
+	auxJWT := &requestv1.AuxData_JWT{Token: "token", KeySetId: "ks"}
+
+	t.Run("with_deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+
+		_, err := jh.parseOptions(ctx, auxJWT, "")
+		require.NoError(t, err)
+
+		parentDeadline, _ := ctx.Deadline()
+		haveDeadline, ok := haveCtx.Deadline()
+		require.True(t, ok)
+		require.Equal(t, parentDeadline.Add(-margin), haveDeadline)
+	})
+
+	t.Run("without_deadline", func(t *testing.T) {
+		_, err := jh.parseOptions(context.Background(), auxJWT, "")
+		require.NoError(t, err)
+
+		_, ok := haveCtx.Deadline()
+		require.False(t, ok)
+	})
+
+	t.Run("deadline_shorter_than_margin", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), margin/2)
+		defer cancel()
+
+		_, err := jh.parseOptions(ctx, auxJWT, "")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
 func mkExpectedTokenData(t *testing.T, expiry time.Time) map[string]*structpb.Value {
 	t.Helper()
 
@@ -6,16 +6,19 @@ package compile
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/util"
 )
 
 type compilerVersionMigration func(*runtimev1.RunnablePolicySet) error
@@ -32,11 +35,58 @@ var (
 	compilerVersion = uint32(len(compilerVersionMigrations))
 )
 
-func BatchCompile(queue <-chan *policy.CompilationUnit, schemaMgr schema.Manager) error {
+// Option configures the compiler.
+type Option func(*options)
+
+type options struct {
+	warningHandler                func([]*runtimev1.CompileErrors_Err)
+	principalPolicyAllowedActions []string
+	celExtensions                 []string
+}
+
+// WithPrincipalPolicyAllowedActions restricts the actions that principal policies are allowed to grant to those matching the given actions or action globs.
+func WithPrincipalPolicyAllowedActions(actions []string) Option {
+	return func(o *options) {
+		o.principalPolicyAllowedActions = actions
+	}
+}
+
+// WithCELExtensions restricts the CEL extension libraries available to policy conditions to the named ones.
+// Conditions that call functions from other extension libraries fail to compile.
+func WithCELExtensions(extensions []string) Option {
+	return func(o *options) {
+		o.celExtensions = extensions
+	}
+}
+
+// WithWarningHandler registers a function to be called with the warnings produced while compiling a unit.
+// The function is only called if there are warnings.
+func WithWarningHandler(fn func(warnings []*runtimev1.CompileErrors_Err)) Option {
+	return func(o *options) {
+		o.warningHandler = fn
+	}
+}
+
+// OptionsFromConf returns the options that apply the restrictions configured in conf.
+// Policies must be compiled with these options wherever they are compiled so that they are accepted or rejected consistently.
+func OptionsFromConf(conf *Conf) []Option {
+	var opts []Option
+	if len(conf.PrincipalPolicyAllowedActions) > 0 {
+		opts = append(opts, WithPrincipalPolicyAllowedActions(conf.PrincipalPolicyAllowedActions))
+	}
+
+	if conf.CELExtensions != nil {
+		opts = append(opts, WithCELExtensions(conf.CELExtensions))
+	}
+
+	return opts
+}
+
+func BatchCompile(queue <-chan *policy.CompilationUnit, schemaMgr schema.Manager, opts ...Option) error {
 	errs := newErrorList()
 
 	for unit := range queue {
-		if _, err := Compile(unit, schemaMgr); err != nil {
+		if _, err := Compile(unit, schemaMgr, opts...); err != nil {
 			errs.Add(err)
 		}
 	}
@@ -44,8 +94,12 @@ func BatchCompile(queue <-chan *policy.CompilationUnit, schemaMgr schema.Manager
 	return errs.ErrOrNil()
 }
 
-func Compile(unit *policy.CompilationUnit, schemaMgr schema.Manager) (rps *runtimev1.RunnablePolicySet, err error) {
-	uc := newUnitCtx(unit)
+func Compile(unit *policy.CompilationUnit, schemaMgr schema.Manager, opts ...Option) (rps *runtimev1.RunnablePolicySet, err error) {
+	uc, err := newUnitCtx(unit, opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	mc := uc.moduleCtx(unit.ModID)
 
 	if mc == nil || mc.def == nil {
@@ -62,6 +116,10 @@ func Compile(unit *policy.CompilationUnit, schemaMgr schema.Manager) (rps *runti
 		mc.addErrWithDesc(fmt.Errorf("unknown policy type %T", pt), "Unexpected error")
 	}
 
+	if len(uc.warnings) > 0 && uc.opts.warningHandler != nil {
+		uc.opts.warningHandler(uc.warnings)
+	}
+
 	return rps, uc.error()
 }
 
@@ -126,7 +184,8 @@ func compileResourcePolicy(modCtx *moduleCtx, schemaMgr schema.Manager) *runtime
 		return nil
 	}
 
-	referencedRoles, err := compileImportedDerivedRoles(modCtx, rp)
+	rules := expandRuleTemplates(modCtx, rp)
+	referencedRoles, err := compileImportedDerivedRoles(modCtx, rp, rules)
 	if err != nil {
 		return nil
 	}
@@ -140,11 +199,11 @@ func compileResourcePolicy(modCtx *moduleCtx, schemaMgr schema.Manager) *runtime
 	rrp := &runtimev1.RunnableResourcePolicySet_Policy{
 		DerivedRoles: referencedRoles,
 		Scope:        rp.Scope,
-		Rules:        make([]*runtimev1.RunnableResourcePolicySet_Policy_Rule, len(rp.Rules)),
+		Rules:        make([]*runtimev1.RunnableResourcePolicySet_Policy_Rule, len(rules)),
 		Schemas:      rp.Schemas,
 	}
 
-	for i, rule := range rp.Rules {
+	for i, rule := range rules {
 		rule.Name = namer.ResourceRuleName(rule, i+1)
 		cr := compileResourceRule(modCtx, rule)
 		if cr == nil {
@@ -155,11 +214,12 @@ func compileResourcePolicy(modCtx *moduleCtx, schemaMgr schema.Manager) *runtime
 	}
 
 	rrp.OrderedVariables, rrp.Variables = modCtx.variables.Used() //nolint:staticcheck
+	checkEnumComparisons(modCtx, rp, rrp, schemaMgr)
 
 	return rrp
 }
 
-func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy) (map[string]*runtimev1.RunnableDerivedRole, error) {
+func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy, rules []*policyv1.ResourceRule) (map[string]*runtimev1.RunnableDerivedRole, error) {
 	type derivedRoleInfo struct {
 		compiledRoles map[string]*runtimev1.RunnableDerivedRole
 		importName    string
@@ -197,7 +257,7 @@ func compileImportedDerivedRoles(modCtx *moduleCtx, rp *policyv1.ResourcePolicy)
 	unknownRoles := make(map[string]struct{})
 	ambiguousRoles := make(map[string]string)
 
-	for _, rule := range rp.Rules {
+	for _, rule := range rules {
 		for _, r := range rule.DerivedRoles {
 			imp, ok := roleImports[r]
 			if !ok {
@@ -258,15 +318,55 @@ func compileDerivedRoles(modCtx *moduleCtx) map[string]*runtimev1.RunnableDerive
 			rdr.ParentRoles[pr] = emptyVal
 		}
 
+		parent := fmt.Sprintf("derived role '%s' (#%d)", def.Name, i)
 		modCtx.variables.ResetUsage()
-		rdr.Condition = compileCondition(modCtx, fmt.Sprintf("derived role '%s' (#%d)", def.Name, i), def.Condition, true)
+		parameters := compileDerivedRoleParameters(modCtx, parent, def.Parameters)
+		rdr.Condition = compileCondition(modCtx, parent, def.Condition, true)
 		rdr.OrderedVariables, rdr.Variables = modCtx.variables.Used() //nolint:staticcheck
+		if len(parameters) > 0 && rdr.Variables != nil {              //nolint:staticcheck
+			// parameters can refer to policy variables so they must be evaluated after them.
+			rdr.OrderedVariables = append(rdr.OrderedVariables, parameters...)
+			for _, p := range parameters {
+				rdr.Variables[p.Name] = p.Expr //nolint:staticcheck
+			}
+		}
 		compiled[def.Name] = rdr
 	}
 
 	return compiled
 }
 
+func compileDerivedRoleParameters(modCtx *moduleCtx, parent string, params map[string]string) []*runtimev1.Variable {
+	modCtx.variables.SetParameters(nil)
+	if len(params) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	compiled := make([]*runtimev1.Variable, 0, len(params))
+	for _, name := range names {
+		if modCtx.variables.IsDefined(name) {
+			modCtx.addErrWithDesc(errVariableRedefined, "Parameter '%s' of %s has the same name as a variable", name, parent)
+			continue
+		}
+
+		expr := params[name]
+		checked := compileCELExpr(modCtx, fmt.Sprintf("parameter '%s' of %s", name, parent), expr, true)
+		compiled = append(compiled, &runtimev1.Variable{
+			Name: name,
+			Expr: &runtimev1.Expr{Original: expr, Checked: checked},
+		})
+	}
+
+	modCtx.variables.SetParameters(names)
+	return compiled
+}
+
 func checkReferencedSchemas(modCtx *moduleCtx, rp *policyv1.ResourcePolicy, schemaMgr schema.Manager) error {
 	if rp.Schemas == nil {
 		return nil
@@ -396,6 +496,10 @@ func compilePrincipalPolicy(modCtx *moduleCtx) *runtimev1.RunnablePrincipalPolic
 			action.Name = namer.PrincipalResourceActionRuleName(action, rule.Resource, i+1)
 
 			ruleName := fmt.Sprintf("rule '%s' (#%d) of resource '%s'", action.Name, i+1, rule.Resource)
+			if action.Effect == effectv1.Effect_EFFECT_ALLOW && !modCtx.principalPolicyActionAllowed(action.Action) {
+				modCtx.addErrWithDesc(errActionNotAllowed, "Action '%s' in %s is not in the list of actions that principal policies are allowed to grant", action.Action, ruleName)
+			}
+
 			actionRule := &runtimev1.RunnablePrincipalPolicySet_Policy_ActionRule{
 				Action:    action.Action,
 				Name:      action.Name,
@@ -421,6 +525,22 @@ func compilePrincipalPolicy(modCtx *moduleCtx) *runtimev1.RunnablePrincipalPolic
 	return rpp
 }
 
+func (mc *moduleCtx) principalPolicyActionAllowed(action string) bool {
+	allowed := mc.opts.principalPolicyAllowedActions
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, a := range allowed {
+		// A single * matches any action, including those containing the : separator.
+		if a == "*" || util.MatchesGlob(a, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func reportMissingAncestors(modCtx *moduleCtx) {
 	required := policy.RequiredAncestors(modCtx.def)
 	defs := modCtx.unit.Definitions
@@ -20,9 +20,11 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/conditions"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/schema"
@@ -76,6 +78,102 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestPrincipalPolicyAllowedActions(t *testing.T) {
+	mkUnit := func(rules ...*policyv1.PrincipalRule) *policy.CompilationUnit {
+		pp := test.NewPrincipalPolicyBuilder("donald_duck", "default").WithRules(rules...).Build()
+		modID := namer.GenModuleID(pp)
+		cu := &policy.CompilationUnit{ModID: modID}
+		cu.AddDefinition(modID, pp)
+		return cu
+	}
+
+	opt := compile.WithPrincipalPolicyAllowedActions([]string{"view", "comment:*"})
+	schemaMgr := schema.NewNopManager()
+
+	t.Run("allowed", func(t *testing.T) {
+		unit := mkUnit(test.NewPrincipalRuleBuilder("document").AllowAction("view").AllowAction("comment:create").DenyAction("delete").Build())
+		rps, err := compile.Compile(unit, schemaMgr, opt)
+		require.NoError(t, err)
+		require.NotNil(t, rps)
+	})
+
+	t.Run("disallowed", func(t *testing.T) {
+		unit := mkUnit(test.NewPrincipalRuleBuilder("document").AllowAction("view").AllowAction("delete").Build())
+		_, err := compile.Compile(unit, schemaMgr, opt)
+		errList := new(compile.ErrorList)
+		require.ErrorAs(t, err, &errList)
+		require.Len(t, errList.Errors, 1)
+		require.Contains(t, errList.Errors[0].Description, "'delete'")
+	})
+
+	t.Run("wildcard_grant", func(t *testing.T) {
+		unit := mkUnit(test.NewPrincipalRuleBuilder("document").AllowAction("*").Build())
+		_, err := compile.Compile(unit, schemaMgr, opt)
+		require.Error(t, err, "A wildcard grant must be rejected unless the allowlist permits all actions")
+
+		_, err = compile.Compile(unit, schemaMgr, compile.WithPrincipalPolicyAllowedActions([]string{"*"}))
+		require.NoError(t, err)
+	})
+
+	t.Run("unrestricted", func(t *testing.T) {
+		unit := mkUnit(test.NewPrincipalRuleBuilder("document").AllowAction("delete").Build())
+		_, err := compile.Compile(unit, schemaMgr)
+		require.NoError(t, err)
+	})
+}
+
+func TestCELExtensions(t *testing.T) {
+	mkUnit := func(expr string) *policy.CompilationUnit {
+		rp := test.NewResourcePolicyBuilder("document", "default").
+			WithRules(test.NewResourceRule("view").WithRoles("user").WithMatchExpr(expr).WithEffect(effectv1.Effect_EFFECT_ALLOW).Build()).
+			Build()
+		modID := namer.GenModuleID(rp)
+		cu := &policy.CompilationUnit{ModID: modID}
+		cu.AddDefinition(modID, rp)
+		return cu
+	}
+
+	const setsExpr = `sets.contains(P.attr.teams, [R.attr.team])`
+	schemaMgr := schema.NewNopManager()
+
+	t.Run("enabled", func(t *testing.T) {
+		rps, err := compile.Compile(mkUnit(setsExpr), schemaMgr, compile.WithCELExtensions([]string{conditions.ExtSets}))
+		require.NoError(t, err)
+		require.NotNil(t, rps)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		_, err := compile.Compile(mkUnit(setsExpr), schemaMgr, compile.WithCELExtensions(conditions.DefaultExtensions))
+		errList := new(compile.ErrorList)
+		require.ErrorAs(t, err, &errList)
+		require.Len(t, errList.Errors, 1)
+		require.Contains(t, errList.Errors[0].Error, "CEL extension not enabled")
+		require.Contains(t, errList.Errors[0].Error, "sets extension")
+	})
+
+	t.Run("default_extension_disabled", func(t *testing.T) {
+		unit := mkUnit(`R.attr.name.lowerAscii() == "x"`)
+
+		_, err := compile.Compile(unit, schemaMgr)
+		require.NoError(t, err)
+
+		_, err = compile.Compile(unit, schemaMgr, compile.WithCELExtensions([]string{conditions.ExtMath}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "strings extension")
+	})
+
+	t.Run("unknown_function", func(t *testing.T) {
+		_, err := compile.Compile(mkUnit(`no_such_function(R.attr.name)`), schemaMgr, compile.WithCELExtensions(conditions.DefaultExtensions))
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "CEL extension not enabled")
+	})
+
+	t.Run("unknown_extension", func(t *testing.T) {
+		_, err := compile.Compile(mkUnit(setsExpr), schemaMgr, compile.WithCELExtensions([]string{"wibble"}))
+		require.ErrorIs(t, err, conditions.ErrUnknownExtension)
+	})
+}
+
 func updateGoldenFiles(t *testing.T, schemaMgr schema.Manager, testCases []test.Case) {
 	t.Helper()
 
@@ -4,12 +4,14 @@
 package compile
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/google/cel-go/cel"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
-	"github.com/cerbos/cerbos/internal/conditions"
 )
 
 func Condition(cond *policyv1.Condition) (*runtimev1.Condition, error) {
@@ -57,8 +59,13 @@ func compileMatch(modCtx *moduleCtx, parent string, match *policyv1.Match, markR
 }
 
 func compileCELExpr(modCtx *moduleCtx, parent, expr string, markReferencedVariablesAsUsed bool) *exprpb.CheckedExpr {
-	celAST, issues := conditions.StdEnv.Compile(expr)
+	celAST, issues := modCtx.env().Compile(expr)
 	if issues != nil && issues.Err() != nil {
+		if exts := modCtx.disabledCELExtensionsUsedBy(expr); len(exts) > 0 {
+			modCtx.addErrWithDesc(fmt.Errorf("%w: `%s` uses functions from the %s extension", errCELExtensionDisabled, expr, strings.Join(exts, " or ")), "Invalid expression in %s", parent)
+			return nil
+		}
+
 		modCtx.addErrWithDesc(newCELCompileError(expr, issues), "Invalid expression in %s", parent)
 		return nil
 	}
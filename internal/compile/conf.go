@@ -5,14 +5,23 @@ package compile
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/config"
 )
 
 const (
 	confKey          = "compile"
 	defaultCacheSize = 1024
+
+	// StoreUnavailableServeLastKnown keeps serving the last successfully compiled policies while the store is unreachable.
+	StoreUnavailableServeLastKnown = "serveLastKnown"
+	// StoreUnavailableDeny treats policies as missing while the store is unreachable, which results in requests being denied.
+	StoreUnavailableDeny = "deny"
 )
 
 // Conf is optional configuration for caches.
@@ -21,6 +30,12 @@ type Conf struct {
 	CacheSize uint `yaml:"cacheSize" conf:",example=1024"`
 	// CacheDuration is the duration to cache an entry.
 	CacheDuration time.Duration `yaml:"cacheDuration" conf:",example=60s"`
+	// PrincipalPolicyAllowedActions restricts the actions that principal policies are allowed to grant. Principal policies that allow an action not matched by any of the listed actions or action globs fail to compile. Empty means no restriction.
+	PrincipalPolicyAllowedActions []string `yaml:"principalPolicyAllowedActions" conf:",example=['view:*']"`
+	// OnStoreUnavailable defines the behaviour when the store cannot be reached after startup. Valid values are 'serveLastKnown' (keep serving the last successfully compiled policies) and 'deny' (deny all requests until the store is reachable again).
+	OnStoreUnavailable string `yaml:"onStoreUnavailable" conf:",example=serveLastKnown"`
+	// CELExtensions are the CEL extension libraries available to policy conditions. Valid values are bindings, encoders, lists, math, sets and strings. Defaults to encoders, math and strings. Policies that use functions from other extension libraries fail to compile.
+	CELExtensions []string `yaml:"celExtensions" conf:",example=[\"encoders\", \"math\", \"strings\"]"`
 }
 
 func (c *Conf) Key() string {
@@ -29,6 +44,8 @@ func (c *Conf) Key() string {
 
 func (c *Conf) SetDefaults() {
 	c.CacheSize = defaultCacheSize
+	c.OnStoreUnavailable = StoreUnavailableServeLastKnown
+	c.CELExtensions = conditions.DefaultExtensions
 }
 
 func (c *Conf) Validate() (outErr error) {
@@ -40,9 +57,27 @@ func (c *Conf) Validate() (outErr error) {
 		outErr = multierr.Append(outErr, errors.New("compile.cacheDuration must be positive"))
 	}
 
+	switch c.OnStoreUnavailable {
+	case StoreUnavailableServeLastKnown, StoreUnavailableDeny:
+	default:
+		outErr = multierr.Append(outErr, fmt.Errorf("compile.onStoreUnavailable must be one of [%s, %s]", StoreUnavailableServeLastKnown, StoreUnavailableDeny))
+	}
+
+	if err := conditions.ValidateExtensions(c.CELExtensions); err != nil {
+		outErr = multierr.Append(outErr, fmt.Errorf("invalid compile.celExtensions: %w", err))
+	}
+
 	return outErr
 }
 
+// GetConf reads the compile configuration section.
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
+
 // DefaultConf creates a config with defaults.
 func DefaultConf() *Conf {
 	cconf := &Conf{}
@@ -6,18 +6,56 @@ package compile
 import (
 	"fmt"
 
+	"github.com/google/cel-go/cel"
+
 	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/policy"
 )
 
 type unitCtx struct {
-	unit   *policy.CompilationUnit
-	errors *ErrorList
+	unit     *policy.CompilationUnit
+	errors   *ErrorList
+	celEnv   *cel.Env
+	warnings []*runtimev1.CompileErrors_Err
+	opts     options
+}
+
+func newUnitCtx(unit *policy.CompilationUnit, opts ...Option) (*unitCtx, error) {
+	uc := &unitCtx{unit: unit, errors: newErrorList()}
+	for _, opt := range opts {
+		opt(&uc.opts)
+	}
+
+	if uc.opts.celExtensions != nil {
+		env, err := conditions.EnvWithExtensions(uc.opts.celExtensions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+		}
+		uc.celEnv = env
+	}
+
+	return uc, nil
 }
 
-func newUnitCtx(unit *policy.CompilationUnit) *unitCtx {
-	return &unitCtx{unit: unit, errors: newErrorList()}
+// env returns the CEL environment used to compile conditions.
+func (uc *unitCtx) env() *cel.Env {
+	if uc.celEnv == nil {
+		return conditions.StdEnv
+	}
+
+	return uc.celEnv
+}
+
+// disabledCELExtensionsUsedBy returns the CEL extensions that would allow the expression to compile if they were enabled.
+func (uc *unitCtx) disabledCELExtensionsUsedBy(expr string) []string {
+	if uc.opts.celExtensions == nil {
+		return nil
+	}
+
+	return conditions.DisabledExtensionsUsedBy(expr, uc.opts.celExtensions)
 }
 
 func (uc *unitCtx) error() error {
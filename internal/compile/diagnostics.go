@@ -0,0 +1,118 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+type Severity uint8
+
+const (
+	SeverityError Severity = iota + 1
+	SeverityWarning
+)
+
+// Diagnostic is an error or a warning produced while compiling a policy.
+type Diagnostic struct {
+	*runtimev1.CompileErrors_Err
+	Severity Severity
+}
+
+// diagnostics holds the diagnostics produced by the most recent compilation of each module.
+type diagnostics struct {
+	byModule map[namer.ModuleID][]Diagnostic
+	mu       sync.RWMutex
+}
+
+func newDiagnostics() *diagnostics {
+	return &diagnostics{byModule: make(map[namer.ModuleID][]Diagnostic)}
+}
+
+func (d *diagnostics) set(modID namer.ModuleID, warnings []*runtimev1.CompileErrors_Err, err error) {
+	var diags []Diagnostic
+	if err != nil {
+		errList := newErrorList()
+		errList.Add(err)
+		for _, e := range errList.Errors {
+			diags = append(diags, Diagnostic{CompileErrors_Err: e, Severity: SeverityError})
+		}
+	}
+
+	for _, w := range warnings {
+		diags = append(diags, Diagnostic{CompileErrors_Err: w, Severity: SeverityWarning})
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(diags) == 0 {
+		delete(d.byModule, modID)
+		return
+	}
+
+	d.byModule[modID] = diags
+}
+
+func (d *diagnostics) remove(modID namer.ModuleID) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.byModule, modID)
+}
+
+func (d *diagnostics) purge() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.byModule = make(map[namer.ModuleID][]Diagnostic)
+}
+
+// all returns the diagnostics of all modules sorted by file and severity.
+// A policy that is part of several compilation units (for example, a scoped policy and its ancestors) only has its diagnostics reported once.
+func (d *diagnostics) all() []Diagnostic {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var out []Diagnostic
+	for _, diags := range d.byModule {
+		for _, diag := range diags {
+			dup := false
+			for _, o := range out {
+				if o.Severity == diag.Severity && proto.Equal(o.CompileErrors_Err, diag.CompileErrors_Err) {
+					dup = true
+					break
+				}
+			}
+
+			if !dup {
+				out = append(out, diag)
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].File != out[j].File {
+			return out[i].File < out[j].File
+		}
+
+		if out[i].Severity != out[j].Severity {
+			return out[i].Severity < out[j].Severity
+		}
+
+		if out[i].Description != out[j].Description {
+			return out[i].Description < out[j].Description
+		}
+
+		return out[i].Error < out[j].Error
+	})
+
+	return out
+}
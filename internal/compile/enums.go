@@ -0,0 +1,228 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+const (
+	principalAttrPrefix = "request.principal.attr."
+	resourceAttrPrefix  = "request.resource.attr."
+)
+
+// enumChecker flags conditions that compare enum attributes to values that are not allowed by the schema.
+type enumChecker struct {
+	modCtx    *moduleCtx
+	principal schema.EnumAttributes
+	resource  schema.EnumAttributes
+}
+
+func checkEnumComparisons(modCtx *moduleCtx, rp *policyv1.ResourcePolicy, rrp *runtimev1.RunnableResourcePolicySet_Policy, schemaMgr schema.Manager) {
+	if rp.Schemas == nil {
+		return
+	}
+
+	ec := &enumChecker{modCtx: modCtx}
+	// Schema load failures are already reported by checkReferencedSchemas.
+	if ps := rp.Schemas.PrincipalSchema; ps != nil && ps.Ref != "" {
+		ec.principal, _ = schemaMgr.EnumAttributes(context.TODO(), ps.Ref)
+	}
+
+	if rs := rp.Schemas.ResourceSchema; rs != nil && rs.Ref != "" {
+		ec.resource, _ = schemaMgr.EnumAttributes(context.TODO(), rs.Ref)
+	}
+
+	if len(ec.principal) == 0 && len(ec.resource) == 0 {
+		return
+	}
+
+	for _, v := range rrp.OrderedVariables {
+		ec.checkExpr(fmt.Sprintf("variable '%s'", v.Name), v.Expr.GetChecked().GetExpr())
+	}
+
+	for _, rule := range rrp.Rules {
+		if rule != nil {
+			ec.checkCondition(fmt.Sprintf("resource rule '%s'", rule.Name), rule.Condition)
+		}
+	}
+}
+
+func (ec *enumChecker) checkCondition(parent string, cond *runtimev1.Condition) {
+	if cond == nil {
+		return
+	}
+
+	switch op := cond.Op.(type) {
+	case *runtimev1.Condition_Expr:
+		ec.checkExpr(parent, op.Expr.GetChecked().GetExpr())
+	case *runtimev1.Condition_All:
+		ec.checkConditionList(parent, op.All)
+	case *runtimev1.Condition_Any:
+		ec.checkConditionList(parent, op.Any)
+	case *runtimev1.Condition_None:
+		ec.checkConditionList(parent, op.None)
+	}
+}
+
+func (ec *enumChecker) checkConditionList(parent string, list *runtimev1.Condition_ExprList) {
+	for _, c := range list.GetExpr() {
+		ec.checkCondition(parent, c)
+	}
+}
+
+func (ec *enumChecker) checkExpr(parent string, expr *exprpb.Expr) {
+	if expr == nil {
+		return
+	}
+
+	switch e := expr.ExprKind.(type) {
+	case *exprpb.Expr_SelectExpr:
+		ec.checkExpr(parent, e.SelectExpr.Operand)
+	case *exprpb.Expr_CallExpr:
+		ec.checkCall(parent, e.CallExpr)
+		ec.checkExpr(parent, e.CallExpr.Target)
+		for _, arg := range e.CallExpr.Args {
+			ec.checkExpr(parent, arg)
+		}
+	case *exprpb.Expr_ListExpr:
+		for _, elem := range e.ListExpr.Elements {
+			ec.checkExpr(parent, elem)
+		}
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range e.StructExpr.Entries {
+			ec.checkExpr(parent, entry.GetMapKey())
+			ec.checkExpr(parent, entry.Value)
+		}
+	case *exprpb.Expr_ComprehensionExpr:
+		ce := e.ComprehensionExpr
+		for _, ex := range []*exprpb.Expr{ce.IterRange, ce.AccuInit, ce.LoopCondition, ce.LoopStep, ce.Result} {
+			ec.checkExpr(parent, ex)
+		}
+	}
+}
+
+func (ec *enumChecker) checkCall(parent string, call *exprpb.Expr_Call) {
+	if len(call.Args) != 2 { //nolint:gomnd
+		return
+	}
+
+	switch call.Function {
+	case "_==_", "_!=_":
+		lhs, rhs := call.Args[0], call.Args[1]
+		if _, ok := constValue(lhs); ok {
+			lhs, rhs = rhs, lhs
+		}
+
+		if v, ok := constValue(rhs); ok {
+			ec.checkValue(parent, lhs, v)
+		}
+	case "@in":
+		for _, elem := range call.Args[1].GetListExpr().GetElements() {
+			if v, ok := constValue(elem); ok {
+				ec.checkValue(parent, call.Args[0], v)
+			}
+		}
+	}
+}
+
+func (ec *enumChecker) checkValue(parent string, attrExpr *exprpb.Expr, value any) {
+	path, ok := exprPath(attrExpr)
+	if !ok {
+		return
+	}
+
+	path = conditions.ExpandAbbrev(path)
+
+	var enums schema.EnumAttributes
+	var attr string
+	switch {
+	case strings.HasPrefix(path, principalAttrPrefix):
+		enums, attr = ec.principal, strings.TrimPrefix(path, principalAttrPrefix)
+	case strings.HasPrefix(path, resourceAttrPrefix):
+		enums, attr = ec.resource, strings.TrimPrefix(path, resourceAttrPrefix)
+	default:
+		return
+	}
+
+	if !enums.Allows(attr, value) {
+		ec.modCtx.addErrWithDesc(errInvalidEnumValue, "Value %s compared to %s in %s is not one of the allowed values %s defined by the schema", toJSON(value), path, parent, toJSON(enums[attr]))
+	}
+}
+
+// exprPath returns the dotted path of a chain of field selections or constant string indexes such as R.attr.owner["team"].
+func exprPath(expr *exprpb.Expr) (string, bool) {
+	switch e := expr.ExprKind.(type) {
+	case *exprpb.Expr_IdentExpr:
+		return e.IdentExpr.Name, true
+	case *exprpb.Expr_SelectExpr:
+		if e.SelectExpr.TestOnly {
+			return "", false
+		}
+
+		operand, ok := exprPath(e.SelectExpr.Operand)
+		if !ok {
+			return "", false
+		}
+		return operand + "." + e.SelectExpr.Field, true
+	case *exprpb.Expr_CallExpr:
+		if e.CallExpr.Function != "_[_]" || len(e.CallExpr.Args) != 2 { //nolint:gomnd
+			return "", false
+		}
+
+		key := e.CallExpr.Args[1].GetConstExpr().GetStringValue()
+		if key == "" {
+			return "", false
+		}
+
+		operand, ok := exprPath(e.CallExpr.Args[0])
+		if !ok {
+			return "", false
+		}
+		return operand + "." + key, true
+	default:
+		return "", false
+	}
+}
+
+func toJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+func constValue(expr *exprpb.Expr) (any, bool) {
+	c := expr.GetConstExpr()
+	if c == nil {
+		return nil, false
+	}
+
+	switch v := c.ConstantKind.(type) {
+	case *exprpb.Constant_StringValue:
+		return v.StringValue, true
+	case *exprpb.Constant_BoolValue:
+		return v.BoolValue, true
+	case *exprpb.Constant_Int64Value:
+		return float64(v.Int64Value), true
+	case *exprpb.Constant_Uint64Value:
+		return float64(v.Uint64Value), true
+	case *exprpb.Constant_DoubleValue:
+		return v.DoubleValue, true
+	default:
+		return nil, false
+	}
+}
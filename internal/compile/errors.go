@@ -15,18 +15,24 @@ import (
 )
 
 var (
-	errAmbiguousDerivedRole   = errors.New("ambiguous derived role")
-	errCyclicalVariables      = errors.New("cyclical variable definitions")
-	errImportNotFound         = errors.New("import not found")
-	errInvalidCompilationUnit = errors.New("invalid compilation unit")
-	errInvalidResourceRule    = errors.New("invalid resource rule")
-	errInvalidSchema          = errors.New("invalid schema")
-	errMissingDefinition      = errors.New("missing policy definition")
-	errScriptsUnsupported     = errors.New("scripts in conditions are no longer supported")
-	errUndefinedVariable      = errors.New("undefined variable")
-	errUnexpectedErr          = errors.New("unexpected error")
-	errUnknownDerivedRole     = errors.New("unknown derived role")
-	errVariableRedefined      = errors.New("variable redefined")
+	errActionNotAllowed          = errors.New("action not allowed")
+	errAmbiguousDerivedRole      = errors.New("ambiguous derived role")
+	errAmbiguousRuleTemplate     = errors.New("ambiguous rule template")
+	errCELExtensionDisabled      = errors.New("CEL extension not enabled")
+	errCyclicalVariables         = errors.New("cyclical variable definitions")
+	errImportNotFound            = errors.New("import not found")
+	errInvalidCompilationUnit    = errors.New("invalid compilation unit")
+	errInvalidEnumValue          = errors.New("invalid enum value")
+	errInvalidResourceRule       = errors.New("invalid resource rule")
+	errInvalidSchema             = errors.New("invalid schema")
+	errInvalidTemplateParameters = errors.New("invalid rule template parameters")
+	errMissingDefinition         = errors.New("missing policy definition")
+	errScriptsUnsupported        = errors.New("scripts in conditions are no longer supported")
+	errUndefinedVariable         = errors.New("undefined variable")
+	errUnexpectedErr             = errors.New("unexpected error")
+	errUnknownDerivedRole        = errors.New("unknown derived role")
+	errUnknownRuleTemplate       = errors.New("unknown rule template")
+	errVariableRedefined         = errors.New("variable redefined")
 )
 
 type ErrorList struct {
@@ -117,7 +123,25 @@ func errorString(err *runtimev1.CompileErrors_Err) string {
 }
 
 func newError(file, desc string, err error) *Error {
-	return &Error{CompileErrors_Err: &runtimev1.CompileErrors_Err{File: file, Error: err.Error(), Description: desc}}
+	return &Error{CompileErrors_Err: &runtimev1.CompileErrors_Err{File: file, Error: err.Error(), Description: desc, Position: errorPosition(err)}}
+}
+
+// errorPosition returns the position of the first CEL issue within the expression, if err is a CEL compilation error.
+func errorPosition(err error) *runtimev1.CompileErrors_Err_Position {
+	celErr := new(CELCompileError)
+	if !errors.As(err, &celErr) {
+		return nil
+	}
+
+	for _, ce := range celErr.issues.Errors() {
+		if ce.Location == nil || ce.Location.Line() < 1 {
+			continue
+		}
+
+		return &runtimev1.CompileErrors_Err_Position{Line: uint32(ce.Location.Line()), Column: uint32(ce.Location.Column())}
+	}
+
+	return nil
 }
 
 // CELCompileError holds CEL compilation errors.
@@ -7,7 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opencensus.io/stats"
@@ -17,7 +21,6 @@ import (
 
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
 	"github.com/cerbos/cerbos/internal/cache"
-	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/policy"
@@ -29,21 +32,31 @@ const (
 	negativeCacheEntryTTL = 10 * time.Second
 	storeFetchTimeout     = 2 * time.Second
 	updateQueueSize       = 32
+	diagnosticsKey        = "diagnostics"
 )
 
 type Manager struct {
-	log           *zap.SugaredLogger
-	store         storage.SourceStore
-	schemaMgr     schema.Manager
-	updateQueue   chan storage.Event
-	cache         *cache.Cache[namer.ModuleID, *runtimev1.RunnablePolicySet]
-	sf            singleflight.Group
-	cacheDuration time.Duration
+	log              *zap.SugaredLogger
+	store            storage.SourceStore
+	schemaMgr        schema.Manager
+	updateQueue      chan storage.Event
+	cache            *cache.Cache[namer.ModuleID, *runtimev1.RunnablePolicySet]
+	lastKnown        *cache.Cache[namer.ModuleID, *runtimev1.RunnablePolicySet]
+	sf               singleflight.Group
+	compileOpts      []Option
+	diagnostics      *diagnostics
+	listeners        []func(bool)
+	cacheDuration    time.Duration
+	generation       atomic.Uint64
+	storeUnavailable atomic.Bool
+	uncompiled       atomic.Bool
+	listenersMu      sync.RWMutex
+	denyUnavailable  bool
 }
 
 func NewManager(ctx context.Context, store storage.SourceStore, schemaMgr schema.Manager) (*Manager, error) {
-	conf := &Conf{}
-	if err := config.GetSection(conf); err != nil {
+	conf, err := GetConf()
+	if err != nil {
 		return nil, err
 	}
 
@@ -62,8 +75,20 @@ func NewManagerFromConf(ctx context.Context, conf *Conf, store storage.SourceSto
 		updateQueue:   make(chan storage.Event, updateQueueSize),
 		cache:         cache.New[namer.ModuleID, *runtimev1.RunnablePolicySet]("compile", conf.CacheSize),
 		cacheDuration: conf.CacheDuration,
+		diagnostics:   newDiagnostics(),
+	}
+
+	if conf.OnStoreUnavailable == StoreUnavailableDeny {
+		c.denyUnavailable = true
+	} else {
+		// Unlike the main cache, entries here never expire so that they remain available for as long as the store is unreachable.
+		c.lastKnown = cache.New[namer.ModuleID, *runtimev1.RunnablePolicySet]("compile_last_known", conf.CacheSize)
 	}
 
+	c.compileOpts = OptionsFromConf(conf)
+
+	c.uncompiled.Store(true)
+
 	go c.processUpdateQueue(ctx)
 	store.Subscribe(c)
 
@@ -92,6 +117,11 @@ func (c *Manager) processUpdateQueue(ctx context.Context) {
 			case storage.EventReload:
 				c.log.Info("Purging compile cache")
 				c.cache.Purge()
+				if c.lastKnown != nil {
+					c.lastKnown.Purge()
+				}
+				c.diagnostics.purge()
+				c.uncompiled.Store(true)
 			case storage.EventAddOrUpdatePolicy, storage.EventDeleteOrDisablePolicy:
 				if err := c.recompile(evt); err != nil {
 					c.log.Warnw("Error while processing storage event", "event", evt, "error", err)
@@ -99,8 +129,141 @@ func (c *Manager) processUpdateQueue(ctx context.Context) {
 			default:
 				c.log.Debugw("Ignoring storage event", "event", evt)
 			}
+
+			// Schema changes can affect the decisions as well, so every event results in a new generation.
+			c.generation.Add(1)
+		}
+	}
+}
+
+// Diagnostics returns the errors and warnings produced by the most recent compilation of each of the policies in the store.
+// Policies are normally compiled when they are first requested, so the policies that haven't been compiled since the store was
+// last reloaded are compiled first.
+func (c *Manager) Diagnostics(ctx context.Context) ([]Diagnostic, error) {
+	_, err, _ := c.sf.Do(diagnosticsKey, func() (any, error) {
+		if !c.uncompiled.Swap(false) {
+			return nil, nil
+		}
+
+		if err := c.compileAll(ctx); err != nil {
+			c.uncompiled.Store(true)
+			return nil, err
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.diagnostics.all(), nil
+}
+
+// compileAll compiles all the enabled resource and principal policies in the store that are not in the cache.
+func (c *Manager) compileAll(ctx context.Context) error {
+	policyIDs, err := c.store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+	if err != nil {
+		return fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	wrappers, err := c.store.LoadPolicy(ctx, policyIDs...)
+	if err != nil {
+		return fmt.Errorf("failed to load policies: %w", err)
+	}
+
+	var toCompile []namer.ModuleID
+	for _, w := range wrappers {
+		if (w.Kind == policy.ResourceKind || w.Kind == policy.PrincipalKind) && !c.cache.Has(w.ID) {
+			toCompile = append(toCompile, w.ID)
+		}
+	}
+
+	units, err := c.store.GetCompilationUnits(ctx, toCompile...)
+	if err != nil {
+		return fmt.Errorf("failed to get compilation units: %w", err)
+	}
+
+	for _, unit := range units {
+		if mp := unit.MainPolicy(); mp == nil || mp.Disabled {
+			continue
+		}
+
+		// The diagnostics are recorded regardless of the outcome.
+		_, _ = c.compile(unit)
+	}
+
+	return nil
+}
+
+// PolicyFingerprint returns a value that changes after every storage event processed by the manager.
+func (c *Manager) PolicyFingerprint() string {
+	return strconv.FormatUint(c.generation.Load(), 10)
+}
+
+// OnStoreAvailabilityChange registers a function to be called whenever the store becomes unreachable or reachable again.
+func (c *Manager) OnStoreAvailabilityChange(fn func(available bool)) {
+	c.listenersMu.Lock()
+	defer c.listenersMu.Unlock()
+
+	c.listeners = append(c.listeners, fn)
+}
+
+// StoreAvailable reports whether the last attempt to fetch policies from the store succeeded.
+func (c *Manager) StoreAvailable() bool {
+	return !c.storeUnavailable.Load()
+}
+
+func (c *Manager) setStoreAvailable(available bool) {
+	if c.storeUnavailable.Swap(!available) == !available {
+		return
+	}
+
+	if available {
+		c.log.Info("Store is reachable again")
+	} else if c.denyUnavailable {
+		c.log.Warn("Store is unreachable: denying requests until it becomes available")
+	} else {
+		c.log.Warn("Store is unreachable: serving the last known policies until it becomes available")
+	}
+
+	// The policies returned by the manager depend on the availability of the store.
+	c.generation.Add(1)
+
+	c.listenersMu.RLock()
+	defer c.listenersMu.RUnlock()
+
+	for _, fn := range c.listeners {
+		fn(available)
+	}
+}
+
+// fromLastKnown handles a failure to fetch compilation units from the store according to the configured behaviour.
+func (c *Manager) fromLastKnown(ctx context.Context, err error, candidates ...namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	err = fmt.Errorf("failed to get compilation units: %w", err)
+	// The caller giving up is not an indication that the store is unreachable.
+	if ctx.Err() != nil {
+		return nil, err
+	}
+
+	c.setStoreAvailable(false)
+
+	if c.denyUnavailable {
+		c.log.Debugw("Treating policies as missing because the store is unreachable", "error", err)
+		return nil, nil
+	}
+
+	for _, modID := range candidates {
+		if rps, ok := c.lastKnown.Get(modID); ok {
+			return rps, nil
 		}
 	}
+
+	return nil, err
+}
+
+// useCache returns false if cached entries must not be used because the store is unreachable and requests must be denied.
+func (c *Manager) useCache() bool {
+	return !c.denyUnavailable || !c.storeUnavailable.Load()
 }
 
 func (c *Manager) recompile(evt storage.Event) error {
@@ -137,8 +300,10 @@ func (c *Manager) recompile(evt storage.Event) error {
 
 	compileUnits, err := c.store.GetCompilationUnits(ctx, toRecompile...)
 	if err != nil {
+		c.setStoreAvailable(false)
 		return fmt.Errorf("failed to get compilation units: %w", err)
 	}
+	c.setStoreAvailable(true)
 
 	for modID, cu := range compileUnits {
 		if cu.MainPolicy() == nil || cu.MainPolicy().Disabled {
@@ -162,6 +327,7 @@ func (c *Manager) getDependents(modID namer.ModuleID) ([]namer.ModuleID, error)
 
 	dependents, err := c.store.GetDependents(ctx, modID)
 	if err != nil {
+		c.setStoreAvailable(false)
 		return nil, fmt.Errorf("failed to find dependents: %w", err)
 	}
 
@@ -173,16 +339,25 @@ func (c *Manager) getDependents(modID namer.ModuleID) ([]namer.ModuleID, error)
 }
 
 func (c *Manager) compile(unit *policy.CompilationUnit) (*runtimev1.RunnablePolicySet, error) {
+	var warnings []*runtimev1.CompileErrors_Err
+	opts := append(slices.Clip(c.compileOpts), WithWarningHandler(func(w []*runtimev1.CompileErrors_Err) { warnings = w }))
+
 	startTime := time.Now()
-	rps, err := Compile(unit, c.schemaMgr)
+	rps, err := Compile(unit, c.schemaMgr, opts...)
 	durationMs := float64(time.Since(startTime)) / float64(time.Millisecond)
 
+	c.diagnostics.set(unit.ModID, warnings, err)
+
 	if err == nil && rps != nil {
 		if c.cacheDuration > 0 {
 			c.cache.SetWithExpire(unit.ModID, rps, c.cacheDuration)
 		} else {
 			c.cache.Set(unit.ModID, rps)
 		}
+
+		if c.lastKnown != nil {
+			c.lastKnown.Set(unit.ModID, rps)
+		}
 	}
 
 	status := "success"
@@ -201,14 +376,21 @@ func (c *Manager) compile(unit *policy.CompilationUnit) (*runtimev1.RunnablePoli
 
 func (c *Manager) evict(modID namer.ModuleID) {
 	c.cache.Remove(modID)
+	c.diagnostics.remove(modID)
+	if c.lastKnown != nil {
+		c.lastKnown.Remove(modID)
+	}
 }
 
 func (c *Manager) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
 	keyBuilder := new(strings.Builder)
+	useCache := c.useCache()
 	for _, modID := range candidates {
-		rps, ok := c.cache.Get(modID)
-		if ok && rps != nil {
-			return rps, nil
+		if useCache {
+			rps, ok := c.cache.Get(modID)
+			if ok && rps != nil {
+				return rps, nil
+			}
 		}
 
 		keyBuilder.WriteString(modID.String())
@@ -221,8 +403,9 @@ func (c *Manager) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID
 	rpsVal, err, _ := c.sf.Do(key, func() (any, error) {
 		cu, err := c.store.GetFirstMatch(ctx, candidates)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get compilation units: %w", err)
+			return c.fromLastKnown(ctx, err, candidates...)
 		}
+		c.setStoreAvailable(true)
 
 		if cu == nil {
 			return nil, nil
@@ -252,15 +435,18 @@ func (c *Manager) GetPolicySet(ctx context.Context, modID namer.ModuleID) (*runt
 	defer c.sf.Forget(key)
 
 	rpsVal, err, _ := c.sf.Do(key, func() (any, error) {
-		rps, ok := c.cache.Get(modID)
-		if ok {
-			return rps, nil
+		if c.useCache() {
+			rps, ok := c.cache.Get(modID)
+			if ok {
+				return rps, nil
+			}
 		}
 
 		compileUnits, err := c.store.GetCompilationUnits(ctx, modID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get compilation units: %w", err)
+			return c.fromLastKnown(ctx, err, modID)
 		}
+		c.setStoreAvailable(true)
 
 		if len(compileUnits) == 0 {
 			// store a nil value in the cache as a negative entry to prevent hitting the database again and again
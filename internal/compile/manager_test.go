@@ -239,6 +239,125 @@ func TestManager(t *testing.T) {
 	})
 }
 
+func TestManagerStoreUnavailable(t *testing.T) {
+	mkUnits := func(mod test.NameMod) (namer.ModuleID, map[namer.ModuleID]*policy.CompilationUnit) {
+		ev := policy.Wrap(test.GenExportVariables(mod))
+		rp := policy.Wrap(test.GenResourcePolicy(mod))
+		dr := policy.Wrap(test.GenDerivedRoles(mod))
+
+		return rp.ID, map[namer.ModuleID]*policy.CompilationUnit{
+			rp.ID: {
+				ModID: rp.ID,
+				Definitions: map[namer.ModuleID]*policyv1.Policy{
+					rp.ID: rp.Policy,
+					dr.ID: dr.Policy,
+					ev.ID: ev.Policy,
+				},
+			},
+		}
+	}
+
+	storeErr := errors.New("connection refused")
+
+	t.Run("serve_last_known", func(t *testing.T) {
+		conf := compile.DefaultConf()
+		conf.CacheDuration = time.Millisecond
+		mgr, mockStore, cancel := mkManagerFromConf(conf)
+		defer cancel()
+
+		var availability []bool
+		mgr.OnStoreAvailabilityChange(func(available bool) { availability = append(availability, available) })
+
+		rpID, units := mkUnits(test.NoMod())
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(units, nil).Once()
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(nil, storeErr).Twice()
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(units, nil).Once()
+
+		want, err := mgr.GetPolicySet(context.Background(), rpID)
+		require.NoError(t, err)
+		require.NotNil(t, want)
+		require.True(t, mgr.StoreAvailable())
+
+		// Wait for the cache entry to expire so that the store has to be consulted again.
+		for i := 0; i < 2; i++ {
+			time.Sleep(5 * time.Millisecond)
+			have, err := mgr.GetPolicySet(context.Background(), rpID)
+			require.NoError(t, err)
+			require.Equal(t, want, have)
+			require.False(t, mgr.StoreAvailable())
+		}
+
+		// Policies that were never loaded cannot be served.
+		otherID, _ := mkUnits(test.Suffix("_other"))
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{otherID}).Return(nil, storeErr).Once()
+		_, err = mgr.GetPolicySet(context.Background(), otherID)
+		require.ErrorIs(t, err, storeErr)
+
+		time.Sleep(5 * time.Millisecond)
+		_, err = mgr.GetPolicySet(context.Background(), rpID)
+		require.NoError(t, err)
+		require.True(t, mgr.StoreAvailable())
+		require.Equal(t, []bool{false, true}, availability)
+
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		conf := compile.DefaultConf()
+		conf.OnStoreUnavailable = compile.StoreUnavailableDeny
+		mgr, mockStore, cancel := mkManagerFromConf(conf)
+		defer cancel()
+
+		var availability []bool
+		mgr.OnStoreAvailabilityChange(func(available bool) { availability = append(availability, available) })
+
+		rpID, units := mkUnits(test.NoMod())
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(units, nil).Once()
+
+		cached, err := mgr.GetPolicySet(context.Background(), rpID)
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+
+		otherID, _ := mkUnits(test.Suffix("_other"))
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{otherID}).Return(nil, storeErr).Once()
+
+		rps, err := mgr.GetPolicySet(context.Background(), otherID)
+		require.NoError(t, err)
+		require.Nil(t, rps)
+		require.False(t, mgr.StoreAvailable())
+
+		// Cached entries must not be used while the store is unreachable.
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(nil, storeErr).Once()
+		rps, err = mgr.GetPolicySet(context.Background(), rpID)
+		require.NoError(t, err)
+		require.Nil(t, rps)
+
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(units, nil).Once()
+		rps, err = mgr.GetPolicySet(context.Background(), rpID)
+		require.NoError(t, err)
+		require.NotNil(t, rps)
+		require.True(t, mgr.StoreAvailable())
+		require.Equal(t, []bool{false, true}, availability)
+
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("cancelled_request", func(t *testing.T) {
+		mgr, mockStore, cancel := mkManager()
+		defer cancel()
+
+		rpID, _ := mkUnits(test.NoMod())
+		mockStore.On("GetCompilationUnits", mock.MatchedBy(anyCtx), []namer.ModuleID{rpID}).Return(nil, context.Canceled).Once()
+
+		ctx, cancelReq := context.WithCancel(context.Background())
+		cancelReq()
+
+		_, err := mgr.GetPolicySet(ctx, rpID)
+		require.Error(t, err)
+		require.True(t, mgr.StoreAvailable(), "Cancelled requests must not mark the store as unavailable")
+	})
+}
+
 func yield() {
 	runtime.Gosched()
 	time.Sleep(200 * time.Millisecond)
@@ -246,12 +365,16 @@ func yield() {
 }
 
 func mkManager() (*compile.Manager, *MockStore, context.CancelFunc) {
+	return mkManagerFromConf(compile.DefaultConf())
+}
+
+func mkManagerFromConf(conf *compile.Conf) (*compile.Manager, *MockStore, context.CancelFunc) {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	mockStore := &MockStore{}
 	mockStore.On("Subscribe", mock.Anything)
 
-	mgr := compile.NewManagerFromDefaultConf(ctx, mockStore, schema.NewNopManager())
+	mgr := compile.NewManagerFromConf(ctx, conf, mockStore, schema.NewNopManager())
 
 	return mgr, mockStore, cancelFunc
 }
@@ -0,0 +1,173 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package compile
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+var templateParamRegex = regexp.MustCompile(`\$\{([[:alpha:]][[:word:]]*)\}`)
+
+// expandRuleTemplates returns the rules of the resource policy followed by the concrete rules produced by instantiating its templated rules.
+// Templates are looked up in the export variables policies imported by the resource policy. The policy definition itself is not modified.
+func expandRuleTemplates(modCtx *moduleCtx, rp *policyv1.ResourcePolicy) []*policyv1.ResourceRule {
+	if len(rp.TemplatedRules) == 0 {
+		return rp.Rules
+	}
+
+	type templateInfo struct {
+		template   *policyv1.RuleTemplate
+		importName string
+	}
+
+	templates := make(map[string][]templateInfo)
+	for _, imp := range rp.Variables.GetImport() {
+		// Missing imports are reported by compilePolicyVariables.
+		evModCtx := modCtx.moduleCtx(namer.ExportVariablesModuleID(imp))
+		if evModCtx == nil {
+			continue
+		}
+
+		for name, tmpl := range evModCtx.def.GetExportVariables().GetRuleTemplates() {
+			templates[name] = append(templates[name], templateInfo{template: tmpl, importName: imp})
+		}
+	}
+
+	rules := make([]*policyv1.ResourceRule, len(rp.Rules), len(rp.Rules)+len(rp.TemplatedRules))
+	copy(rules, rp.Rules)
+
+	for _, inst := range rp.TemplatedRules {
+		candidates, ok := templates[inst.Template]
+		if !ok {
+			modCtx.addErrWithDesc(errUnknownRuleTemplate, "Rule template '%s' is not defined in any imports", inst.Template)
+			continue
+		}
+
+		if len(candidates) > 1 {
+			imports := make([]string, len(candidates))
+			for i, c := range candidates {
+				imports[i] = c.importName
+			}
+			sort.Strings(imports)
+			modCtx.addErrWithDesc(errAmbiguousRuleTemplate, "Rule template '%s' is defined in more than one import: [%s]", inst.Template, strings.Join(imports, ","))
+			continue
+		}
+
+		if rule := instantiateRuleTemplate(modCtx, inst, candidates[0].template); rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+func instantiateRuleTemplate(modCtx *moduleCtx, inst *policyv1.RuleTemplateInstance, tmpl *policyv1.RuleTemplate) *policyv1.ResourceRule {
+	declared := make(map[string]struct{}, len(tmpl.Parameters))
+	valid := true
+	for _, p := range tmpl.Parameters {
+		declared[p] = struct{}{}
+		if _, ok := inst.Parameters[p]; !ok {
+			modCtx.addErrWithDesc(errInvalidTemplateParameters, "Rule template '%s' requires parameter '%s'", inst.Template, p)
+			valid = false
+		}
+	}
+
+	for p := range inst.Parameters {
+		if _, ok := declared[p]; !ok {
+			modCtx.addErrWithDesc(errInvalidTemplateParameters, "Rule template '%s' does not declare parameter '%s'", inst.Template, p)
+			valid = false
+		}
+	}
+
+	if !valid {
+		return nil
+	}
+
+	rule, ok := proto.Clone(tmpl.Rule).(*policyv1.ResourceRule)
+	if !ok || rule == nil {
+		modCtx.addErrWithDesc(errUnexpectedErr, "Rule template '%s' does not define a rule", inst.Template)
+		return nil
+	}
+
+	undeclared := make(map[string]struct{})
+	rewriteStrings(rule.ProtoReflect(), func(s string) string {
+		return templateParamRegex.ReplaceAllStringFunc(s, func(m string) string {
+			name := templateParamRegex.FindStringSubmatch(m)[1]
+			if v, ok := inst.Parameters[name]; ok {
+				return v
+			}
+
+			undeclared[name] = struct{}{}
+			return m
+		})
+	})
+
+	if len(undeclared) > 0 {
+		names := make([]string, 0, len(undeclared))
+		for n := range undeclared {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		modCtx.addErrWithDesc(errInvalidTemplateParameters, "Rule template '%s' references undeclared parameters [%s]", inst.Template, strings.Join(names, ","))
+		return nil
+	}
+
+	return rule
+}
+
+// rewriteStrings replaces every string value in the message, including those in nested messages, lists and maps, with the result of fn.
+func rewriteStrings(msg protoreflect.Message, fn func(string) string) {
+	type update struct {
+		fd    protoreflect.FieldDescriptor
+		value string
+	}
+
+	var updates []update
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				switch fd.Kind() { //nolint:exhaustive
+				case protoreflect.StringKind:
+					list.Set(i, protoreflect.ValueOfString(fn(list.Get(i).String())))
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					rewriteStrings(list.Get(i).Message(), fn)
+				}
+			}
+		case fd.IsMap():
+			m := v.Map()
+			switch fd.MapValue().Kind() { //nolint:exhaustive
+			case protoreflect.StringKind:
+				m.Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+					m.Set(k, protoreflect.ValueOfString(fn(mv.String())))
+					return true
+				})
+			case protoreflect.MessageKind, protoreflect.GroupKind:
+				m.Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					rewriteStrings(mv.Message(), fn)
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			updates = append(updates, update{fd: fd, value: fn(v.String())})
+		case fd.Kind() == protoreflect.MessageKind, fd.Kind() == protoreflect.GroupKind:
+			rewriteStrings(v.Message(), fn)
+		}
+
+		return true
+	})
+
+	for _, u := range updates {
+		msg.Set(u.fd, protoreflect.ValueOfString(u.value))
+	}
+}
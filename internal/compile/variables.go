@@ -93,6 +93,7 @@ type variableDefinitions struct {
 	ids     map[string]int64
 	sources map[string][]string
 	used    map[string]struct{}
+	params  map[string]struct{}
 	nextID  int64
 }
 
@@ -211,12 +212,33 @@ func (vd *variableDefinitions) ResetUsage() {
 	vd.used = make(map[string]struct{}, len(vd.ids))
 }
 
+// SetParameters declares the derived role parameters that are in scope for subsequent calls to Use.
+// Parameters are not part of the variable graph because they are only visible within a single derived role definition.
+func (vd *variableDefinitions) SetParameters(names []string) {
+	if len(names) == 0 {
+		vd.params = nil
+		return
+	}
+
+	vd.params = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		vd.params[name] = struct{}{}
+	}
+}
+
+func (vd *variableDefinitions) IsDefined(name string) bool {
+	_, defined := vd.ids[name]
+	return defined
+}
+
 func (vd *variableDefinitions) Use(parent string, expr *expr.CheckedExpr) {
 	for name := range vd.references(parent, expr) {
-		id, defined := vd.ids[name]
-		if defined {
+		if id, defined := vd.ids[name]; defined {
 			vd.use(id, name)
-		} else {
+			continue
+		}
+
+		if _, isParam := vd.params[name]; !isParam {
 			vd.modCtx.addErrWithDesc(errUndefinedVariable, "Undefined variable '%s' referenced in %s", name, parent)
 		}
 	}
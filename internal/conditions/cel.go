@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
-	"github.com/google/cel-go/ext"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
@@ -22,6 +21,7 @@ const (
 	CELResourceField   = "resource"
 	CELPrincipalAbbrev = "P"
 	CELPrincipalField  = "principal"
+	CELParentIdent     = "parent"
 	CELRuntimeIdent    = "runtime"
 	CELVariablesIdent  = "variables"
 	CELVariablesAbbrev = "V"
@@ -34,12 +34,17 @@ var (
 	TrueExpr  *exprpb.CheckedExpr
 	FalseExpr *exprpb.CheckedExpr
 
+	// StdEnv is the environment used to compile policy conditions. It has the default set of CEL extension libraries loaded.
 	StdEnv *cel.Env
+	// EvalEnv is the environment used to evaluate compiled conditions. It has all the supported CEL extension libraries loaded,
+	// so that conditions compiled with any combination of them can be evaluated.
+	EvalEnv *cel.Env
 
 	StdEnvDecls = []*exprpb.Decl{
 		decls.NewVar(CELRequestIdent, decls.NewObjectType("cerbos.engine.v1.Request")),
 		decls.NewVar(CELPrincipalAbbrev, decls.NewObjectType("cerbos.engine.v1.Request.Principal")),
 		decls.NewVar(CELResourceAbbrev, decls.NewObjectType("cerbos.engine.v1.Request.Resource")),
+		decls.NewVar(CELParentIdent, decls.NewObjectType("cerbos.engine.v1.Request.Resource")),
 		decls.NewVar(CELRuntimeIdent, decls.NewObjectType("cerbos.engine.v1.Runtime")),
 		decls.NewVar(CELVariablesIdent, decls.NewMapType(decls.String, decls.Dyn)),
 		decls.NewVar(CELVariablesAbbrev, decls.NewMapType(decls.String, decls.Dyn)),
@@ -51,9 +56,6 @@ var (
 		cel.CrossTypeNumericComparisons(true),
 		cel.Types(&enginev1.Request{}, &enginev1.Request_Principal{}, &enginev1.Request_Resource{}, &enginev1.Runtime{}),
 		cel.Declarations(StdEnvDecls...),
-		ext.Strings(),
-		ext.Encoders(),
-		ext.Math(),
 		CerbosCELLib(),
 	}
 )
@@ -61,11 +63,16 @@ var (
 func init() {
 	var err error
 
-	StdEnv, err = initEnv(StdEnvOptions)
+	StdEnv, err = EnvWithExtensions(DefaultExtensions)
 	if err != nil {
 		panic(fmt.Errorf("failed to initialize standard CEL environment: %w", err))
 	}
 
+	EvalEnv, err = EnvWithExtensions(SupportedExtensions())
+	if err != nil {
+		panic(fmt.Errorf("failed to initialize CEL evaluation environment: %w", err))
+	}
+
 	FalseExpr, err = compileConstant("false")
 	if err != nil {
 		panic(fmt.Errorf("failed to compile constant 'false': %w", err))
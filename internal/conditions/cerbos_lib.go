@@ -4,6 +4,7 @@
 package conditions
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"time"
@@ -141,6 +142,18 @@ func Eval(env *cel.Env, ast *cel.Ast, vars any, nowFunc func() time.Time, opts .
 	return prg.Eval(vars)
 }
 
+// ContextEval is like Eval but the evaluation is interrupted when the context is cancelled.
+// Interruption requires the cel.InterruptCheckFrequency program option.
+func ContextEval(ctx context.Context, env *cel.Env, ast *cel.Ast, vars any, nowFunc func() time.Time, opts ...cel.ProgramOption) (ref.Val, *cel.EvalDetails, error) {
+	programOpts := append([]cel.ProgramOption{cel.CustomDecorator(newTimeDecorator(nowFunc))}, opts...)
+	prg, err := env.Program(ast, programOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return prg.ContextEval(ctx, vars)
+}
+
 func newTimeDecorator(nowFunc func() time.Time) interpreter.InterpretableDecorator {
 	td := timeDecorator{now: nowFunc()}
 	return td.decorate
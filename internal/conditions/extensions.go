@@ -0,0 +1,138 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+const (
+	ExtBindings = "bindings"
+	ExtEncoders = "encoders"
+	ExtLists    = "lists"
+	ExtMath     = "math"
+	ExtSets     = "sets"
+	ExtStrings  = "strings"
+)
+
+var (
+	// DefaultExtensions are the CEL extension libraries available to policy conditions unless configured otherwise.
+	DefaultExtensions = []string{ExtEncoders, ExtMath, ExtStrings}
+
+	ErrUnknownExtension = errors.New("unknown CEL extension")
+
+	extensions = map[string]func() cel.EnvOption{
+		ExtBindings: ext.Bindings,
+		ExtEncoders: ext.Encoders,
+		ExtLists:    ext.Lists,
+		ExtMath:     ext.Math,
+		ExtSets:     ext.Sets,
+		ExtStrings:  func() cel.EnvOption { return ext.Strings() },
+	}
+
+	extEnvs sync.Map
+)
+
+// SupportedExtensions returns the names of all the CEL extension libraries that can be enabled.
+func SupportedExtensions() []string {
+	names := make([]string, 0, len(extensions))
+	for name := range extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ValidateExtensions returns an error if any of the names is not a supported CEL extension library.
+func ValidateExtensions(names []string) error {
+	for _, name := range names {
+		if _, ok := extensions[name]; !ok {
+			return fmt.Errorf("%w %q: valid values are %s", ErrUnknownExtension, name, strings.Join(SupportedExtensions(), ", "))
+		}
+	}
+
+	return nil
+}
+
+// EnvWithExtensions returns the standard environment with only the given CEL extension libraries loaded.
+// Environments are cached, so repeated calls with the same set of extensions are cheap.
+func EnvWithExtensions(names []string) (*cel.Env, error) {
+	names = uniqueSorted(names)
+	key := strings.Join(names, ",")
+	if env, ok := extEnvs.Load(key); ok {
+		return env.(*cel.Env), nil //nolint:forcetypeassert
+	}
+
+	env, err := newEnvWithExtensions(names)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := extEnvs.LoadOrStore(key, env)
+	return actual.(*cel.Env), nil //nolint:forcetypeassert
+}
+
+func newEnvWithExtensions(names []string) (*cel.Env, error) {
+	if err := ValidateExtensions(names); err != nil {
+		return nil, err
+	}
+
+	options := make([]cel.EnvOption, 0, len(StdEnvOptions)+len(names))
+	options = append(options, StdEnvOptions...)
+	for _, name := range names {
+		options = append(options, extensions[name]())
+	}
+
+	return initEnv(options)
+}
+
+func uniqueSorted(names []string) []string {
+	sorted := make([]string, len(names))
+	copy(sorted, names)
+	sort.Strings(sorted)
+
+	unique := sorted[:0]
+	for i, name := range sorted {
+		if i == 0 || name != unique[len(unique)-1] {
+			unique = append(unique, name)
+		}
+	}
+
+	return unique
+}
+
+// DisabledExtensionsUsedBy returns the names of the extension libraries that are not enabled and would allow the expression to compile if enabled.
+// It's intended for explaining compilation failures, so it compiles the expression once for each disabled extension.
+func DisabledExtensionsUsedBy(expr string, enabled []string) []string {
+	enabledSet := make(map[string]struct{}, len(enabled))
+	for _, name := range enabled {
+		enabledSet[name] = struct{}{}
+	}
+
+	var used []string
+	for _, name := range SupportedExtensions() {
+		if _, ok := enabledSet[name]; ok {
+			continue
+		}
+
+		env, err := EnvWithExtensions(append([]string{name}, enabled...))
+		if err != nil {
+			continue
+		}
+
+		if _, issues := env.Compile(expr); issues == nil || issues.Err() == nil {
+			used = append(used, name)
+		}
+	}
+
+	return used
+}
@@ -0,0 +1,47 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvWithExtensions(t *testing.T) {
+	t.Run("cached", func(t *testing.T) {
+		env, err := EnvWithExtensions([]string{ExtStrings, ExtMath, ExtEncoders, ExtMath})
+		require.NoError(t, err)
+		require.Same(t, StdEnv, env)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := EnvWithExtensions([]string{ExtSets, "wibble"})
+		require.ErrorIs(t, err, ErrUnknownExtension)
+	})
+
+	t.Run("evaluate", func(t *testing.T) {
+		env, err := EnvWithExtensions([]string{ExtSets})
+		require.NoError(t, err)
+
+		ast, issues := env.Compile(`sets.contains([1, 2, 3], [2])`)
+		require.NoError(t, issues.Err())
+
+		checked, err := cel.AstToCheckedExpr(ast)
+		require.NoError(t, err)
+
+		// Conditions compiled with any combination of extensions must be evaluable in EvalEnv.
+		val, _, err := Eval(EvalEnv, cel.CheckedExprToAst(checked), map[string]any{}, time.Now)
+		require.NoError(t, err)
+		require.Equal(t, true, val.Value())
+	})
+}
+
+func TestDisabledExtensionsUsedBy(t *testing.T) {
+	require.Equal(t, []string{ExtSets}, DisabledExtensionsUsedBy(`sets.contains([1], [1])`, DefaultExtensions))
+	require.Equal(t, []string{ExtStrings}, DisabledExtensionsUsedBy(`"A".lowerAscii() == "a"`, nil))
+	require.Empty(t, DisabledExtensionsUsedBy(`no_such_function(1)`, DefaultExtensions))
+}
@@ -0,0 +1,80 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+// actionAliases maps the alternative names of actions to the canonical names used in policies.
+type actionAliases map[string]string
+
+func newActionAliases(conf map[string]string) actionAliases {
+	if len(conf) == 0 {
+		return nil
+	}
+
+	return actionAliases(conf)
+}
+
+// canonical returns the canonical name of the action.
+func (aa actionAliases) canonical(action string) string {
+	if c, ok := aa[action]; ok {
+		return c
+	}
+
+	return action
+}
+
+// canonicalize returns the canonical names of the actions with duplicates removed.
+// The actions are returned as-is and the second return value is false if none of them are aliases.
+func (aa actionAliases) canonicalize(actions []string) ([]string, bool) {
+	if len(aa) == 0 {
+		return actions, false
+	}
+
+	aliased := false
+	for _, a := range actions {
+		if _, ok := aa[a]; ok {
+			aliased = true
+			break
+		}
+	}
+
+	if !aliased {
+		return actions, false
+	}
+
+	out := make([]string, 0, len(actions))
+	seen := make(map[string]struct{}, len(actions))
+	for _, a := range actions {
+		c := aa.canonical(a)
+		if _, ok := seen[c]; !ok {
+			seen[c] = struct{}{}
+			out = append(out, c)
+		}
+	}
+
+	return out, true
+}
+
+func validateActionAliases(aliases map[string]string) (errs error) {
+	for alias, canonical := range aliases {
+		switch {
+		case strings.TrimSpace(alias) == "" || strings.TrimSpace(canonical) == "":
+			errs = multierr.Append(errs, errEmptyActionAlias)
+		case alias == canonical:
+			errs = multierr.Append(errs, fmt.Errorf("engine.actionAliases.%s: action must not be an alias of itself", alias))
+		default:
+			if _, ok := aliases[canonical]; ok {
+				errs = multierr.Append(errs, fmt.Errorf("engine.actionAliases.%s: canonical action %q is itself an alias", alias, canonical))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,145 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const actionAliasesTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+    - actions: ["update"]
+      roles: ["editor"]
+      effect: EFFECT_ALLOW
+`
+
+func TestActionAliasesCanonicalize(t *testing.T) {
+	aa := newActionAliases(map[string]string{"edit": "update", "modify": "update", "read": "view"})
+
+	testCases := []struct {
+		name        string
+		actions     []string
+		want        []string
+		wantAliased bool
+	}{
+		{
+			name:    "no_aliases",
+			actions: []string{"view", "delete"},
+			want:    []string{"view", "delete"},
+		},
+		{
+			name:        "alias",
+			actions:     []string{"edit", "delete"},
+			want:        []string{"update", "delete"},
+			wantAliased: true,
+		},
+		{
+			name:        "duplicates",
+			actions:     []string{"edit", "update", "modify", "read"},
+			want:        []string{"update", "view"},
+			wantAliased: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actions := append([]string(nil), tc.actions...)
+			have, aliased := aa.canonicalize(actions)
+			require.Equal(t, tc.want, have)
+			require.Equal(t, tc.wantAliased, aliased)
+			require.Equal(t, tc.actions, actions, "Input actions must not be modified")
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		var aa actionAliases
+		require.Equal(t, "edit", aa.canonical("edit"))
+
+		have, aliased := aa.canonicalize([]string{"edit"})
+		require.Equal(t, []string{"edit"}, have)
+		require.False(t, aliased)
+	})
+}
+
+func TestCheckWithActionAliases(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{
+		policies:      map[string]string{"document.yaml": actionAliasesTestPolicy},
+		actionAliases: map[string]string{"edit": "update"},
+	})
+	t.Cleanup(cancelFunc)
+
+	ctx := context.Background()
+
+	check := func(t *testing.T, roles []string, actions ...string) *enginev1.CheckOutput {
+		t.Helper()
+
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   actions,
+				Principal: &enginev1.Principal{Id: "alice", Roles: roles},
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		return outputs[0]
+	}
+
+	t.Run("alias", func(t *testing.T) {
+		actions := []string{"view", "edit"}
+		output := check(t, []string{"user", "editor"}, actions...)
+		require.Len(t, output.Actions, 2)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, output.Actions["view"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, output.Actions["edit"].Effect)
+		require.Equal(t, "resource.document.vdefault", output.Actions["edit"].Policy)
+		require.Equal(t, []string{"view", "edit"}, actions, "Request actions must not be modified")
+	})
+
+	t.Run("alias_and_canonical", func(t *testing.T) {
+		output := check(t, []string{"editor"}, "edit", "update")
+		require.Len(t, output.Actions, 2)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, output.Actions["edit"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, output.Actions["update"].Effect)
+	})
+
+	t.Run("alias_denied", func(t *testing.T) {
+		output := check(t, []string{"user"}, "edit")
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, output.Actions["edit"].Effect)
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		output, err := eng.PlanResources(ctx, &enginev1.PlanResourcesInput{
+			RequestId: "test",
+			Action:    "edit",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"editor"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, output.Filter.Kind)
+		require.Equal(t, "edit", output.Action)
+	})
+}
+
+func TestActionAliasesValidate(t *testing.T) {
+	require.NoError(t, validateActionAliases(nil))
+	require.NoError(t, validateActionAliases(map[string]string{"edit": "update", "modify": "update"}))
+	require.ErrorIs(t, validateActionAliases(map[string]string{"": "update"}), errEmptyActionAlias)
+	require.Error(t, validateActionAliases(map[string]string{"edit": "edit"}))
+	require.Error(t, validateActionAliases(map[string]string{"edit": "modify", "modify": "update"}))
+}
@@ -0,0 +1,83 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+// breakGlass grants emergency access to principals whose verified JWT asserts it.
+type breakGlass struct {
+	claim string
+	roles []string
+}
+
+func newBreakGlass(conf *BreakGlassConf) *breakGlass {
+	if conf == nil {
+		return nil
+	}
+
+	bg := &breakGlass{claim: conf.Claim, roles: conf.Roles}
+	if bg.claim == "" {
+		bg.claim = defaultBreakGlassClaim
+	}
+
+	return bg
+}
+
+// asserted returns true if the JWT in the auxiliary data has the break-glass claim set to true.
+// The JWT is verified by the auxData handler before it reaches the engine.
+func (bg *breakGlass) asserted(auxData *enginev1.AuxData) bool {
+	if bg == nil {
+		return false
+	}
+
+	return auxData.GetJwt()[bg.claim].GetBoolValue()
+}
+
+// anyAsserted returns true if any of the inputs asserts emergency access.
+func (bg *breakGlass) anyAsserted(inputs []*enginev1.CheckInput) bool {
+	for _, input := range inputs {
+		if bg.asserted(input.AuxData) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// grant returns a copy of the principal with the emergency roles added if the auxiliary data asserts emergency access.
+// The principal is returned as-is otherwise.
+func (bg *breakGlass) grant(p *enginev1.Principal, auxData *enginev1.AuxData) *enginev1.Principal {
+	if p == nil || !bg.asserted(auxData) {
+		return p
+	}
+
+	roles := make([]string, len(p.Roles), len(p.Roles)+len(bg.roles))
+	copy(roles, p.Roles)
+
+	for _, r := range bg.roles {
+		if !containsRole(roles, r) {
+			roles = append(roles, r)
+		}
+	}
+
+	return &enginev1.Principal{
+		Id:            p.Id,
+		PolicyVersion: p.PolicyVersion,
+		Roles:         roles,
+		Attr:          p.Attr,
+		Scope:         p.Scope,
+	}
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const breakGlassTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: database
+  rules:
+    - name: responders-can-restore
+      actions: ["restore"]
+      roles: ["incident_responder"]
+      effect: EFFECT_ALLOW
+`
+
+func TestBreakGlass(t *testing.T) {
+	mkInput := func(auxData *enginev1.AuxData) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"restore"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:  &enginev1.Resource{Kind: "database", Id: "db1"},
+			AuxData:   auxData,
+		}
+	}
+
+	breakGlassJWT := &enginev1.AuxData{Jwt: map[string]*structpb.Value{"break_glass": structpb.NewBoolValue(true)}}
+
+	t.Run("asserted", func(t *testing.T) {
+		eng, log := mkBreakGlassEngine(t, &BreakGlassConf{Roles: []string{"incident_responder"}})
+
+		outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(breakGlassJWT)})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["restore"].Effect)
+
+		entry := log.lastEntry(t)
+		require.True(t, entry.BreakGlass, "Decision log entry must be tagged")
+		require.Equal(t, []string{"user"}, entry.GetCheckResources().Inputs[0].Principal.Roles, "Logged input must be the original request")
+	})
+
+	t.Run("not_asserted", func(t *testing.T) {
+		eng, log := mkBreakGlassEngine(t, &BreakGlassConf{Roles: []string{"incident_responder"}})
+
+		claimFalse := &enginev1.AuxData{Jwt: map[string]*structpb.Value{"break_glass": structpb.NewStringValue("true")}}
+		for _, auxData := range []*enginev1.AuxData{nil, claimFalse} {
+			outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(auxData)})
+			require.NoError(t, err)
+			require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[0].Actions["restore"].Effect)
+			require.False(t, log.lastEntry(t).BreakGlass)
+		}
+	})
+
+	t.Run("custom_claim", func(t *testing.T) {
+		eng, _ := mkBreakGlassEngine(t, &BreakGlassConf{Claim: "emergency", Roles: []string{"incident_responder"}})
+
+		outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(breakGlassJWT)})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[0].Actions["restore"].Effect)
+
+		emergencyJWT := &enginev1.AuxData{Jwt: map[string]*structpb.Value{"emergency": structpb.NewBoolValue(true)}}
+		outputs, err = eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(emergencyJWT)})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["restore"].Effect)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		eng, log := mkBreakGlassEngine(t, nil)
+
+		outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput(breakGlassJWT)})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[0].Actions["restore"].Effect)
+		require.False(t, log.lastEntry(t).BreakGlass)
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		eng, log := mkBreakGlassEngine(t, &BreakGlassConf{Roles: []string{"incident_responder"}})
+
+		output, err := eng.PlanResources(context.Background(), &enginev1.PlanResourcesInput{
+			RequestId: "test",
+			Action:    "restore",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "database"},
+			AuxData:   breakGlassJWT,
+		})
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, output.Filter.Kind)
+		require.True(t, log.lastEntry(t).BreakGlass)
+	})
+}
+
+func TestBreakGlassConfValidate(t *testing.T) {
+	conf := &Conf{}
+	conf.SetDefaults()
+	conf.BreakGlass = &BreakGlassConf{}
+	require.ErrorIs(t, conf.Validate(), errEmptyBreakGlassRoles)
+}
+
+func mkBreakGlassEngine(t *testing.T, breakGlassConf *BreakGlassConf) (*Engine, *capturingAuditLog) {
+	t.Helper()
+
+	log := &capturingAuditLog{}
+	eng, cancelFunc := mkEngine(t, param{
+		policies:   map[string]string{"database.yaml": breakGlassTestPolicy},
+		auditLog:   log,
+		breakGlass: breakGlassConf,
+	})
+	t.Cleanup(cancelFunc)
+
+	return eng, log
+}
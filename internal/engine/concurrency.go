@@ -0,0 +1,72 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"go.opencensus.io/stats"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+// ErrConcurrencyLimitExceeded is returned when an evaluation is rejected because the concurrency limit has been reached and the queue is full.
+var ErrConcurrencyLimitExceeded = errors.New("too many concurrent evaluations")
+
+// concurrencyLimiter bounds the number of evaluations that run at the same time.
+// Evaluations that can't run straight away wait in a queue of bounded length and are rejected when it's full.
+type concurrencyLimiter struct {
+	slots     chan struct{}
+	queued    atomic.Int64
+	maxQueued int64
+}
+
+func newConcurrencyLimiter(conf *ConcurrencyLimitConf) *concurrencyLimiter {
+	if conf == nil || conf.MaxConcurrent == 0 {
+		return nil
+	}
+
+	return &concurrencyLimiter{
+		slots:     make(chan struct{}, conf.MaxConcurrent),
+		maxQueued: int64(conf.MaxQueued),
+	}
+}
+
+// acquire waits until the evaluation can be run and returns a function to call when it's done.
+func (cl *concurrencyLimiter) acquire(ctx context.Context) (func(), error) {
+	if cl == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case cl.slots <- struct{}{}:
+		return cl.release, nil
+	default:
+	}
+
+	queued := cl.queued.Add(1)
+	if queued > cl.maxQueued {
+		cl.queued.Add(-1)
+		stats.Record(context.Background(), metrics.EngineConcurrencyRejectedCount.M(1))
+		return nil, ErrConcurrencyLimitExceeded
+	}
+
+	stats.Record(context.Background(), metrics.EngineConcurrencyQueueDepth.M(queued))
+	defer func() {
+		stats.Record(context.Background(), metrics.EngineConcurrencyQueueDepth.M(cl.queued.Add(-1)))
+	}()
+
+	select {
+	case cl.slots <- struct{}{}:
+		return cl.release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (cl *concurrencyLimiter) release() {
+	<-cl.slots
+}
@@ -0,0 +1,179 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		release, err := newConcurrencyLimiter(nil).acquire(context.Background())
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		cl := newConcurrencyLimiter(&ConcurrencyLimitConf{MaxConcurrent: 2})
+
+		release1, err := cl.acquire(context.Background())
+		require.NoError(t, err)
+		release2, err := cl.acquire(context.Background())
+		require.NoError(t, err)
+
+		_, err = cl.acquire(context.Background())
+		require.ErrorIs(t, err, ErrConcurrencyLimitExceeded)
+
+		release1()
+		release3, err := cl.acquire(context.Background())
+		require.NoError(t, err)
+
+		release2()
+		release3()
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		cl := newConcurrencyLimiter(&ConcurrencyLimitConf{MaxConcurrent: 1, MaxQueued: 1})
+
+		release, err := cl.acquire(context.Background())
+		require.NoError(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			r, err := cl.acquire(context.Background())
+			if err == nil {
+				r()
+			}
+			close(acquired)
+		}()
+
+		require.Eventually(t, func() bool { return cl.queued.Load() == 1 }, 5*time.Second, time.Millisecond)
+
+		_, err = cl.acquire(context.Background())
+		require.ErrorIs(t, err, ErrConcurrencyLimitExceeded, "Evaluations beyond the queue length must be rejected")
+
+		select {
+		case <-acquired:
+			t.Fatal("Queued evaluation must wait for a free slot")
+		default:
+		}
+
+		release()
+		require.Eventually(t, func() bool {
+			select {
+			case <-acquired:
+				return true
+			default:
+				return false
+			}
+		}, 5*time.Second, time.Millisecond)
+		require.Equal(t, int64(0), cl.queued.Load())
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		cl := newConcurrencyLimiter(&ConcurrencyLimitConf{MaxConcurrent: 1, MaxQueued: 1})
+
+		release, err := cl.acquire(context.Background())
+		require.NoError(t, err)
+		defer release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = cl.acquire(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, int64(0), cl.queued.Load())
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		const (
+			maxConcurrent = 4
+			numWorkers    = 32
+		)
+
+		cl := newConcurrencyLimiter(&ConcurrencyLimitConf{MaxConcurrent: maxConcurrent, MaxQueued: numWorkers})
+
+		var running, maxRunning atomic.Int64
+		var wg sync.WaitGroup
+		for i := 0; i < numWorkers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				release, err := cl.acquire(context.Background())
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				defer release()
+
+				n := running.Add(1)
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+				running.Add(-1)
+			}()
+		}
+
+		wg.Wait()
+		require.LessOrEqual(t, maxRunning.Load(), int64(maxConcurrent))
+		require.Positive(t, maxRunning.Load())
+	})
+}
+
+func TestCheckWithConcurrencyLimit(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{
+		policies:         map[string]string{"document.yaml": actionAliasesTestPolicy},
+		concurrencyLimit: &ConcurrencyLimitConf{MaxConcurrent: 1},
+	})
+	t.Cleanup(cancelFunc)
+
+	ctx := context.Background()
+
+	inputs := []*enginev1.CheckInput{
+		{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+		},
+	}
+
+	outputs, err := eng.Check(ctx, inputs)
+	require.NoError(t, err)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view"].Effect)
+
+	// Occupy the only slot.
+	release, err := eng.concurrency.acquire(ctx)
+	require.NoError(t, err)
+
+	_, err = eng.Check(ctx, inputs)
+	require.ErrorIs(t, err, ErrConcurrencyLimitExceeded)
+
+	_, err = eng.PlanResources(ctx, &enginev1.PlanResourcesInput{
+		RequestId: "test",
+		Action:    "view",
+		Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+		Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document"},
+	})
+	require.ErrorIs(t, err, ErrConcurrencyLimitExceeded)
+
+	release()
+	_, err = eng.Check(ctx, inputs)
+	require.NoError(t, err)
+}
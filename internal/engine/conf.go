@@ -5,16 +5,40 @@ package engine
 
 import (
 	"errors"
+	"fmt"
 	"runtime"
 	"strings"
+	"time"
+
+	"go.uber.org/multierr"
 
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/namer"
 )
 
-const confKey = "engine"
+const (
+	confKey = "engine"
+
+	defaultBreakGlassClaim   = "break_glass"
+	defaultPrincipalID       = "anonymous"
+	defaultDecisionCacheSize = 1024
+	defaultDecisionCacheTTL  = 60 * time.Second
+	defaultGroupsAttr        = "groups"
+	defaultGroupsMaxDepth    = 10
+	defaultMaxRemediation    = 1
+	defaultShadowConcurrency = 16
+)
 
-var errEmptyDefaultVersion = errors.New("engine.defaultVersion must not be an empty string")
+var (
+	errEmptyDefaultVersion        = errors.New("engine.defaultVersion must not be an empty string")
+	errEmptyShadowStorageDriver   = errors.New("engine.shadow.storage.driver must not be an empty string")
+	errEmptyGroupDefinitions      = errors.New("engine.groups.definitions must contain at least one group")
+	errEmptyBreakGlassRoles       = errors.New("engine.breakGlass.roles must contain at least one role")
+	errNegativeDecisionCacheTTL   = errors.New("engine.decisionCache.ttl must not be negative")
+	errEmptyDefaultPrincipalRoles = errors.New("engine.defaultPrincipal.roles must contain at least one role")
+	errEmptyActionAlias           = errors.New("engine.actionAliases must not contain empty action names")
+	errZeroMaxConcurrent          = errors.New("engine.concurrencyLimit.maxConcurrent must be greater than zero")
+)
 
 // Conf is optional configuration for engine.
 type Conf struct {
@@ -24,7 +48,118 @@ type Conf struct {
 	DefaultPolicyVersion string `yaml:"defaultPolicyVersion" conf:",example=\"default\""`
 	// LenientScopeSearch configures the engine to ignore missing scopes and search upwards through the scope tree until it finds a usable policy.
 	LenientScopeSearch bool `yaml:"lenientScopeSearch" conf:",example=false"`
-	NumWorkers         uint `yaml:"numWorkers" conf:",ignore"`
+	// Shadow configures a secondary policy set that is evaluated alongside the primary policies. Decisions are always served from the primary policies and any differences are logged and counted.
+	Shadow *ShadowConf `yaml:"shadow"`
+	// Groups configures the expansion of nested group memberships into principal roles. Roles granted to a group apply to all principals that are direct or indirect members of that group.
+	Groups *GroupsConf `yaml:"groups"`
+	// BreakGlass configures emergency access. Principals presenting a verified JWT that asserts emergency access are granted additional roles and their decisions are always logged.
+	BreakGlass *BreakGlassConf `yaml:"breakGlass"`
+	// DecisionCache configures caching of CheckResources decisions. Cached decisions are keyed by the version of the active policy set, so they are invalidated whenever the policies change.
+	DecisionCache *DecisionCacheConf `yaml:"decisionCache"`
+	// RemediationHints configures adding hints about what would be needed to allow denied actions to CheckResources responses. The hints are derived from the allow rules that came closest to matching and reveal details of the policies to the client.
+	RemediationHints *RemediationHintsConf `yaml:"remediationHints"`
+	// ActionAliases maps alternative action names used by clients to the canonical action names referenced in policies. Actions are normalized before matching policy rules and responses use the action names from the request.
+	ActionAliases map[string]string `yaml:"actionAliases" conf:",example={\"edit\": \"update\"}"`
+	// ConcurrencyLimit bounds the number of CheckResources and PlanResources requests evaluated at the same time to protect the server from being overloaded.
+	ConcurrencyLimit *ConcurrencyLimitConf `yaml:"concurrencyLimit"`
+	// DefaultPrincipal is the principal used for CheckResources and PlanResources requests that don't specify one. Requests without a principal are rejected if it's not configured.
+	DefaultPrincipal *DefaultPrincipalConf `yaml:"defaultPrincipal"`
+	// ResourceKindInference lists the rules for inferring the kind of resources from their IDs when requests don't specify one. The rules are tried in order and the first one with a pattern that matches the ID determines the kind.
+	ResourceKindInference []ResourceKindRule `yaml:"resourceKindInference"`
+	NumWorkers            uint               `yaml:"numWorkers" conf:",ignore"`
+}
+
+type ShadowConf struct {
+	// Storage configures the store that holds the shadow policies. It has the same format as the top-level storage section and supports the same drivers.
+	Storage map[string]any `yaml:"storage" conf:"required,example={\"driver\": \"disk\", \"disk\": {\"directory\": \"/path/to/shadow/policies\"}}"`
+	// MaxConcurrent is the maximum number of shadow evaluations running in the background. Shadow evaluations of requests received while the limit is reached are skipped. Defaults to 16.
+	MaxConcurrent uint `yaml:"maxConcurrent" conf:",example=16"`
+}
+
+type GroupsConf struct {
+	// Definitions maps group names to the roles granted by the group and the parent groups it belongs to.
+	Definitions map[string]GroupDef `yaml:"definitions" conf:"required,example={\"engineering\": {\"roles\": [\"developer\"]}}"`
+	// Attr is the name of the principal attribute that lists the groups the principal is a direct member of. Defaults to "groups".
+	Attr string `yaml:"attr" conf:",example=groups"`
+	// MaxDepth is the maximum number of ancestor levels to expand. Defaults to 10.
+	MaxDepth uint `yaml:"maxDepth" conf:",example=10"`
+}
+
+type GroupDef struct {
+	// Roles are the roles granted to the members of the group.
+	Roles []string `yaml:"roles" conf:",example=[\"developer\"]"`
+	// MemberOf lists the parent groups of this group.
+	MemberOf []string `yaml:"memberOf" conf:",example=[\"staff\"]"`
+}
+
+type BreakGlassConf struct {
+	// Claim is the name of the JWT claim that asserts emergency access when set to true. Defaults to "break_glass".
+	Claim string `yaml:"claim" conf:",example=break_glass"`
+	// Roles are the roles granted to the principal while emergency access is asserted.
+	Roles []string `yaml:"roles" conf:"required,example=[\"incident_responder\"]"`
+}
+
+type DecisionCacheConf struct {
+	// Size is the maximum number of decisions to cache. Defaults to 1024.
+	Size uint `yaml:"size" conf:",example=1024"`
+	// TTL is the maximum duration a decision is cached for. Decisions that depend on the current time may be stale for up to this duration. Defaults to 60s.
+	TTL time.Duration `yaml:"ttl" conf:",example=60s"`
+}
+
+type ConcurrencyLimitConf struct {
+	// MaxConcurrent is the maximum number of requests evaluated at the same time.
+	MaxConcurrent uint `yaml:"maxConcurrent" conf:"required,example=64"`
+	// MaxQueued is the maximum number of requests waiting for their turn when MaxConcurrent requests are being evaluated. Requests beyond the limit are rejected with a ResourceExhausted error. Defaults to 0, which rejects requests as soon as the concurrency limit is reached.
+	MaxQueued uint `yaml:"maxQueued" conf:",example=256"`
+}
+
+type DefaultPrincipalConf struct {
+	// Attr are the attributes of the default principal.
+	Attr map[string]any `yaml:"attr" conf:",example={\"authenticated\": false}"`
+	// ID is the ID of the default principal. Defaults to "anonymous".
+	ID string `yaml:"id" conf:",example=anonymous"`
+	// Roles are the roles of the default principal.
+	Roles []string `yaml:"roles" conf:"required,example=[\"anonymous\"]"`
+}
+
+type ResourceKindRule struct {
+	// Pattern is the regular expression to match against the resource ID.
+	Pattern string `yaml:"pattern" conf:"required,example=\"^doc:\""`
+	// Kind is the kind of the resources with IDs that match the pattern. It can refer to the capture groups of the pattern, such as $1 or ${name}.
+	Kind string `yaml:"kind" conf:"required,example=document"`
+}
+
+type RemediationHintsConf struct {
+	// MaxHints is the maximum number of hints to return for each denied action. Defaults to 1.
+	MaxHints uint `yaml:"maxHints" conf:",example=1"`
+}
+
+func (rc *RemediationHintsConf) maxHints() uint {
+	if rc == nil {
+		return 0
+	}
+
+	if rc.MaxHints == 0 {
+		return defaultMaxRemediation
+	}
+
+	return rc.MaxHints
+}
+
+func (gc *GroupsConf) validate() (errs error) {
+	if len(gc.Definitions) == 0 {
+		return errEmptyGroupDefinitions
+	}
+
+	for name, def := range gc.Definitions {
+		for _, parent := range def.MemberOf {
+			if _, ok := gc.Definitions[parent]; !ok {
+				errs = multierr.Append(errs, fmt.Errorf("engine.groups.definitions.%s: parent group %q is not defined", name, parent))
+			}
+		}
+	}
+
+	return errs
 }
 
 func (c *Conf) Key() string {
@@ -41,6 +176,42 @@ func (c *Conf) Validate() error {
 		return errEmptyDefaultVersion
 	}
 
+	if c.Shadow != nil {
+		if driver, _ := c.Shadow.Storage["driver"].(string); strings.TrimSpace(driver) == "" {
+			return errEmptyShadowStorageDriver
+		}
+	}
+
+	if c.BreakGlass != nil && len(c.BreakGlass.Roles) == 0 {
+		return errEmptyBreakGlassRoles
+	}
+
+	if c.DecisionCache != nil && c.DecisionCache.TTL < 0 {
+		return errNegativeDecisionCacheTTL
+	}
+
+	if c.ConcurrencyLimit != nil && c.ConcurrencyLimit.MaxConcurrent == 0 {
+		return errZeroMaxConcurrent
+	}
+
+	if c.DefaultPrincipal != nil {
+		if _, err := c.DefaultPrincipal.principal(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateActionAliases(c.ActionAliases); err != nil {
+		return err
+	}
+
+	if _, err := newKindInferrer(c.ResourceKindInference); err != nil {
+		return err
+	}
+
+	if c.Groups != nil {
+		return c.Groups.validate()
+	}
+
 	return nil
 }
 
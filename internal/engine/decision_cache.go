@@ -0,0 +1,100 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/cache"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+var decisionCacheIgnoreFields = map[string]struct{}{
+	"cerbos.engine.v1.CheckInput.request_id": {},
+}
+
+// PolicyFingerprinter is implemented by policy loaders that can identify the version of the policy set they currently serve.
+// The fingerprint must change whenever the policies change.
+type PolicyFingerprinter interface {
+	PolicyFingerprint() string
+}
+
+type decisionCacheKey struct {
+	fingerprint        string
+	input              uint64
+	parent             uint64
+	lenientScopeSearch bool
+	includeScopeChain  bool
+}
+
+// decisionCache caches the outputs of CheckResources evaluations.
+// Keys include the fingerprint of the active policy set so that a policy reload makes all previous entries unreachable.
+type decisionCache struct {
+	fingerprinter PolicyFingerprinter
+	cache         *cache.Cache[decisionCacheKey, *enginev1.CheckOutput]
+	conf          DecisionCacheConf
+}
+
+func newDecisionCache(conf *DecisionCacheConf, policyLoader PolicyLoader) *decisionCache {
+	if conf == nil {
+		return nil
+	}
+
+	fingerprinter, ok := policyLoader.(PolicyFingerprinter)
+	if !ok {
+		return nil
+	}
+
+	dc := &decisionCache{fingerprinter: fingerprinter, conf: *conf}
+	if dc.conf.Size == 0 {
+		dc.conf.Size = defaultDecisionCacheSize
+	}
+
+	if dc.conf.TTL == 0 {
+		dc.conf.TTL = defaultDecisionCacheTTL
+	}
+
+	dc.cache = cache.New[decisionCacheKey, *enginev1.CheckOutput]("decision", dc.conf.Size)
+	return dc
+}
+
+// key returns the cache key for the input. The second return value is false if the decision must not be cached.
+func (dc *decisionCache) key(input *enginev1.CheckInput, checkOpts *CheckOptions) (decisionCacheKey, bool) {
+	// Traced evaluations, evaluations at a pinned time and evaluations with a policy overlay must always be computed.
+	if dc == nil || checkOpts.tracerSink != nil || checkOpts.pinnedTime || checkOpts.evalParams.overlay != nil {
+		return decisionCacheKey{}, false
+	}
+
+	key := decisionCacheKey{
+		fingerprint:        dc.fingerprinter.PolicyFingerprint(),
+		input:              util.HashPB(input, decisionCacheIgnoreFields),
+		lenientScopeSearch: checkOpts.evalParams.lenientScopeSearch,
+		includeScopeChain:  checkOpts.evalParams.includeScopeChain,
+	}
+
+	// The attributes of the parent are not part of the input, so the key must account for them separately.
+	if parent := checkOpts.evalParams.parent; parent != nil {
+		key.parent = util.HashPB(parent, nil)
+	}
+
+	return key, true
+}
+
+func (dc *decisionCache) get(key decisionCacheKey, requestID string) (*enginev1.CheckOutput, bool) {
+	output, ok := dc.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	//nolint:forcetypeassert
+	output = proto.Clone(output).(*enginev1.CheckOutput)
+	output.RequestId = requestID
+	return output, true
+}
+
+func (dc *decisionCache) put(key decisionCacheKey, output *enginev1.CheckOutput) {
+	//nolint:forcetypeassert
+	dc.cache.SetWithExpire(key, proto.Clone(output).(*enginev1.CheckOutput), dc.conf.TTL)
+}
@@ -0,0 +1,168 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+const decisionCacheTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: report
+  rules:
+    - name: view
+      actions: ["view"]
+      roles: ["user"]
+      effect: %s
+`
+
+func TestDecisionCache(t *testing.T) {
+	mkInputs := func(requestID string) []*enginev1.CheckInput {
+		return []*enginev1.CheckInput{
+			{
+				RequestId: requestID,
+				Actions:   []string{"view"},
+				Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: "report", Id: "r1"},
+			},
+		}
+	}
+
+	check := func(t *testing.T, eng *Engine, requestID string, opts ...CheckOpt) *enginev1.CheckOutput {
+		t.Helper()
+
+		outputs, err := eng.Check(context.Background(), mkInputs(requestID), opts...)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		return outputs[0]
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		eng, loader := mkDecisionCacheEngine(t, &DecisionCacheConf{})
+
+		first := check(t, eng, "req1")
+		calls := loader.calls.Load()
+
+		second := check(t, eng, "req2")
+		require.Equal(t, calls, loader.calls.Load(), "Cached decision must not load policies")
+		require.Equal(t, "req2", second.RequestId)
+		require.Equal(t, first.Actions["view"].Effect, second.Actions["view"].Effect)
+	})
+
+	t.Run("fingerprint_change", func(t *testing.T) {
+		eng, loader := mkDecisionCacheEngine(t, &DecisionCacheConf{})
+
+		check(t, eng, "req1")
+		calls := loader.calls.Load()
+
+		loader.setFingerprint("other")
+		check(t, eng, "req2")
+		require.Greater(t, loader.calls.Load(), calls, "Decision must be recomputed after the fingerprint changes")
+	})
+
+	t.Run("pinned_time", func(t *testing.T) {
+		eng, loader := mkDecisionCacheEngine(t, &DecisionCacheConf{})
+
+		check(t, eng, "req1")
+		calls := loader.calls.Load()
+
+		check(t, eng, "req2", WithNowFunc(time.Now))
+		require.Greater(t, loader.calls.Load(), calls, "Decisions at a pinned time must not be served from the cache")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		eng, loader := mkDecisionCacheEngine(t, nil)
+
+		check(t, eng, "req1")
+		calls := loader.calls.Load()
+
+		check(t, eng, "req2")
+		require.Greater(t, loader.calls.Load(), calls)
+	})
+
+	t.Run("policy_reload", func(t *testing.T) {
+		dir := writeDecisionCacheTestPolicy(t, t.TempDir(), "EFFECT_ALLOW")
+		eng, cancelFunc := mkEngine(t, param{
+			policyDir:     dir,
+			decisionCache: &DecisionCacheConf{TTL: time.Hour},
+		})
+		t.Cleanup(cancelFunc)
+
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, check(t, eng, "req1").Actions["view"].Effect)
+
+		writeDecisionCacheTestPolicy(t, dir, "EFFECT_DENY")
+
+		require.Eventually(t, func() bool {
+			return check(t, eng, "req2").Actions["view"].Effect == effectv1.Effect_EFFECT_DENY
+		}, 5*time.Second, 50*time.Millisecond, "Reload did not invalidate the cached decision")
+	})
+}
+
+func writeDecisionCacheTestPolicy(t *testing.T, dir, effect string) string {
+	t.Helper()
+
+	policy := []byte(fmt.Sprintf(decisionCacheTestPolicy, effect))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "report.yaml"), policy, 0o600))
+	return dir
+}
+
+func mkDecisionCacheEngine(t *testing.T, cacheConf *DecisionCacheConf) (*Engine, *fingerprintingLoader) {
+	t.Helper()
+
+	var loader *fingerprintingLoader
+	eng, cancelFunc := mkEngine(t, param{
+		policies:      map[string]string{"report.yaml": fmt.Sprintf(decisionCacheTestPolicy, "EFFECT_ALLOW")},
+		decisionCache: cacheConf,
+		wrapPolicyLoader: func(pl PolicyLoader) PolicyLoader {
+			loader = &fingerprintingLoader{PolicyLoader: pl, fingerprint: "initial"}
+			return loader
+		},
+	})
+	t.Cleanup(cancelFunc)
+
+	return eng, loader
+}
+
+// fingerprintingLoader counts the policy lookups and reports a fingerprint controlled by the test.
+type fingerprintingLoader struct {
+	PolicyLoader
+	fingerprint string
+	calls       atomic.Int64
+	mu          sync.RWMutex
+}
+
+func (l *fingerprintingLoader) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	l.calls.Add(1)
+	return l.PolicyLoader.GetFirstMatch(ctx, candidates)
+}
+
+func (l *fingerprintingLoader) PolicyFingerprint() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.fingerprint
+}
+
+func (l *fingerprintingLoader) setFingerprint(fingerprint string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.fingerprint = fingerprint
+}
@@ -0,0 +1,47 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+// principal converts the configuration to the principal used for requests that don't specify one.
+func (dpc *DefaultPrincipalConf) principal() (*enginev1.Principal, error) {
+	if dpc == nil {
+		return nil, nil
+	}
+
+	if len(dpc.Roles) == 0 {
+		return nil, errEmptyDefaultPrincipalRoles
+	}
+
+	p := &enginev1.Principal{Id: dpc.ID, Roles: dpc.Roles}
+	if p.Id == "" {
+		p.Id = defaultPrincipalID
+	}
+
+	if len(dpc.Attr) > 0 {
+		p.Attr = make(map[string]*structpb.Value, len(dpc.Attr))
+		for k, v := range dpc.Attr {
+			pv, err := structpb.NewValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("engine.defaultPrincipal.attr.%s: invalid value: %w", k, err)
+			}
+			p.Attr[k] = pv
+		}
+	}
+
+	return p, nil
+}
+
+// DefaultPrincipal returns the principal to use for requests that don't specify one or nil if there's no default principal.
+// The returned principal is shared and must not be modified.
+func (engine *Engine) DefaultPrincipal() *enginev1.Principal {
+	return engine.defaultPrincipal
+}
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"net/http"
 	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,8 +47,11 @@ type PolicyLoader interface {
 }
 
 type CheckOptions struct {
-	tracerSink tracer.Sink
-	evalParams evalParams
+	tracerSink   tracer.Sink
+	explanations []*tracer.Collector
+	parents      []*enginev1.Request_Resource
+	evalParams   evalParams
+	pinnedTime   bool
 }
 
 func (co *CheckOptions) NowFunc() func() time.Time {
@@ -75,6 +79,15 @@ func newCheckOptions(ctx context.Context, conf *Conf, opts ...CheckOpt) *CheckOp
 	}
 
 	co := &CheckOptions{tracerSink: tracerSink, evalParams: defaultEvalParams(conf)}
+	if now, ok := RequestTimeFromContext(ctx); ok {
+		co.evalParams.nowFunc = func() time.Time { return now }
+		co.pinnedTime = true
+	}
+
+	if overlay := policyOverlayFromContext(ctx); overlay != nil {
+		co.evalParams.overlay = overlay
+	}
+
 	for _, opt := range opts {
 		opt(co)
 	}
@@ -82,6 +95,26 @@ func newCheckOptions(ctx context.Context, conf *Conf, opts ...CheckOpt) *CheckOp
 	return co
 }
 
+// forInput returns the options to use for evaluating the input at the given index.
+func (co *CheckOptions) forInput(index int) *CheckOptions {
+	if co.explanations == nil && co.parents == nil {
+		return co
+	}
+
+	inputOpts := *co
+	if co.explanations != nil {
+		collector := tracer.NewCollector()
+		co.explanations[index] = collector
+		inputOpts.tracerSink = tracer.Tee(co.tracerSink, collector)
+	}
+
+	if co.parents != nil {
+		inputOpts.evalParams.parent = co.parents[index]
+	}
+
+	return &inputOpts
+}
+
 // CheckOpt defines options for engine Check calls.
 type CheckOpt func(*CheckOptions)
 
@@ -100,6 +133,46 @@ func WithZapTraceSink(log *zap.Logger) CheckOpt {
 func WithNowFunc(nowFunc func() time.Time) CheckOpt {
 	return func(co *CheckOptions) {
 		co.evalParams.nowFunc = nowFunc
+		co.pinnedTime = true
+	}
+}
+
+type requestTimeCtxKeyType struct{}
+
+var requestTimeCtxKey = &requestTimeCtxKeyType{}
+
+// ContextWithRequestTime returns a context that pins `now` to the given time for Check calls made with it.
+// It is intended for reproducing past decisions. An explicit WithNowFunc option takes precedence.
+func ContextWithRequestTime(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, requestTimeCtxKey, now)
+}
+
+// RequestTimeFromContext returns the time pinned by ContextWithRequestTime, if any.
+func RequestTimeFromContext(ctx context.Context) (time.Time, bool) {
+	now, ok := ctx.Value(requestTimeCtxKey).(time.Time)
+	return now, ok
+}
+
+type expressionTraceCtxKeyType struct{}
+
+var expressionTraceCtxKey = &expressionTraceCtxKeyType{}
+
+// ContextWithExpressionTrace returns a context that requests the values of the sub-expressions of the deciding conditions
+// to be recorded in the decision log explanations of Check calls made with it. It has no effect if explanations are disabled.
+func ContextWithExpressionTrace(ctx context.Context) context.Context {
+	return context.WithValue(ctx, expressionTraceCtxKey, true)
+}
+
+// ExpressionTraceFromContext reports whether ContextWithExpressionTrace was used to create the context.
+func ExpressionTraceFromContext(ctx context.Context) bool {
+	enabled, _ := ctx.Value(expressionTraceCtxKey).(bool)
+	return enabled
+}
+
+// WithScopeChain adds the scopes of the policies that were considered for each input to the outputs.
+func WithScopeChain() CheckOpt {
+	return func(co *CheckOptions) {
+		co.evalParams.includeScopeChain = true
 	}
 }
 
@@ -116,6 +189,17 @@ type Engine struct {
 	policyLoader      PolicyLoader
 	conf              *Conf
 	metadataExtractor audit.MetadataExtractor
+	shadow            *Engine
+	shadowSlots       chan struct{}
+	shadowPending     sync.WaitGroup
+	groups            *groupExpander
+	actionAliases     actionAliases
+	breakGlass        *breakGlass
+	decisionCache     *decisionCache
+	concurrency       *concurrencyLimiter
+	kindInferrer      kindInferrer
+	defaultPrincipal  *enginev1.Principal
+	explanations      audit.DecisionLogExplanations
 	workerPool        []chan<- workIn
 	workerIndex       uint64
 }
@@ -125,6 +209,10 @@ type Components struct {
 	PolicyLoader      PolicyLoader
 	SchemaMgr         schema.Manager
 	MetadataExtractor audit.MetadataExtractor
+	// ShadowPolicyLoader is an optional secondary policy set to evaluate alongside the primary for comparison.
+	ShadowPolicyLoader PolicyLoader
+	// DecisionLogExplanations configures the explanations included in the decision log entries.
+	DecisionLogExplanations audit.DecisionLogExplanations
 }
 
 func New(ctx context.Context, components Components) (*Engine, error) {
@@ -162,13 +250,55 @@ func NewEphemeral(policyLoader PolicyLoader, schemaMgr schema.Manager) (*Engine,
 }
 
 func newEngine(conf *Conf, c Components) *Engine {
-	return &Engine{
+	engine := &Engine{
 		conf:              conf,
 		policyLoader:      c.PolicyLoader,
 		schemaMgr:         c.SchemaMgr,
 		auditLog:          c.AuditLog,
 		metadataExtractor: c.MetadataExtractor,
+		groups:            newGroupExpander(conf.Groups),
+		actionAliases:     newActionAliases(conf.ActionAliases),
+		breakGlass:        newBreakGlass(conf.BreakGlass),
+		decisionCache:     newDecisionCache(conf.DecisionCache, c.PolicyLoader),
+		concurrency:       newConcurrencyLimiter(conf.ConcurrencyLimit),
+		explanations:      c.DecisionLogExplanations,
+	}
+
+	if dp, err := conf.DefaultPrincipal.principal(); err != nil {
+		zap.L().Named("engine").Warn("Ignoring invalid default principal", zap.Error(err))
+	} else {
+		engine.defaultPrincipal = dp
+	}
+
+	if ki, err := newKindInferrer(conf.ResourceKindInference); err != nil {
+		zap.L().Named("engine").Warn("Ignoring invalid resource kind inference rules", zap.Error(err))
+	} else {
+		engine.kindInferrer = ki
 	}
+
+	if conf.DecisionCache != nil && engine.decisionCache == nil {
+		zap.L().Named("engine").Warn("Decision cache is disabled because the policy store does not support fingerprinting the policies")
+	}
+
+	if c.ShadowPolicyLoader != nil {
+		maxShadow := uint(defaultShadowConcurrency)
+		if conf.Shadow != nil && conf.Shadow.MaxConcurrent > 0 {
+			maxShadow = conf.Shadow.MaxConcurrent
+		}
+
+		engine.shadowSlots = make(chan struct{}, maxShadow)
+		engine.shadow = &Engine{
+			conf:          conf,
+			policyLoader:  c.ShadowPolicyLoader,
+			schemaMgr:     c.SchemaMgr,
+			auditLog:      audit.NewNopLog(),
+			groups:        engine.groups,
+			actionAliases: engine.actionAliases,
+			breakGlass:    engine.breakGlass,
+		}
+	}
+
+	return engine
 }
 
 func (engine *Engine) startWorker(ctx context.Context, num int, inputChan <-chan workIn) {
@@ -208,8 +338,14 @@ func (engine *Engine) submitWork(ctx context.Context, work workIn) error {
 }
 
 func (engine *Engine) PlanResources(ctx context.Context, input *enginev1.PlanResourcesInput) (*enginev1.PlanResourcesOutput, error) {
+	release, err := engine.concurrency.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	output, err := measurePlanLatency(func() (output *enginev1.PlanResourcesOutput, err error) {
-		ctx, span := tracing.StartSpan(ctx, "engine.Plan")
+		ctx, span := tracing.StartSpan(ctx, "engine.Plan", tracing.ResourceKind(input.GetResource().GetKind()))
 		defer span.End()
 
 		output, err = engine.doPlanResources(ctx, input)
@@ -220,6 +356,10 @@ func (engine *Engine) PlanResources(ctx context.Context, input *enginev1.PlanRes
 		return output, err
 	})
 
+	if err == nil && engine.shadow != nil {
+		engine.shadowPlanResources(ctx, input, output)
+	}
+
 	return engine.logPlanDecision(ctx, input, output, err)
 }
 
@@ -229,9 +369,24 @@ func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanR
 		return nil, err
 	}
 
+	requestedAction := input.Action
+	action := engine.actionAliases.canonical(input.Action)
+	if principal := engine.expandPrincipal(input.Principal, input.AuxData); principal != input.Principal || action != input.Action {
+		input = &enginev1.PlanResourcesInput{
+			RequestId:   input.RequestId,
+			Action:      action,
+			Principal:   principal,
+			Resource:    input.Resource,
+			AuxData:     input.AuxData,
+			IncludeMeta: input.IncludeMeta,
+		}
+	}
+
+	overlay := policyOverlayFromContext(ctx)
+
 	// get the principal policy check
 	ppName, ppVersion, ppScope := engine.policyAttr(input.Principal.Id, input.Principal.PolicyVersion, input.Principal.Scope)
-	policySet, err := engine.getPrincipalPolicySet(ctx, ppName, ppVersion, ppScope, engine.conf.LenientScopeSearch)
+	policySet, err := engine.getPrincipalPolicySet(ctx, overlay, ppName, ppVersion, ppScope, engine.conf.LenientScopeSearch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", ppName, ppVersion, err)
 	}
@@ -248,7 +403,7 @@ func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanR
 
 	// get the resource policy check
 	rpName, rpVersion, rpScope := engine.policyAttr(input.Resource.Kind, input.Resource.PolicyVersion, input.Resource.Scope)
-	policySet, err = engine.getResourcePolicySet(ctx, rpName, rpVersion, rpScope, engine.conf.LenientScopeSearch)
+	policySet, err = engine.getResourcePolicySet(ctx, overlay, rpName, rpVersion, rpScope, engine.conf.LenientScopeSearch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", rpName, rpVersion, err)
 	}
@@ -267,6 +422,7 @@ func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanR
 	if err != nil {
 		return nil, err
 	}
+	output.Action = requestedAction
 
 	if result.Empty() {
 		output.FilterDebug = noPolicyMatch
@@ -276,6 +432,11 @@ func (engine *Engine) doPlanResources(ctx context.Context, input *enginev1.PlanR
 }
 
 func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanResourcesInput, output *enginev1.PlanResourcesOutput, planErr error) (*enginev1.PlanResourcesOutput, error) {
+	breakGlass := engine.breakGlass.asserted(input.AuxData)
+	if breakGlass {
+		ctx = engine.logBreakGlass(ctx, input.Principal)
+	}
+
 	if err := engine.auditLog.WriteDecisionLogEntry(ctx, func() (*auditv1.DecisionLogEntry, error) {
 		callID, ok := audit.CallIDFromContext(ctx)
 		if !ok {
@@ -302,6 +463,7 @@ func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanR
 			Method: &auditv1.DecisionLogEntry_PlanResources_{
 				PlanResources: planRes,
 			},
+			BreakGlass: breakGlass,
 		}
 
 		if engine.metadataExtractor != nil {
@@ -317,11 +479,24 @@ func (engine *Engine) logPlanDecision(ctx context.Context, input *enginev1.PlanR
 }
 
 func (engine *Engine) Check(ctx context.Context, inputs []*enginev1.CheckInput, opts ...CheckOpt) ([]*enginev1.CheckOutput, error) {
+	release, err := engine.concurrency.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var explanations []*tracer.Collector
 	outputs, err := measureCheckLatency(len(inputs), func() (outputs []*enginev1.CheckOutput, err error) {
 		ctx, span := tracing.StartSpan(ctx, "engine.Check")
 		defer span.End()
 
 		checkOpts := newCheckOptions(ctx, engine.conf, opts...)
+		checkOpts.parents = resolveParents(inputs)
+		if engine.explanations.Enabled && engine.auditLog.Enabled() {
+			explanations = make([]*tracer.Collector, len(inputs))
+			checkOpts.explanations = explanations
+			checkOpts.evalParams.traceExpressions = ExpressionTraceFromContext(ctx)
+		}
 
 		// if the number of inputs is less than the threshold, do a serial execution as it is usually faster.
 		// ditto if the worker pool is not initialized
@@ -338,10 +513,25 @@ func (engine *Engine) Check(ctx context.Context, inputs []*enginev1.CheckInput,
 		return outputs, err
 	})
 
-	return engine.logCheckDecision(ctx, inputs, outputs, err)
+	if err == nil && engine.shadow != nil {
+		engine.shadowCheck(ctx, inputs, outputs, opts...)
+	}
+
+	return engine.logCheckDecision(ctx, inputs, outputs, explanations, err)
 }
 
-func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, checkErr error) ([]*enginev1.CheckOutput, error) {
+func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, explanations []*tracer.Collector, checkErr error) ([]*enginev1.CheckOutput, error) {
+	breakGlass := engine.breakGlass.anyAsserted(inputs)
+	if breakGlass {
+		principals := make([]*enginev1.Principal, 0, len(inputs))
+		for _, input := range inputs {
+			if engine.breakGlass.asserted(input.AuxData) {
+				principals = append(principals, input.Principal)
+			}
+		}
+		ctx = engine.logBreakGlass(ctx, principals...)
+	}
+
 	if err := engine.auditLog.WriteDecisionLogEntry(ctx, func() (*auditv1.DecisionLogEntry, error) {
 		ctx, span := tracing.StartSpan(ctx, "audit.WriteDecisionLog")
 		defer span.End()
@@ -362,6 +552,8 @@ func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.C
 
 		if checkErr != nil {
 			checkRes.Error = checkErr.Error()
+		} else if explanations != nil {
+			checkRes.Explanations, checkRes.ExplanationsTruncated = explainDecisions(inputs, outputs, explanations, engine.actionAliases, engine.explanations.MaxSizeBytes)
 		}
 
 		entry := &auditv1.DecisionLogEntry{
@@ -371,6 +563,7 @@ func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.C
 			Method: &auditv1.DecisionLogEntry_CheckResources_{
 				CheckResources: checkRes,
 			},
+			BreakGlass: breakGlass,
 		}
 
 		if engine.metadataExtractor != nil {
@@ -385,6 +578,22 @@ func (engine *Engine) logCheckDecision(ctx context.Context, inputs []*enginev1.C
 	return outputs, checkErr
 }
 
+// expandPrincipal adds the roles granted by group memberships and break-glass emergency access to the principal.
+func (engine *Engine) expandPrincipal(p *enginev1.Principal, auxData *enginev1.AuxData) *enginev1.Principal {
+	return engine.breakGlass.grant(engine.groups.expand(p), auxData)
+}
+
+// logBreakGlass logs the use of break-glass emergency access and returns a context that forces the decision to be logged.
+func (engine *Engine) logBreakGlass(ctx context.Context, principals ...*enginev1.Principal) context.Context {
+	ids := make([]string, len(principals))
+	for i, p := range principals {
+		ids[i] = p.GetId()
+	}
+
+	logging.FromContext(ctx).Warn("Break-glass emergency access asserted", zap.Strings("principals", ids), zap.Strings("roles", engine.breakGlass.roles))
+	return audit.NewContextWithForcedDecisionLog(ctx)
+}
+
 func (engine *Engine) checkSerial(ctx context.Context, inputs []*enginev1.CheckInput, checkOpts *CheckOptions) ([]*enginev1.CheckOutput, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.CheckSerial")
 	defer span.End()
@@ -392,7 +601,7 @@ func (engine *Engine) checkSerial(ctx context.Context, inputs []*enginev1.CheckI
 	outputs := make([]*enginev1.CheckOutput, len(inputs))
 
 	for i, input := range inputs {
-		o, err := engine.evaluate(ctx, input, checkOpts)
+		o, err := engine.evaluate(ctx, input, checkOpts.forInput(i))
 		if err != nil {
 			return nil, err
 		}
@@ -411,7 +620,7 @@ func (engine *Engine) checkParallel(ctx context.Context, inputs []*enginev1.Chec
 	collector := make(chan workOut, len(inputs))
 
 	for i, input := range inputs {
-		if err := engine.submitWork(ctx, workIn{index: i, ctx: ctx, input: input, out: collector, checkOpts: checkOpts}); err != nil {
+		if err := engine.submitWork(ctx, workIn{index: i, ctx: ctx, input: input, out: collector, checkOpts: checkOpts.forInput(i)}); err != nil {
 			return nil, err
 		}
 	}
@@ -433,7 +642,8 @@ func (engine *Engine) checkParallel(ctx context.Context, inputs []*enginev1.Chec
 }
 
 func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput, checkOpts *CheckOptions) (*enginev1.CheckOutput, error) {
-	ctx, span := tracing.StartSpan(ctx, "engine.Evaluate")
+	// The resource kind must be set when the span starts because it's used for sampling.
+	ctx, span := tracing.StartSpan(ctx, "engine.Evaluate", tracing.ResourceKind(input.Resource.Kind))
 	defer span.End()
 
 	span.SetAttributes(tracing.RequestID(input.RequestId), tracing.ReqResourceID(input.Resource.Id))
@@ -444,6 +654,26 @@ func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput,
 		return nil, err
 	}
 
+	cacheKey, cacheable := engine.decisionCache.key(input, checkOpts)
+	if cacheable {
+		if output, ok := engine.decisionCache.get(cacheKey, input.RequestId); ok {
+			return output, nil
+		}
+	}
+
+	// The output uses the action names from the request, but the policies are evaluated with the canonical names.
+	requestedActions := input.Actions
+	actions, aliased := engine.actionAliases.canonicalize(input.Actions)
+	if principal := engine.expandPrincipal(input.Principal, input.AuxData); principal != input.Principal || aliased {
+		input = &enginev1.CheckInput{
+			RequestId: input.RequestId,
+			Resource:  input.Resource,
+			Principal: principal,
+			Actions:   actions,
+			AuxData:   input.AuxData,
+		}
+	}
+
 	output := &enginev1.CheckOutput{
 		RequestId:  input.RequestId,
 		ResourceId: input.Resource.Id,
@@ -465,23 +695,34 @@ func (engine *Engine) evaluate(ctx context.Context, input *enginev1.CheckInput,
 	}
 
 	// update the output
-	for _, action := range input.Actions {
+	for _, action := range requestedActions {
 		output.Actions[action] = &enginev1.CheckOutput_ActionEffect{
 			Effect: defaultEffect,
 			Policy: noPolicyMatch,
 		}
 
-		if einfo, ok := result.effects[action]; ok {
+		canonical := engine.actionAliases.canonical(action)
+		if einfo, ok := result.effects[canonical]; ok {
 			ae := output.Actions[action]
 			ae.Effect = einfo.Effect
 			ae.Policy = einfo.Policy
 			ae.Scope = einfo.Scope
 		}
+
+		if hints, ok := result.remediationHints[canonical]; ok {
+			output.Actions[action].RemediationHints = hints
+		}
 	}
 
 	output.EffectiveDerivedRoles = result.effectiveDerivedRoles
 	output.ValidationErrors = result.validationErrors
 	output.Outputs = result.outputs
+	output.PrincipalScopeChain = ec.principalScopeChain
+	output.ResourceScopeChain = ec.resourceScopeChain
+
+	if cacheable {
+		engine.decisionCache.put(cacheKey, output)
+	}
 
 	return output, nil
 }
@@ -496,6 +737,9 @@ func (engine *Engine) buildEvaluationCtx(ctx context.Context, eparams evalParams
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", ppName, ppVersion, err)
 	}
 	ec.addCheck(ppCheck)
+	if sc, ok := ppCheck.(scopeChainer); ok && eparams.includeScopeChain {
+		ec.principalScopeChain = sc.scopeChain()
+	}
 
 	// get the resource policy check
 	rpName, rpVersion, rpScope := engine.policyAttr(input.Resource.Kind, input.Resource.PolicyVersion, input.Resource.Scope)
@@ -504,12 +748,15 @@ func (engine *Engine) buildEvaluationCtx(ctx context.Context, eparams evalParams
 		return nil, fmt.Errorf("failed to get check for [%s.%s]: %w", rpName, rpVersion, err)
 	}
 	ec.addCheck(rpCheck)
+	if sc, ok := rpCheck.(scopeChainer); ok && eparams.includeScopeChain {
+		ec.resourceScopeChain = sc.scopeChain()
+	}
 
 	return ec, nil
 }
 
 func (engine *Engine) getPrincipalPolicyEvaluator(ctx context.Context, eparams evalParams, principal, policyVer, scope string) (Evaluator, error) {
-	rps, err := engine.getPrincipalPolicySet(ctx, principal, policyVer, scope, eparams.lenientScopeSearch)
+	rps, err := engine.getPrincipalPolicySet(ctx, eparams.overlay, principal, policyVer, scope, eparams.lenientScopeSearch)
 	if err != nil {
 		return nil, err
 	}
@@ -521,13 +768,13 @@ func (engine *Engine) getPrincipalPolicyEvaluator(ctx context.Context, eparams e
 	return NewEvaluator(rps, engine.schemaMgr, eparams), nil
 }
 
-func (engine *Engine) getPrincipalPolicySet(ctx context.Context, principal, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
+func (engine *Engine) getPrincipalPolicySet(ctx context.Context, overlay PolicyLoader, principal, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.GetPrincipalPolicy")
 	defer span.End()
 	span.SetAttributes(tracing.PolicyName(principal), tracing.PolicyVersion(policyVer), tracing.PolicyScope(scope))
 
 	principalModIDs := namer.ScopedPrincipalPolicyModuleIDs(principal, policyVer, scope, lenientScopeSearch)
-	rps, err := engine.policyLoader.GetFirstMatch(ctx, principalModIDs)
+	rps, err := engine.getFirstMatch(ctx, overlay, principalModIDs)
 	if err != nil {
 		tracing.MarkFailed(span, http.StatusInternalServerError, err)
 		return nil, err
@@ -537,7 +784,7 @@ func (engine *Engine) getPrincipalPolicySet(ctx context.Context, principal, poli
 }
 
 func (engine *Engine) getResourcePolicyEvaluator(ctx context.Context, eparams evalParams, resource, policyVer, scope string) (Evaluator, error) {
-	rps, err := engine.getResourcePolicySet(ctx, resource, policyVer, scope, eparams.lenientScopeSearch)
+	rps, err := engine.getResourcePolicySet(ctx, eparams.overlay, resource, policyVer, scope, eparams.lenientScopeSearch)
 	if err != nil {
 		return nil, err
 	}
@@ -549,13 +796,13 @@ func (engine *Engine) getResourcePolicyEvaluator(ctx context.Context, eparams ev
 	return NewEvaluator(rps, engine.schemaMgr, eparams), nil
 }
 
-func (engine *Engine) getResourcePolicySet(ctx context.Context, resource, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
+func (engine *Engine) getResourcePolicySet(ctx context.Context, overlay PolicyLoader, resource, policyVer, scope string, lenientScopeSearch bool) (*runtimev1.RunnablePolicySet, error) {
 	ctx, span := tracing.StartSpan(ctx, "engine.GetResourcePolicy")
 	defer span.End()
 	span.SetAttributes(tracing.PolicyName(resource), tracing.PolicyVersion(policyVer), tracing.PolicyScope(scope))
 
 	resourceModIDs := namer.ScopedResourcePolicyModuleIDs(resource, policyVer, scope, lenientScopeSearch)
-	rps, err := engine.policyLoader.GetFirstMatch(ctx, resourceModIDs)
+	rps, err := engine.getFirstMatch(ctx, overlay, resourceModIDs)
 	if err != nil {
 		tracing.MarkFailed(span, http.StatusInternalServerError, err)
 		return nil, err
@@ -577,8 +824,25 @@ func (engine *Engine) policyAttr(name, version, scope string) (pName, pVersion,
 }
 
 type evaluationCtx struct {
-	checks    [2]Evaluator
-	numChecks int
+	principalScopeChain []string
+	resourceScopeChain  []string
+	checks              [2]Evaluator
+	numChecks           int
+}
+
+// scopeChainer is implemented by the evaluators that report the scope chain of their policy set.
+type scopeChainer interface {
+	scopeChain() []string
+}
+
+// scopeChain returns the scopes of the policies in a policy set, from the most specific scope to the root scope.
+func scopeChain[P interface{ GetScope() string }](policies []P) []string {
+	chain := make([]string, len(policies))
+	for i, p := range policies {
+		chain[i] = p.GetScope()
+	}
+
+	return chain
 }
 
 func (ec *evaluationCtx) addCheck(eval Evaluator) {
@@ -626,6 +890,7 @@ func (ec *evaluationCtx) evaluate(ctx context.Context, tctx tracer.Context, inpu
 type evaluationResult struct {
 	effects               map[string]EffectInfo
 	effectiveDerivedRoles []string
+	remediationHints      map[string][]*enginev1.RemediationHint
 	validationErrors      []*schemav1.ValidationError
 	outputs               []*enginev1.OutputEntry
 }
@@ -657,6 +922,13 @@ func (er *evaluationResult) merge(res *PolicyEvalResult) bool {
 		if currEffect, ok := er.effects[action]; !ok || currEffect.Effect == effectv1.Effect_EFFECT_NO_MATCH {
 			er.effects[action] = effect
 
+			if hints, ok := res.RemediationHints[action]; ok {
+				if er.remediationHints == nil {
+					er.remediationHints = make(map[string][]*enginev1.RemediationHint)
+				}
+				er.remediationHints[action] = hints
+			}
+
 			// if this effect is a no_match, we still need to traverse the policy hierarchy until we find a definitive answer
 			if effect.Effect == effectv1.Effect_EFFECT_NO_MATCH {
 				hasNoMatches = true
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
@@ -26,6 +27,7 @@ import (
 	"github.com/cerbos/cerbos/internal/printer"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/storage/index"
 	"github.com/cerbos/cerbos/internal/test"
 	"github.com/cerbos/cerbos/internal/util"
 )
@@ -64,6 +66,28 @@ func TestCheck(t *testing.T) {
 	}
 }
 
+func TestCheckWithResourceKindAllowlist(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{schemaEnforcement: schema.EnforcementNone, resourceKinds: []string{"leave_request"}})
+	defer cancelFunc()
+
+	mkInput := func(kind string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"view:public"},
+			Principal: &enginev1.Principal{Id: "john", Roles: []string{"admin"}},
+			Resource:  &enginev1.Resource{Kind: kind, Id: "xx125"},
+		}
+	}
+
+	outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{mkInput("leave_request"), mkInput("purchase_order")})
+	require.NoError(t, err)
+	require.Len(t, outputs, 2)
+
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view:public"].Effect, "Allowed resource kind should be evaluated")
+	require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[1].Actions["view:public"].Effect, "Excluded resource kind should be denied by default")
+	require.Equal(t, noPolicyMatch, outputs[1].Actions["view:public"].Policy)
+}
+
 func TestCheckWithLenientScopeSearch(t *testing.T) {
 	eng, cancelFunc := mkEngine(t, param{schemaEnforcement: schema.EnforcementNone, lenientScopeSearch: true})
 	defer cancelFunc()
@@ -96,6 +120,60 @@ func TestCheckWithLenientScopeSearch(t *testing.T) {
 	}
 }
 
+func TestCheckWithScopeChain(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{schemaEnforcement: schema.EnforcementNone})
+	defer cancelFunc()
+
+	input := &enginev1.CheckInput{
+		RequestId: "test",
+		Actions:   []string{"create", "delete", "view:public"},
+		Principal: &enginev1.Principal{
+			Id:    "john",
+			Roles: []string{"employee"},
+			Attr: map[string]*structpb.Value{
+				"department": structpb.NewStringValue("marketing"),
+				"geography":  structpb.NewStringValue("GB"),
+				"team":       structpb.NewStringValue("design"),
+				"ip_address": structpb.NewStringValue("10.20.0.13"),
+			},
+		},
+		Resource: &enginev1.Resource{
+			Kind:  "leave_request",
+			Scope: "acme.hr.uk",
+			Id:    "XX125",
+			Attr: map[string]*structpb.Value{
+				"department": structpb.NewStringValue("marketing"),
+				"geography":  structpb.NewStringValue("GB"),
+				"id":         structpb.NewStringValue("XX125"),
+				"owner":      structpb.NewStringValue("john"),
+				"team":       structpb.NewStringValue("design"),
+			},
+		},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{input}, WithScopeChain())
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+
+		chain := outputs[0].ResourceScopeChain
+		require.Equal(t, []string{"acme.hr.uk", "acme.hr", "acme", ""}, chain)
+
+		wantLevels := map[string]int{"delete": 0, "view:public": 1, "create": 2}
+		for action, wantLevel := range wantLevels {
+			require.Equal(t, chain[wantLevel], outputs[0].Actions[action].Scope, "Unexpected matching scope for %q", action)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		outputs, err := eng.Check(context.Background(), []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Empty(t, outputs[0].PrincipalScopeChain)
+		require.Empty(t, outputs[0].ResourceScopeChain)
+	})
+}
+
 func TestSchemaValidation(t *testing.T) {
 	for _, enforcement := range []string{"warn", "reject"} {
 		enforcement := enforcement
@@ -194,7 +272,25 @@ type param struct {
 	enableAuditLog     bool
 	schemaEnforcement  schema.Enforcement
 	subDir             string
+	resourceKinds      []string
 	lenientScopeSearch bool
+	// policies maps file names to policy definitions to load instead of the policies in subDir.
+	policies map[string]string
+	// shadowPolicies maps file names to policy definitions to load as the shadow policy set.
+	shadowPolicies map[string]string
+	groups         *GroupsConf
+	// auditLog is the audit log to use instead of the one created according to enableAuditLog.
+	auditLog         audit.Log
+	explanations     audit.DecisionLogExplanations
+	remediationHints *RemediationHintsConf
+	breakGlass       *BreakGlassConf
+	decisionCache    *DecisionCacheConf
+	actionAliases    map[string]string
+	concurrencyLimit *ConcurrencyLimitConf
+	// policyDir is the directory to load the policies from instead of subDir. It's watched for changes.
+	policyDir string
+	// wrapPolicyLoader wraps the policy loader used by the engine.
+	wrapPolicyLoader func(PolicyLoader) PolicyLoader
 }
 
 func mkEngine(tb testing.TB, p param) (*Engine, context.CancelFunc) {
@@ -203,20 +299,34 @@ func mkEngine(tb testing.TB, p param) (*Engine, context.CancelFunc) {
 	if p.subDir == "" {
 		p.subDir = "store"
 	}
-	dir := test.PathToDir(tb, p.subDir)
+
+	var dir string
+	switch {
+	case p.policyDir != "":
+		dir = p.policyDir
+	case p.policies != nil:
+		dir = test.WritePolicies(tb, p.policies)
+	default:
+		dir = test.PathToDir(tb, p.subDir)
+	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
-	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir, WatchForChanges: p.policyDir != ""}, index.WithResourceKinds(p.resourceKinds))
 	require.NoError(tb, err)
 
 	schemaConf := schema.NewConf(p.schemaEnforcement)
 	schemaMgr := schema.NewFromConf(ctx, store, schemaConf)
 
-	compiler := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+	var compiler PolicyLoader = compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+	if p.wrapPolicyLoader != nil {
+		compiler = p.wrapPolicyLoader(compiler)
+	}
 
-	var auditLog audit.Log
-	if p.enableAuditLog {
+	auditLog := p.auditLog
+	switch {
+	case auditLog != nil:
+	case p.enableAuditLog:
 		conf := &local.Conf{
 			StoragePath: tb.TempDir(),
 		}
@@ -225,7 +335,7 @@ func mkEngine(tb testing.TB, p param) (*Engine, context.CancelFunc) {
 		decisionFilter := audit.NewDecisionLogEntryFilterFromConf(&audit.Conf{})
 		auditLog, err = local.NewLog(conf, decisionFilter)
 		require.NoError(tb, err)
-	} else {
+	default:
 		auditLog = audit.NewNopLog()
 	}
 
@@ -233,12 +343,29 @@ func mkEngine(tb testing.TB, p param) (*Engine, context.CancelFunc) {
 	engineConf.SetDefaults()
 	engineConf.Globals = map[string]any{"environment": "test"}
 	engineConf.LenientScopeSearch = p.lenientScopeSearch
+	engineConf.Groups = p.groups
+	engineConf.RemediationHints = p.remediationHints
+	engineConf.BreakGlass = p.breakGlass
+	engineConf.DecisionCache = p.decisionCache
+	engineConf.ActionAliases = p.actionAliases
+	engineConf.ConcurrencyLimit = p.concurrencyLimit
+	require.NoError(tb, engineConf.Validate())
+
+	var shadowPolicyLoader PolicyLoader
+	if p.shadowPolicies != nil {
+		shadowStore, err := disk.NewStore(ctx, &disk.Conf{Directory: test.WritePolicies(tb, p.shadowPolicies)})
+		require.NoError(tb, err)
+
+		shadowPolicyLoader = compile.NewManagerFromDefaultConf(ctx, shadowStore, schemaMgr)
+	}
 
 	eng := NewFromConf(ctx, engineConf, Components{
-		PolicyLoader:      compiler,
-		SchemaMgr:         schemaMgr,
-		AuditLog:          auditLog,
-		MetadataExtractor: audit.NewMetadataExtractorFromConf(&audit.Conf{}),
+		PolicyLoader:            compiler,
+		SchemaMgr:               schemaMgr,
+		AuditLog:                auditLog,
+		MetadataExtractor:       audit.NewMetadataExtractorFromConf(&audit.Conf{}),
+		ShadowPolicyLoader:      shadowPolicyLoader,
+		DecisionLogExplanations: p.explanations,
 	})
 
 	return eng, cancelFunc
@@ -34,16 +34,22 @@ import (
 var ErrPolicyNotExecutable = errors.New("policy not executable")
 
 type evalParams struct {
-	globals            map[string]any
-	nowFunc            func() time.Time
-	lenientScopeSearch bool
+	globals             map[string]any
+	parent              *enginev1.Request_Resource
+	overlay             PolicyLoader
+	nowFunc             func() time.Time
+	maxRemediationHints uint
+	lenientScopeSearch  bool
+	traceExpressions    bool
+	includeScopeChain   bool
 }
 
 func defaultEvalParams(conf *Conf) evalParams {
 	return evalParams{
-		globals:            conf.Globals,
-		nowFunc:            time.Now,
-		lenientScopeSearch: conf.LenientScopeSearch,
+		globals:             conf.Globals,
+		nowFunc:             time.Now,
+		maxRemediationHints: conf.RemediationHints.maxHints(),
+		lenientScopeSearch:  conf.LenientScopeSearch,
 	}
 }
 
@@ -81,6 +87,15 @@ func (ec *evalContext) lazyRuntime() any { // We have to return `any` rather tha
 	return ec.runtime
 }
 
+// parentResource returns the parent of the resource being evaluated or an empty resource if the parent is unknown.
+func (ec *evalContext) parentResource() *enginev1.Request_Resource {
+	if ec.parent == nil {
+		return &enginev1.Request_Resource{}
+	}
+
+	return ec.parent
+}
+
 type Evaluator interface {
 	Evaluate(context.Context, tracer.Context, *enginev1.CheckInput) (*PolicyEvalResult, error)
 }
@@ -108,6 +123,10 @@ type resourcePolicyEvaluator struct {
 	evalParams evalParams
 }
 
+func (rpe *resourcePolicyEvaluator) scopeChain() []string {
+	return scopeChain(rpe.policy.Policies)
+}
+
 func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Context, input *enginev1.CheckInput) (*PolicyEvalResult, error) {
 	_, span := tracing.StartSpan(ctx, "resource_policy.Evaluate")
 	span.SetAttributes(tracing.PolicyFQN(rpe.policy.Meta.Fqn))
@@ -145,6 +164,8 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 		}
 	}
 
+	var evaluated []evaluatedPolicy
+
 	// evaluate policies in the set
 	for _, p := range rpe.policy.Policies {
 		// Get the actions that are yet to be resolved. This is to implement first-match-wins semantics.
@@ -200,6 +221,10 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 			return nil, fmt.Errorf("failed to evaluate variables: %w", err)
 		}
 
+		if rpe.evalParams.maxRemediationHints > 0 {
+			evaluated = append(evaluated, evaluatedPolicy{policy: p, evalCtx: evalCtx, variables: variables})
+		}
+
 		// evaluate each rule until all actions have a result
 		for _, rule := range p.Rules {
 			rctx := sctx.StartRule(rule.Name)
@@ -246,6 +271,10 @@ func (rpe *resourcePolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Co
 		}
 	}
 
+	if rpe.evalParams.maxRemediationHints > 0 {
+		result.RemediationHints = remediationHints(rpe.policy.Meta, evaluated, result.unresolvedActions(), effectiveRoles, rpe.evalParams.maxRemediationHints)
+	}
+
 	// set the default effect for actions that were not matched
 	result.setDefaultEffect(pctx, EffectInfo{Effect: effectv1.Effect_EFFECT_DENY, Policy: policyKey})
 
@@ -257,6 +286,10 @@ type principalPolicyEvaluator struct {
 	evalParams evalParams
 }
 
+func (ppe *principalPolicyEvaluator) scopeChain() []string {
+	return scopeChain(ppe.policy.Policies)
+}
+
 func (ppe *principalPolicyEvaluator) Evaluate(ctx context.Context, tctx tracer.Context, input *enginev1.CheckInput) (*PolicyEvalResult, error) {
 	_, span := tracing.StartSpan(ctx, "principal_policy.Evaluate")
 	span.SetAttributes(tracing.PolicyFQN(ppe.policy.Meta.Fqn))
@@ -357,7 +390,13 @@ func (ec *evalContext) satisfiesCondition(tctx tracer.Context, cond *runtimev1.C
 	switch t := cond.Op.(type) {
 	case *runtimev1.Condition_Expr:
 		ectx := tctx.StartExpr(t.Expr.Original)
-		val, err := ec.evaluateBoolCELExpr(t.Expr.Checked, variables)
+		var val bool
+		var err error
+		if ec.traceExpressions {
+			val, err = ec.evaluateTracedBoolCELExpr(ectx, t.Expr.Checked, variables)
+		} else {
+			val, err = ec.evaluateBoolCELExpr(t.Expr.Checked, variables)
+		}
 		if err != nil {
 			ectx.ComputedBoolResult(false, err, "Failed to evaluate expression")
 			return false, fmt.Errorf("failed to evaluate `%s`: %w", t.Expr.Original, err)
@@ -469,31 +508,52 @@ func (ec *evalContext) evaluateProtobufValueCELExpr(expr *exprpb.CheckedExpr, va
 	return pbVal
 }
 
+// evaluateTracedBoolCELExpr evaluates the expression like evaluateBoolCELExpr and records the values of its sub-expressions in the trace.
+func (ec *evalContext) evaluateTracedBoolCELExpr(tctx tracer.Context, expr *exprpb.CheckedExpr, variables map[string]any) (bool, error) {
+	result, details, err := ec.evalCELExpr(expr, variables, cel.EvalOptions(cel.OptTrackState))
+	if details != nil {
+		traceSubExpressions(tctx, expr, details.State())
+	}
+
+	if err != nil || result == nil {
+		return false, err
+	}
+
+	boolVal, _ := result.Value().(bool)
+	return boolVal, nil
+}
+
 func (ec *evalContext) evaluateCELExpr(expr *exprpb.CheckedExpr, variables map[string]any) (ref.Val, error) {
+	result, _, err := ec.evalCELExpr(expr, variables)
+	return result, err
+}
+
+func (ec *evalContext) evalCELExpr(expr *exprpb.CheckedExpr, variables map[string]any, opts ...cel.ProgramOption) (ref.Val, *cel.EvalDetails, error) {
 	if expr == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	result, _, err := conditions.Eval(conditions.StdEnv, cel.CheckedExprToAst(expr), map[string]any{
+	result, details, err := conditions.Eval(conditions.EvalEnv, cel.CheckedExprToAst(expr), map[string]any{
 		conditions.CELRequestIdent:    ec.request,
 		conditions.CELResourceAbbrev:  ec.request.Resource,
 		conditions.CELPrincipalAbbrev: ec.request.Principal,
+		conditions.CELParentIdent:     ec.parentResource(),
 		conditions.CELRuntimeIdent:    ec.lazyRuntime,
 		conditions.CELVariablesIdent:  variables,
 		conditions.CELVariablesAbbrev: variables,
 		conditions.CELGlobalsIdent:    ec.globals,
 		conditions.CELGlobalsAbbrev:   ec.globals,
-	}, ec.nowFunc)
+	}, ec.nowFunc, opts...)
 	if err != nil {
 		// ignore expressions that are invalid
 		if types.IsError(result) {
-			return nil, nil
+			return nil, details, nil
 		}
 
-		return nil, err
+		return nil, details, err
 	}
 
-	return result, nil
+	return result, details, nil
 }
 
 func (ec *evalContext) evaluateCELExprToRaw(expr *exprpb.CheckedExpr, variables map[string]any) (any, error) {
@@ -519,6 +579,7 @@ type PolicyEvalResult struct {
 	Effects               map[string]EffectInfo
 	EffectiveDerivedRoles map[string]struct{}
 	toResolve             map[string]struct{}
+	RemediationHints      map[string][]*enginev1.RemediationHint
 	ValidationErrors      []*schemav1.ValidationError
 	Outputs               []*enginev1.OutputEntry
 }
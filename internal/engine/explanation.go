@@ -0,0 +1,134 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+// explainDecisions builds the decision explanations from the traces collected while evaluating each input.
+// Explanations are added in input order until the total size exceeds maxSize. The second return value reports whether any were dropped.
+func explainDecisions(inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, collectors []*tracer.Collector, aliases actionAliases, maxSize uint) ([]*auditv1.DecisionExplanation, bool) {
+	explanations := make([]*auditv1.DecisionExplanation, 0, len(outputs))
+	size := 0
+	for i, output := range outputs {
+		var traces []*enginev1.Trace
+		if i < len(collectors) && collectors[i] != nil {
+			traces = collectors[i].Traces()
+		}
+
+		explanation := explainDecision(inputs[i], output, traces, aliases)
+		size += proto.Size(explanation)
+		if maxSize > 0 && size > int(maxSize) {
+			return explanations, true
+		}
+
+		explanations = append(explanations, explanation)
+	}
+
+	return explanations, false
+}
+
+func explainDecision(input *enginev1.CheckInput, output *enginev1.CheckOutput, traces []*enginev1.Trace, aliases actionAliases) *auditv1.DecisionExplanation {
+	explanation := &auditv1.DecisionExplanation{
+		RequestId:  input.RequestId,
+		ResourceId: output.ResourceId,
+		Actions:    make([]*auditv1.DecisionExplanation_Action, 0, len(output.Actions)),
+	}
+
+	actions := make([]string, 0, len(output.Actions))
+	for action := range output.Actions {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	for _, action := range actions {
+		ae := output.Actions[action]
+		ax := &auditv1.DecisionExplanation_Action{
+			Action: action,
+			Effect: ae.Effect,
+			Policy: ae.Policy,
+			Scope:  ae.Scope,
+		}
+
+		// The traces refer to the canonical action names that the policies were evaluated with.
+		if deciding := findDecidingTrace(traces, aliases.canonical(action), ae); deciding != nil {
+			ax.Message = deciding.Event.GetMessage()
+			for _, c := range deciding.Components {
+				if c.Kind == enginev1.Trace_Component_KIND_RULE {
+					ax.Rule = c.GetRule()
+				}
+			}
+			ax.Condition = conditionTraces(traces, deciding.Components)
+		}
+
+		explanation.Actions = append(explanation.Actions, ax)
+	}
+
+	return explanation
+}
+
+// findDecidingTrace returns the trace of the last effect applied to the action that matches the final outcome.
+// Later rules can override the effect set by earlier rules in the same policy, so the last match is the one that decided.
+func findDecidingTrace(traces []*enginev1.Trace, action string, ae *enginev1.CheckOutput_ActionEffect) *enginev1.Trace {
+	var deciding *enginev1.Trace
+	for _, t := range traces {
+		n := len(t.Components)
+		if n == 0 || t.Event.GetEffect() != ae.Effect {
+			continue
+		}
+
+		if last := t.Components[n-1]; last.Kind != enginev1.Trace_Component_KIND_ACTION || last.GetAction() != action {
+			continue
+		}
+
+		matches := true
+		for _, c := range t.Components {
+			switch c.Kind {
+			case enginev1.Trace_Component_KIND_POLICY:
+				matches = matches && namer.PolicyKeyFromFQN(c.GetPolicy()) == ae.Policy
+			case enginev1.Trace_Component_KIND_SCOPE:
+				matches = matches && c.GetScope() == ae.Scope
+			default:
+			}
+		}
+
+		if matches {
+			deciding = t
+		}
+	}
+
+	return deciding
+}
+
+// conditionTraces returns the traces of the condition evaluated under the given action path, relative to that path.
+func conditionTraces(traces []*enginev1.Trace, actionPath []*enginev1.Trace_Component) []*enginev1.Trace {
+	var out []*enginev1.Trace
+	for _, t := range traces {
+		if len(t.Components) <= len(actionPath) || !hasComponentPrefix(t.Components, actionPath) {
+			continue
+		}
+
+		out = append(out, &enginev1.Trace{Components: t.Components[len(actionPath):], Event: t.Event})
+	}
+
+	return out
+}
+
+func hasComponentPrefix(components, prefix []*enginev1.Trace_Component) bool {
+	for i, p := range prefix {
+		if !proto.Equal(components[i], p) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,209 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+)
+
+const explanationTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - name: owner-can-edit
+      actions: ["edit"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: request.resource.attr.owner == request.principal.id
+    - name: anyone-can-view
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+func TestDecisionLogExplanations(t *testing.T) {
+	inputs := []*enginev1.CheckInput{
+		{
+			RequestId: "test",
+			Actions:   []string{"edit", "view", "delete"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource: &enginev1.Resource{
+				Kind: "document",
+				Id:   "doc1",
+				Attr: map[string]*structpb.Value{"owner": structpb.NewStringValue("alice")},
+			},
+		},
+		{
+			RequestId: "test",
+			Actions:   []string{"edit"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource: &enginev1.Resource{
+				Kind: "document",
+				Id:   "doc2",
+				Attr: map[string]*structpb.Value{"owner": structpb.NewStringValue("bob")},
+			},
+		},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		eng, log := mkExplanationEngine(t, explanationTestPolicy, audit.DecisionLogExplanations{Enabled: true})
+
+		_, err := eng.Check(context.Background(), inputs)
+		require.NoError(t, err)
+
+		checkRes := log.lastCheckResources(t)
+		require.False(t, checkRes.ExplanationsTruncated)
+		require.Len(t, checkRes.Explanations, 2)
+
+		doc1 := checkRes.Explanations[0]
+		require.Equal(t, "doc1", doc1.ResourceId)
+		require.Len(t, doc1.Actions, 3)
+
+		actions := make(map[string]*auditv1.DecisionExplanation_Action, len(doc1.Actions))
+		for _, a := range doc1.Actions {
+			actions[a.Action] = a
+		}
+
+		edit := actions["edit"]
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, edit.Effect)
+		require.Equal(t, "resource.document.vdefault", edit.Policy)
+		require.Equal(t, "owner-can-edit", edit.Rule)
+		require.Len(t, edit.Condition, 1)
+		require.Equal(t, "request.resource.attr.owner == request.principal.id", edit.Condition[0].Components[1].GetExpr())
+		require.True(t, conditionResult(t, edit.Condition), "Condition outcome must be recorded")
+
+		view := actions["view"]
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, view.Effect)
+		require.Equal(t, "anyone-can-view", view.Rule)
+		require.True(t, conditionResult(t, view.Condition))
+
+		del := actions["delete"]
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, del.Effect)
+		require.Empty(t, del.Rule)
+		require.Equal(t, "Default effect", del.Message)
+
+		doc2 := checkRes.Explanations[1]
+		require.Equal(t, "doc2", doc2.ResourceId)
+		require.Len(t, doc2.Actions, 1)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, doc2.Actions[0].Effect)
+		require.Empty(t, doc2.Actions[0].Rule)
+	})
+
+	t.Run("size_limit", func(t *testing.T) {
+		eng, log := mkExplanationEngine(t, explanationTestPolicy, audit.DecisionLogExplanations{Enabled: true, MaxSizeBytes: 64})
+
+		_, err := eng.Check(context.Background(), inputs)
+		require.NoError(t, err)
+
+		checkRes := log.lastCheckResources(t)
+		require.True(t, checkRes.ExplanationsTruncated)
+		require.Empty(t, checkRes.Explanations)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		eng, log := mkExplanationEngine(t, explanationTestPolicy, audit.DecisionLogExplanations{})
+
+		_, err := eng.Check(context.Background(), inputs)
+		require.NoError(t, err)
+
+		checkRes := log.lastCheckResources(t)
+		require.Empty(t, checkRes.Explanations)
+		require.False(t, checkRes.ExplanationsTruncated)
+	})
+}
+
+func conditionResult(t *testing.T, traces []*enginev1.Trace) bool {
+	t.Helper()
+
+	// The outermost component of the condition carries the overall result.
+	var outermost *enginev1.Trace
+	for _, tr := range traces {
+		require.Equal(t, enginev1.Trace_Component_KIND_CONDITION, tr.Components[0].Kind)
+		if outermost == nil || len(tr.Components) < len(outermost.Components) {
+			outermost = tr
+		}
+	}
+
+	require.NotNil(t, outermost, "Condition trace not found")
+	return outermost.Event.GetResult().GetBoolValue()
+}
+
+func mkExplanationEngine(t *testing.T, policy string, explanations audit.DecisionLogExplanations) (*Engine, *capturingAuditLog) {
+	t.Helper()
+
+	log := &capturingAuditLog{}
+	eng, cancelFunc := mkEngine(t, param{
+		policies:     map[string]string{"document.yaml": policy},
+		auditLog:     log,
+		explanations: explanations,
+	})
+	t.Cleanup(cancelFunc)
+
+	return eng, log
+}
+
+type capturingAuditLog struct {
+	entries []*auditv1.DecisionLogEntry
+	mu      sync.Mutex
+}
+
+func (l *capturingAuditLog) Backend() string {
+	return "capturing"
+}
+
+func (l *capturingAuditLog) Enabled() bool {
+	return true
+}
+
+func (l *capturingAuditLog) WriteAccessLogEntry(context.Context, audit.AccessLogEntryMaker) error {
+	return nil
+}
+
+func (l *capturingAuditLog) WriteDecisionLogEntry(_ context.Context, maker audit.DecisionLogEntryMaker) error {
+	entry, err := maker()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func (l *capturingAuditLog) Close() error {
+	return nil
+}
+
+func (l *capturingAuditLog) lastEntry(t *testing.T) *auditv1.DecisionLogEntry {
+	t.Helper()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	require.NotEmpty(t, l.entries)
+	return l.entries[len(l.entries)-1]
+}
+
+func (l *capturingAuditLog) lastCheckResources(t *testing.T) *auditv1.DecisionLogEntry_CheckResources {
+	t.Helper()
+
+	checkRes := l.lastEntry(t).GetCheckResources()
+	require.NotNil(t, checkRes)
+	return checkRes
+}
@@ -0,0 +1,107 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter"
+	"github.com/google/cel-go/parser"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+)
+
+// maxTracedSubExpressions is the maximum number of sub-expressions recorded for a single condition expression.
+const maxTracedSubExpressions = 64
+
+var errTraceLimitReached = errors.New("limit reached")
+
+// traceSubExpressions records the values of the sub-expressions that were evaluated as part of the expression.
+// Sub-expressions skipped due to short-circuiting don't have a value and are not recorded.
+// Identifiers, literals and intermediate field selections are omitted because their values are either obvious or too large to be useful.
+func traceSubExpressions(tctx tracer.Context, expr *exprpb.CheckedExpr, state interpreter.EvalState) {
+	if state == nil || expr.GetExpr() == nil {
+		return
+	}
+
+	var subExprs []*exprpb.Expr
+	collectSubExpressions(expr.Expr, false, &subExprs)
+
+	traced := 0
+	for _, se := range subExprs {
+		if se.Id == expr.Expr.Id {
+			continue
+		}
+
+		val, ok := state.Value(se.Id)
+		if !ok {
+			continue
+		}
+
+		text, err := parser.Unparse(se, expr.SourceInfo)
+		if err != nil {
+			continue
+		}
+
+		if traced == maxTracedSubExpressions {
+			tctx.StartExpr("...").Skipped(errTraceLimitReached, fmt.Sprintf("Only the first %d sub-expressions are recorded", maxTracedSubExpressions))
+			return
+		}
+		traced++
+
+		sctx := tctx.StartExpr(text)
+		if types.IsError(val) {
+			sctx.Failed(fmt.Errorf("%v", val), "Failed to evaluate sub-expression")
+			continue
+		}
+
+		sctx.ComputedResult(nativeValue(val))
+	}
+}
+
+func collectSubExpressions(e *exprpb.Expr, operandOfSelect bool, out *[]*exprpb.Expr) {
+	switch k := e.GetExprKind().(type) {
+	case *exprpb.Expr_CallExpr:
+		*out = append(*out, e)
+		if k.CallExpr.Target != nil {
+			collectSubExpressions(k.CallExpr.Target, false, out)
+		}
+
+		for _, arg := range k.CallExpr.Args {
+			collectSubExpressions(arg, false, out)
+		}
+	case *exprpb.Expr_SelectExpr:
+		if !operandOfSelect {
+			*out = append(*out, e)
+		}
+		collectSubExpressions(k.SelectExpr.Operand, true, out)
+	case *exprpb.Expr_ListExpr:
+		for _, elem := range k.ListExpr.Elements {
+			collectSubExpressions(elem, false, out)
+		}
+	case *exprpb.Expr_StructExpr:
+		for _, entry := range k.StructExpr.Entries {
+			collectSubExpressions(entry.Value, false, out)
+		}
+	case *exprpb.Expr_ComprehensionExpr:
+		// The loop steps are evaluated many times, so only the overall result and the range are recorded.
+		*out = append(*out, e)
+		collectSubExpressions(k.ComprehensionExpr.IterRange, false, out)
+	default:
+	}
+}
+
+func nativeValue(val ref.Val) any {
+	if pbVal, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{})); err == nil {
+		return pbVal
+	}
+
+	return val.Value()
+}
@@ -0,0 +1,109 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	auditv1 "github.com/cerbos/cerbos/api/genpb/cerbos/audit/v1"
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+)
+
+const exprTraceTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - name: owner-can-edit
+      actions: ["edit"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: request.resource.attr.owner == request.principal.id && (request.resource.attr.status == "draft" || request.principal.attr.admin)
+`
+
+func TestExpressionTrace(t *testing.T) {
+	mkInput := func(status string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"edit"},
+			Principal: &enginev1.Principal{
+				Id:    "alice",
+				Roles: []string{"user"},
+				Attr:  map[string]*structpb.Value{"admin": structpb.NewBoolValue(true)},
+			},
+			Resource: &enginev1.Resource{
+				Kind: "document",
+				Id:   "doc1",
+				Attr: map[string]*structpb.Value{
+					"owner":  structpb.NewStringValue("alice"),
+					"status": structpb.NewStringValue(status),
+				},
+			},
+		}
+	}
+
+	check := func(t *testing.T, ctx context.Context, input *enginev1.CheckInput) map[string]any {
+		t.Helper()
+
+		eng, log := mkExplanationEngine(t, exprTraceTestPolicy, audit.DecisionLogExplanations{Enabled: true})
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{input})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["edit"].Effect)
+
+		checkRes := log.lastCheckResources(t)
+		require.Len(t, checkRes.Explanations, 1)
+		require.Len(t, checkRes.Explanations[0].Actions, 1)
+		return subExpressionValues(t, checkRes.Explanations[0].Actions[0])
+	}
+
+	t.Run("compound_condition", func(t *testing.T) {
+		have := check(t, ContextWithExpressionTrace(context.Background()), mkInput("published"))
+		require.Equal(t, map[string]any{
+			`request.resource.attr.owner == request.principal.id`:                     true,
+			`request.resource.attr.owner`:                                             "alice",
+			`request.principal.id`:                                                    "alice",
+			`request.resource.attr.status == "draft" || request.principal.attr.admin`: true,
+			`request.resource.attr.status == "draft"`:                                 false,
+			`request.resource.attr.status`:                                            "published",
+			`request.principal.attr.admin`:                                            true,
+		}, have)
+	})
+
+	t.Run("short_circuit", func(t *testing.T) {
+		have := check(t, ContextWithExpressionTrace(context.Background()), mkInput("draft"))
+		require.Contains(t, have, `request.resource.attr.status == "draft"`)
+		require.NotContains(t, have, `request.principal.attr.admin`, "Sub-expressions that were not evaluated must not be recorded")
+	})
+
+	t.Run("not_requested", func(t *testing.T) {
+		have := check(t, context.Background(), mkInput("published"))
+		require.Empty(t, have)
+	})
+}
+
+// subExpressionValues returns the values of the sub-expressions recorded under the condition expression of the action.
+func subExpressionValues(t *testing.T, action *auditv1.DecisionExplanation_Action) map[string]any {
+	t.Helper()
+
+	values := make(map[string]any)
+	for _, tr := range action.Condition {
+		n := len(tr.Components)
+		if n < 3 || tr.Components[n-1].Kind != enginev1.Trace_Component_KIND_EXPR || tr.Components[n-2].Kind != enginev1.Trace_Component_KIND_EXPR {
+			continue
+		}
+
+		values[tr.Components[n-1].GetExpr()] = tr.Event.GetResult().AsInterface()
+	}
+
+	return values
+}
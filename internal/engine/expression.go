@@ -0,0 +1,106 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/conditions"
+)
+
+const (
+	// expressionCostLimit is the maximum cost of evaluating an expression with EvaluateExpression.
+	// It protects the server from expressions that would take too long to evaluate.
+	expressionCostLimit = 1_000_000
+	// expressionInterruptCheckFrequency is the number of comprehension iterations between checks for context cancellation.
+	expressionInterruptCheckFrequency = 100
+)
+
+var ErrInvalidExpression = errors.New("invalid expression")
+
+// ExpressionInput holds the values available to an expression evaluated with EvaluateExpression.
+type ExpressionInput struct {
+	Principal *enginev1.Principal
+	Resource  *enginev1.Resource
+	Globals   map[string]any
+	NowFunc   func() time.Time
+	// CELExtensions are the CEL extension libraries available to the expression. The default extensions are available if nil.
+	CELExtensions []string
+}
+
+// EvaluateExpression compiles the expression in the environment used for policy conditions and evaluates it against the input.
+// Compilation errors are reported as ErrInvalidExpression. Policy variables and runtime values are not available to the expression.
+// Evaluation stops when the context is cancelled or the cost of the expression exceeds the limit.
+func EvaluateExpression(ctx context.Context, expr string, input ExpressionInput) (*structpb.Value, error) {
+	env := conditions.StdEnv
+	if input.CELExtensions != nil {
+		var err error
+		if env, err = conditions.EnvWithExtensions(input.CELExtensions); err != nil {
+			return nil, err
+		}
+	}
+
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpression, issues.Err())
+	}
+
+	request := &enginev1.Request{
+		Principal: &enginev1.Request_Principal{
+			Id:    input.Principal.GetId(),
+			Roles: input.Principal.GetRoles(),
+			Attr:  input.Principal.GetAttr(),
+		},
+		Resource: &enginev1.Request_Resource{
+			Kind: input.Resource.GetKind(),
+			Id:   input.Resource.GetId(),
+			Attr: input.Resource.GetAttr(),
+		},
+	}
+
+	globals := input.Globals
+	if globals == nil {
+		globals = map[string]any{}
+	}
+
+	nowFunc := input.NowFunc
+	if nowFunc == nil {
+		nowFunc = time.Now
+	}
+
+	result, _, err := conditions.ContextEval(ctx, env, ast, map[string]any{
+		conditions.CELRequestIdent:    request,
+		conditions.CELResourceAbbrev:  request.Resource,
+		conditions.CELPrincipalAbbrev: request.Principal,
+		conditions.CELParentIdent:     &enginev1.Request_Resource{},
+		conditions.CELRuntimeIdent:    &enginev1.Runtime{},
+		conditions.CELVariablesIdent:  map[string]any{},
+		conditions.CELVariablesAbbrev: map[string]any{},
+		conditions.CELGlobalsIdent:    globals,
+		conditions.CELGlobalsAbbrev:   globals,
+	}, nowFunc, cel.CostLimit(expressionCostLimit), cel.InterruptCheckFrequency(expressionInterruptCheckFrequency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+
+	val, err := result.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert result of type %s: %w", result.Type().TypeName(), err)
+	}
+
+	pbVal, ok := val.(*structpb.Value)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %T", val)
+	}
+
+	return pbVal, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	input := ExpressionInput{
+		Principal: &enginev1.Principal{
+			Id:    "alice",
+			Roles: []string{"user"},
+			Attr:  map[string]*structpb.Value{"department": structpb.NewStringValue("marketing")},
+		},
+		Resource: &enginev1.Resource{
+			Kind: "document",
+			Id:   "doc1",
+			Attr: map[string]*structpb.Value{
+				"owner":     structpb.NewStringValue("alice"),
+				"expiresAt": structpb.NewStringValue("2020-01-01T00:00:00Z"),
+			},
+		},
+		Globals: map[string]any{"environment": "test"},
+		NowFunc: func() time.Time { return time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC) },
+	}
+
+	testCases := []struct {
+		name string
+		expr string
+		want any
+	}{
+		{name: "request", expr: "request.resource.attr.owner == request.principal.id", want: true},
+		{name: "abbreviations", expr: `R.attr.owner + "/" + P.attr.department`, want: "alice/marketing"},
+		{name: "globals", expr: `G.environment == "test" && globals.environment == "test"`, want: true},
+		{name: "now", expr: "now() < timestamp(R.attr.expiresAt)", want: true},
+		{name: "list", expr: `P.roles.map(r, r + "!")`, want: []any{"user!"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := EvaluateExpression(context.Background(), tc.expr, input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, have.AsInterface())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := EvaluateExpression(context.Background(), "request.resource.attr.owner ==", input)
+		require.ErrorIs(t, err, ErrInvalidExpression)
+
+		_, err = EvaluateExpression(context.Background(), "request.foo", input)
+		require.ErrorIs(t, err, ErrInvalidExpression)
+	})
+
+	t.Run("evaluation_error", func(t *testing.T) {
+		_, err := EvaluateExpression(context.Background(), "R.attr.missing == 1", input)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrInvalidExpression)
+	})
+
+	t.Run("cel_extensions", func(t *testing.T) {
+		have, err := EvaluateExpression(context.Background(), `math.greatest(1, 2)`, input)
+		require.NoError(t, err)
+		require.Equal(t, float64(2), have.GetNumberValue())
+
+		_, err = EvaluateExpression(context.Background(), `math.greatest(1, 2)`, ExpressionInput{CELExtensions: []string{"strings"}})
+		require.ErrorIs(t, err, ErrInvalidExpression)
+	})
+
+	t.Run("cost_limit", func(t *testing.T) {
+		_, err := EvaluateExpression(context.Background(), `[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(a, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(b, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(c, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(d, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(e, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(f, a + b + c + d + e + f > 0))))))`, input)
+		require.ErrorContains(t, err, "cost limit exceeded")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		cancelFunc()
+
+		_, err := EvaluateExpression(ctx, `[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(a, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(b, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(c, a + b + c > 0)))`, input)
+		require.Error(t, err)
+	})
+
+	t.Run("empty_input", func(t *testing.T) {
+		have, err := EvaluateExpression(context.Background(), `size(R.attr) == 0 && P.id == ""`, ExpressionInput{})
+		require.NoError(t, err)
+		require.True(t, have.GetBoolValue())
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+// groupExpander adds the roles granted by nested group memberships to principals.
+type groupExpander struct {
+	groups   map[string]GroupDef
+	attr     string
+	maxDepth int
+}
+
+func newGroupExpander(conf *GroupsConf) *groupExpander {
+	if conf == nil {
+		return nil
+	}
+
+	ge := &groupExpander{
+		groups:   conf.Definitions,
+		attr:     conf.Attr,
+		maxDepth: int(conf.MaxDepth),
+	}
+
+	if ge.attr == "" {
+		ge.attr = defaultGroupsAttr
+	}
+
+	if ge.maxDepth == 0 {
+		ge.maxDepth = defaultGroupsMaxDepth
+	}
+
+	return ge
+}
+
+// expand returns a copy of the principal with the roles of its groups and their ancestors added.
+// The principal is returned as-is if the group memberships don't grant any additional roles.
+func (ge *groupExpander) expand(p *enginev1.Principal) *enginev1.Principal {
+	if ge == nil || p == nil {
+		return p
+	}
+
+	roles := ge.effectiveRoles(p.Roles, principalGroups(p.Attr[ge.attr]))
+	if len(roles) == len(p.Roles) {
+		return p
+	}
+
+	return &enginev1.Principal{
+		Id:            p.Id,
+		PolicyVersion: p.PolicyVersion,
+		Roles:         roles,
+		Attr:          p.Attr,
+		Scope:         p.Scope,
+	}
+}
+
+// effectiveRoles walks up the group hierarchy breadth-first, up to maxDepth levels above the direct groups.
+// Each group is only visited once so that cycles in the hierarchy terminate.
+func (ge *groupExpander) effectiveRoles(roles, groups []string) []string {
+	if len(groups) == 0 {
+		return roles
+	}
+
+	seenRoles := make(map[string]struct{}, len(roles))
+	for _, r := range roles {
+		seenRoles[r] = struct{}{}
+	}
+
+	out := roles
+	visited := make(map[string]struct{})
+	for depth := 0; len(groups) > 0; depth++ {
+		var parents []string
+		for _, g := range groups {
+			if _, ok := visited[g]; ok {
+				continue
+			}
+			visited[g] = struct{}{}
+
+			def, ok := ge.groups[g]
+			if !ok {
+				continue
+			}
+
+			for _, r := range def.Roles {
+				if _, ok := seenRoles[r]; !ok {
+					seenRoles[r] = struct{}{}
+					// Never append to the caller's slice.
+					if len(out) == len(roles) {
+						out = append(make([]string, 0, len(roles)+len(def.Roles)), roles...)
+					}
+					out = append(out, r)
+				}
+			}
+
+			if depth < ge.maxDepth {
+				parents = append(parents, def.MemberOf...)
+			}
+		}
+
+		groups = parents
+	}
+
+	return out
+}
+
+func principalGroups(v *structpb.Value) []string {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return []string{k.StringValue}
+	case *structpb.Value_ListValue:
+		groups := make([]string, 0, len(k.ListValue.GetValues()))
+		for _, item := range k.ListValue.GetValues() {
+			if s, ok := item.GetKind().(*structpb.Value_StringValue); ok {
+				groups = append(groups, s.StringValue)
+			}
+		}
+		return groups
+	default:
+		return nil
+	}
+}
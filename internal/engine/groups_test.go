@@ -0,0 +1,177 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const groupsTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - actions: ["view"]
+      roles: ["employee"]
+      effect: EFFECT_ALLOW
+    - actions: ["edit"]
+      roles: ["developer"]
+      effect: EFFECT_ALLOW
+`
+
+func TestGroupExpander(t *testing.T) {
+	conf := &GroupsConf{
+		Definitions: map[string]GroupDef{
+			"company":     {Roles: []string{"employee"}},
+			"engineering": {Roles: []string{"developer"}, MemberOf: []string{"company"}},
+			"platform":    {MemberOf: []string{"engineering"}},
+			"sre":         {Roles: []string{"oncall"}, MemberOf: []string{"platform"}},
+			"cycle_a":     {Roles: []string{"a"}, MemberOf: []string{"cycle_b"}},
+			"cycle_b":     {Roles: []string{"b"}, MemberOf: []string{"cycle_a"}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		maxDepth uint
+		roles    []string
+		groups   []string
+		want     []string
+	}{
+		{
+			name:   "no_groups",
+			roles:  []string{"user"},
+			groups: nil,
+			want:   []string{"user"},
+		},
+		{
+			name:   "direct_group",
+			roles:  []string{"user"},
+			groups: []string{"company"},
+			want:   []string{"user", "employee"},
+		},
+		{
+			name:   "nested_groups",
+			roles:  []string{"user"},
+			groups: []string{"sre"},
+			want:   []string{"user", "oncall", "developer", "employee"},
+		},
+		{
+			name:     "max_depth",
+			maxDepth: 2,
+			roles:    []string{"user"},
+			groups:   []string{"sre"},
+			want:     []string{"user", "oncall", "developer"},
+		},
+		{
+			name:   "duplicate_roles",
+			roles:  []string{"employee"},
+			groups: []string{"engineering", "company"},
+			want:   []string{"employee", "developer"},
+		},
+		{
+			name:   "cycle",
+			roles:  []string{"user"},
+			groups: []string{"cycle_a"},
+			want:   []string{"user", "a", "b"},
+		},
+		{
+			name:   "unknown_group",
+			roles:  []string{"user"},
+			groups: []string{"wibble"},
+			want:   []string{"user"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := *conf
+			c.MaxDepth = tc.maxDepth
+			ge := newGroupExpander(&c)
+
+			roles := append([]string(nil), tc.roles...)
+			have := ge.effectiveRoles(roles, tc.groups)
+			require.Equal(t, tc.want, have)
+			require.Equal(t, tc.roles, roles, "Input roles must not be modified")
+		})
+	}
+}
+
+func TestCheckWithNestedGroups(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{
+		policies: map[string]string{"document.yaml": groupsTestPolicy},
+		groups: &GroupsConf{
+			Definitions: map[string]GroupDef{
+				"company":     {Roles: []string{"employee"}},
+				"engineering": {Roles: []string{"developer"}, MemberOf: []string{"company"}},
+				"platform":    {MemberOf: []string{"engineering"}},
+				"sre":         {MemberOf: []string{"platform"}},
+			},
+		},
+	})
+	t.Cleanup(cancelFunc)
+
+	ctx := context.Background()
+
+	groups, err := structpb.NewValue([]any{"sre"})
+	require.NoError(t, err)
+
+	principal := &enginev1.Principal{Id: "alice", Roles: []string{"user"}, Attr: map[string]*structpb.Value{"groups": groups}}
+
+	t.Run("check", func(t *testing.T) {
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   []string{"view", "edit"},
+				Principal: principal,
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view"].Effect)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["edit"].Effect)
+		require.Equal(t, []string{"user"}, principal.Roles, "Request principal must not be modified")
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		output, err := eng.PlanResources(ctx, &enginev1.PlanResourcesInput{
+			RequestId: "test",
+			Action:    "edit",
+			Principal: principal,
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, output.Filter.Kind)
+	})
+
+	t.Run("no_groups", func(t *testing.T) {
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   []string{"view"},
+				Principal: &enginev1.Principal{Id: "bob", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, outputs[0].Actions["view"].Effect)
+	})
+}
+
+func TestGroupsConfValidate(t *testing.T) {
+	conf := &GroupsConf{Definitions: map[string]GroupDef{"engineering": {MemberOf: []string{"company"}}}}
+	require.Error(t, conf.validate())
+
+	require.ErrorIs(t, (&GroupsConf{}).validate(), errEmptyGroupDefinitions)
+}
@@ -0,0 +1,66 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type kindRule struct {
+	pattern *regexp.Regexp
+	kind    string
+}
+
+// kindInferrer determines the kind of resources from their IDs.
+type kindInferrer []kindRule
+
+func newKindInferrer(rules []ResourceKindRule) (kindInferrer, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	ki := make(kindInferrer, len(rules))
+	for i, r := range rules {
+		if r.Kind == "" {
+			return nil, fmt.Errorf("engine.resourceKindInference[%d].kind must not be empty", i)
+		}
+
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("engine.resourceKindInference[%d].pattern is invalid: %w", i, err)
+		}
+
+		ki[i] = kindRule{pattern: pattern, kind: r.Kind}
+	}
+
+	return ki, nil
+}
+
+// infer returns the kind produced by the first rule with a pattern that matches the ID.
+func (ki kindInferrer) infer(id string) (string, bool) {
+	for _, r := range ki {
+		match := r.pattern.FindStringSubmatchIndex(id)
+		if match == nil {
+			continue
+		}
+
+		if kind := string(r.pattern.ExpandString(nil, r.kind, id, match)); kind != "" {
+			return kind, true
+		}
+	}
+
+	return "", false
+}
+
+// InfersResourceKinds returns true if any resource kind inference rules are configured.
+func (engine *Engine) InfersResourceKinds() bool {
+	return len(engine.kindInferrer) > 0
+}
+
+// InferResourceKind returns the kind of the resource with the given ID according to the configured inference rules.
+// The second return value is false if none of the rules match the ID.
+func (engine *Engine) InferResourceKind(id string) (string, bool) {
+	return engine.kindInferrer.infer(id)
+}
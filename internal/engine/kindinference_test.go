@@ -0,0 +1,54 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKindInferrer(t *testing.T) {
+	ki, err := newKindInferrer([]ResourceKindRule{
+		{Pattern: `^doc:`, Kind: "document"},
+		{Pattern: `^(?P<kind>[a-z]+):[0-9]+$`, Kind: "app:${kind}"},
+		{Pattern: `^([a-z]*)/`, Kind: "$1"},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		id       string
+		wantKind string
+		wantOK   bool
+	}{
+		{id: "doc:123", wantKind: "document", wantOK: true},
+		{id: "photo:123", wantKind: "app:photo", wantOK: true},
+		{id: "album/123", wantKind: "album", wantOK: true},
+		{id: "photo:abc"},
+		{id: "/123"},
+		{id: "123"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.id, func(t *testing.T) {
+			kind, ok := ki.infer(tc.id)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.wantKind, kind)
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		_, ok := kindInferrer(nil).infer("doc:123")
+		require.False(t, ok)
+	})
+}
+
+func TestKindInferrerInvalidRules(t *testing.T) {
+	_, err := newKindInferrer([]ResourceKindRule{{Pattern: `^doc:(`, Kind: "document"}})
+	require.ErrorContains(t, err, "engine.resourceKindInference[0].pattern is invalid")
+
+	_, err = newKindInferrer([]ResourceKindRule{{Pattern: `^doc:`}})
+	require.ErrorContains(t, err, "engine.resourceKindInference[0].kind must not be empty")
+}
@@ -0,0 +1,166 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/schema"
+)
+
+type policyOverlayCtxKeyType struct{}
+
+var policyOverlayCtxKey = &policyOverlayCtxKeyType{}
+
+// ContextWithPolicyOverlay returns a context that evaluates the Check and PlanResources calls made with it against the policies
+// provided by the overlay on top of the policies from the policy loader of the engine.
+// An overlay policy replaces the stored policy with the same name, version and scope. The stored policies at the other scopes of
+// the scope chain are still used.
+func ContextWithPolicyOverlay(ctx context.Context, overlay PolicyLoader) context.Context {
+	return context.WithValue(ctx, policyOverlayCtxKey, overlay)
+}
+
+func policyOverlayFromContext(ctx context.Context) PolicyLoader {
+	overlay, _ := ctx.Value(policyOverlayCtxKey).(PolicyLoader)
+	return overlay
+}
+
+func (engine *Engine) getFirstMatch(ctx context.Context, overlay PolicyLoader, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	rps, err := engine.policyLoader.GetFirstMatch(ctx, candidates)
+	if err != nil || overlay == nil {
+		return rps, err
+	}
+
+	ors, err := overlay.GetFirstMatch(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overlay policies can only be compiled if their ancestors are in the overlay as well. So, if the overlay matches a scope that's
+	// at least as specific as the stored one, the overlay policy set already has the policies for the whole scope chain.
+	if ors != nil && (rps == nil || scopeDepth(leafScope(ors)) >= scopeDepth(leafScope(rps))) {
+		return ors, nil
+	}
+
+	if rps == nil {
+		return nil, nil
+	}
+
+	return overlayScopes(ctx, overlay, rps)
+}
+
+// overlayScopes replaces the policies of the stored policy set with the overlay policies of the same scope.
+func overlayScopes(ctx context.Context, overlay PolicyLoader, rps *runtimev1.RunnablePolicySet) (*runtimev1.RunnablePolicySet, error) {
+	switch ps := rps.PolicySet.(type) {
+	case *runtimev1.RunnablePolicySet_ResourcePolicy:
+		rrps := ps.ResourcePolicy
+		policies, err := overlayPolicies(ctx, overlay, rrps.Policies,
+			func(p *runtimev1.RunnableResourcePolicySet_Policy) namer.ModuleID {
+				return namer.ResourcePolicyModuleID(rrps.Meta.Resource, rrps.Meta.Version, p.Scope)
+			},
+			func(ors *runtimev1.RunnablePolicySet) []*runtimev1.RunnableResourcePolicySet_Policy {
+				return ors.GetResourcePolicy().GetPolicies()
+			})
+		if err != nil || policies == nil {
+			return rps, err
+		}
+
+		return &runtimev1.RunnablePolicySet{
+			Fqn:             rps.Fqn,
+			CompilerVersion: rps.CompilerVersion,
+			PolicySet: &runtimev1.RunnablePolicySet_ResourcePolicy{
+				ResourcePolicy: &runtimev1.RunnableResourcePolicySet{
+					Meta:     rrps.Meta,
+					Policies: policies,
+					// Only schema in effect is the schema defined by the "root" policy.
+					Schemas: policies[len(policies)-1].Schemas,
+				},
+			},
+		}, nil
+
+	case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+		rpps := ps.PrincipalPolicy
+		policies, err := overlayPolicies(ctx, overlay, rpps.Policies,
+			func(p *runtimev1.RunnablePrincipalPolicySet_Policy) namer.ModuleID {
+				return namer.PrincipalPolicyModuleID(rpps.Meta.Principal, rpps.Meta.Version, p.Scope)
+			},
+			func(ors *runtimev1.RunnablePolicySet) []*runtimev1.RunnablePrincipalPolicySet_Policy {
+				return ors.GetPrincipalPolicy().GetPolicies()
+			})
+		if err != nil || policies == nil {
+			return rps, err
+		}
+
+		return &runtimev1.RunnablePolicySet{
+			Fqn:             rps.Fqn,
+			CompilerVersion: rps.CompilerVersion,
+			PolicySet: &runtimev1.RunnablePolicySet_PrincipalPolicy{
+				PrincipalPolicy: &runtimev1.RunnablePrincipalPolicySet{
+					Meta:     rpps.Meta,
+					Policies: policies,
+				},
+			},
+		}, nil
+
+	default:
+		return rps, nil
+	}
+}
+
+// overlayPolicies returns a copy of the scope chain with the policies replaced by the overlay policies of the same scope.
+// It returns nil if the overlay doesn't have a policy for any of the scopes.
+func overlayPolicies[P any](ctx context.Context, overlay PolicyLoader, chain []P, modID func(P) namer.ModuleID, policies func(*runtimev1.RunnablePolicySet) []P) ([]P, error) {
+	var overlaid []P
+	for i, p := range chain {
+		ors, err := overlay.GetFirstMatch(ctx, []namer.ModuleID{modID(p)})
+		if err != nil {
+			return nil, err
+		}
+
+		// The first policy of a policy set is the one at the scope that was matched.
+		op := policies(ors)
+		if len(op) == 0 {
+			continue
+		}
+
+		if overlaid == nil {
+			overlaid = slices.Clone(chain)
+		}
+		overlaid[i] = op[0]
+	}
+
+	return overlaid, nil
+}
+
+func leafScope(rps *runtimev1.RunnablePolicySet) string {
+	switch ps := rps.PolicySet.(type) {
+	case *runtimev1.RunnablePolicySet_ResourcePolicy:
+		if len(ps.ResourcePolicy.Policies) > 0 {
+			return ps.ResourcePolicy.Policies[0].Scope
+		}
+	case *runtimev1.RunnablePolicySet_PrincipalPolicy:
+		if len(ps.PrincipalPolicy.Policies) > 0 {
+			return ps.PrincipalPolicy.Policies[0].Scope
+		}
+	}
+
+	return ""
+}
+
+func scopeDepth(scope string) int {
+	if scope == "" {
+		return 0
+	}
+
+	return strings.Count(scope, ".") + 1
+}
+
+// SchemaManager returns the schema manager used by the engine to validate the requests.
+func (engine *Engine) SchemaManager() schema.Manager {
+	return engine.schemaMgr
+}
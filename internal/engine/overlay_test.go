@@ -0,0 +1,167 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+const (
+	overlayTestRootPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+	overlayTestScopedPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  scope: acme
+  rules:
+    - actions: ["delete"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+	overlayTestOverlayRootPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_DENY
+    - actions: ["edit"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+	overlayTestOverlayScopedPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  scope: acme
+  rules:
+    - actions: ["delete"]
+      roles: ["user"]
+      effect: EFFECT_DENY
+`
+)
+
+func TestPolicyOverlay(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{
+		policies: map[string]string{
+			"document.yaml":      overlayTestRootPolicy,
+			"document_acme.yaml": overlayTestScopedPolicy,
+		},
+	})
+	t.Cleanup(cancelFunc)
+
+	check := func(t *testing.T, ctx context.Context, scope string) map[string]effectv1.Effect {
+		t.Helper()
+
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   []string{"view", "edit", "delete"},
+				Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1", Scope: scope},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+
+		effects := make(map[string]effectv1.Effect, len(outputs[0].Actions))
+		for action, ae := range outputs[0].Actions {
+			effects[action] = ae.Effect
+		}
+
+		return effects
+	}
+
+	t.Run("without_overlay", func(t *testing.T) {
+		have := check(t, context.Background(), "acme")
+		require.Equal(t, map[string]effectv1.Effect{
+			"view":   effectv1.Effect_EFFECT_ALLOW,
+			"edit":   effectv1.Effect_EFFECT_DENY,
+			"delete": effectv1.Effect_EFFECT_ALLOW,
+		}, have)
+	})
+
+	t.Run("overlay_at_parent_scope", func(t *testing.T) {
+		ctx := ContextWithPolicyOverlay(context.Background(), mkOverlay(t, map[string]string{"document.yaml": overlayTestOverlayRootPolicy}))
+
+		have := check(t, ctx, "acme")
+		require.Equal(t, map[string]effectv1.Effect{
+			"view":   effectv1.Effect_EFFECT_DENY,
+			"edit":   effectv1.Effect_EFFECT_ALLOW,
+			"delete": effectv1.Effect_EFFECT_ALLOW,
+		}, have, "Stored policy at the requested scope should be used with the overlay policy at the parent scope")
+
+		have = check(t, ctx, "")
+		require.Equal(t, map[string]effectv1.Effect{
+			"view":   effectv1.Effect_EFFECT_DENY,
+			"edit":   effectv1.Effect_EFFECT_ALLOW,
+			"delete": effectv1.Effect_EFFECT_DENY,
+		}, have)
+	})
+
+	t.Run("overlay_at_requested_scope", func(t *testing.T) {
+		ctx := ContextWithPolicyOverlay(context.Background(), mkOverlay(t, map[string]string{
+			"document.yaml":      overlayTestOverlayRootPolicy,
+			"document_acme.yaml": overlayTestOverlayScopedPolicy,
+		}))
+
+		have := check(t, ctx, "acme")
+		require.Equal(t, map[string]effectv1.Effect{
+			"view":   effectv1.Effect_EFFECT_DENY,
+			"edit":   effectv1.Effect_EFFECT_ALLOW,
+			"delete": effectv1.Effect_EFFECT_DENY,
+		}, have)
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		ctx := ContextWithPolicyOverlay(context.Background(), mkOverlay(t, map[string]string{"document.yaml": overlayTestOverlayRootPolicy}))
+
+		output, err := eng.PlanResources(ctx, &enginev1.PlanResourcesInput{
+			RequestId: "test",
+			Action:    "edit",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document", Scope: "acme"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, output.Filter.Kind)
+	})
+}
+
+func mkOverlay(t *testing.T, policies map[string]string) PolicyLoader {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.WritePolicies(t, policies)})
+	require.NoError(t, err)
+
+	return compile.NewManagerFromDefaultConf(ctx, store, schema.NewNopManager())
+}
@@ -0,0 +1,56 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+type resourceKey struct {
+	kind string
+	id   string
+}
+
+// resolveParents finds the parents referenced by the resources of the inputs among the other resources of the same request.
+// The returned slice is indexed like the inputs and is nil if none of the resources refer to a parent.
+// A parent that is not included in the request resolves to a resource with no attributes.
+func resolveParents(inputs []*enginev1.CheckInput) []*enginev1.Request_Resource {
+	hasParents := false
+	for _, input := range inputs {
+		if input.GetResource().GetParent() != nil {
+			hasParents = true
+			break
+		}
+	}
+
+	if !hasParents {
+		return nil
+	}
+
+	resources := make(map[resourceKey]*enginev1.Resource, len(inputs))
+	for _, input := range inputs {
+		r := input.GetResource()
+		key := resourceKey{kind: r.GetKind(), id: r.GetId()}
+		if _, ok := resources[key]; !ok {
+			resources[key] = r
+		}
+	}
+
+	parents := make([]*enginev1.Request_Resource, len(inputs))
+	for i, input := range inputs {
+		ref := input.GetResource().GetParent()
+		if ref == nil {
+			continue
+		}
+
+		parent := &enginev1.Request_Resource{Kind: ref.Kind, Id: ref.Id}
+		if r, ok := resources[resourceKey{kind: ref.Kind, id: ref.Id}]; ok {
+			parent.Attr = r.Attr
+		}
+
+		parents[i] = parent
+	}
+
+	return parents
+}
@@ -0,0 +1,117 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const parentTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: photo
+  rules:
+    - name: view-photos-in-public-albums
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: parent.kind == "album" && parent.attr.public == true
+`
+
+func TestParentAttributes(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{policies: map[string]string{"document.yaml": parentTestPolicy}})
+	t.Cleanup(cancelFunc)
+
+	principal := &enginev1.Principal{Id: "alice", Roles: []string{"user"}}
+	album := func(id string, public bool) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: principal,
+			Resource: &enginev1.Resource{
+				Kind: "album",
+				Id:   id,
+				Attr: map[string]*structpb.Value{"public": structpb.NewBoolValue(public)},
+			},
+		}
+	}
+	photo := func(id, albumID string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: principal,
+			Resource: &enginev1.Resource{
+				Kind:   "photo",
+				Id:     id,
+				Parent: &enginev1.Resource_Ref{Kind: "album", Id: albumID},
+			},
+		}
+	}
+
+	check := func(t *testing.T, inputs ...*enginev1.CheckInput) map[string]effectv1.Effect {
+		t.Helper()
+
+		outputs, err := eng.Check(context.Background(), inputs)
+		require.NoError(t, err)
+
+		effects := make(map[string]effectv1.Effect, len(outputs))
+		for _, o := range outputs {
+			effects[o.ResourceId] = o.Actions["view"].Effect
+		}
+		return effects
+	}
+
+	t.Run("parent_in_request", func(t *testing.T) {
+		have := check(t,
+			album("public-album", true),
+			album("private-album", false),
+			photo("photo1", "public-album"),
+			photo("photo2", "private-album"),
+		)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, have["photo1"])
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, have["photo2"])
+	})
+
+	t.Run("parent_not_in_request", func(t *testing.T) {
+		have := check(t, photo("photo1", "public-album"))
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, have["photo1"])
+	})
+
+	t.Run("no_parent", func(t *testing.T) {
+		input := photo("photo1", "public-album")
+		input.Resource.Parent = nil
+
+		have := check(t, album("public-album", true), input)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, have["photo1"])
+	})
+}
+
+func TestResolveParents(t *testing.T) {
+	require.Nil(t, resolveParents([]*enginev1.CheckInput{{Resource: &enginev1.Resource{Kind: "album", Id: "a1"}}}))
+
+	attr := map[string]*structpb.Value{"public": structpb.NewBoolValue(true)}
+	inputs := []*enginev1.CheckInput{
+		{Resource: &enginev1.Resource{Kind: "album", Id: "a1", Attr: attr}},
+		{Resource: &enginev1.Resource{Kind: "photo", Id: "p1", Parent: &enginev1.Resource_Ref{Kind: "album", Id: "a1"}}},
+		{Resource: &enginev1.Resource{Kind: "photo", Id: "p2", Parent: &enginev1.Resource_Ref{Kind: "folder", Id: "a1"}}},
+	}
+
+	have := resolveParents(inputs)
+	require.Len(t, have, len(inputs))
+	require.Nil(t, have[0])
+	require.Equal(t, "a1", have[1].Id)
+	require.Equal(t, attr, have[1].Attr)
+	require.Equal(t, "folder", have[2].Kind)
+	require.Empty(t, have[2].Attr, "Parent of a different kind must not match")
+}
@@ -567,10 +567,12 @@ func newEvaluator(request *enginev1.Request, globals map[string]any) (p *partial
 	knownVars := make(map[string]any)
 	knownVars[conditions.CELRequestIdent] = request
 	knownVars[conditions.CELPrincipalAbbrev] = request.Principal
+	// Query plans are produced for a resource kind rather than a resource, so the parent is never known.
+	knownVars[conditions.CELParentIdent] = &enginev1.Request_Resource{}
 	knownVars[conditions.CELGlobalsIdent] = globals
 	knownVars[conditions.CELGlobalsAbbrev] = globals
 
-	p.env = conditions.StdEnv
+	p.env = conditions.EvalEnv
 	if len(request.Resource.GetAttr()) > 0 {
 		var ds []*exprpb.Decl
 		for name, value := range request.Resource.Attr {
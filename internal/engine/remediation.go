@@ -0,0 +1,149 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"sort"
+	"strings"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/engine/internal"
+	"github.com/cerbos/cerbos/internal/engine/tracer"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+// maxRemediationConditionLen is the maximum length of the condition text included in a remediation hint.
+const maxRemediationConditionLen = 256
+
+// evaluatedPolicy holds the state required to re-examine the rules of a policy after it has been evaluated.
+type evaluatedPolicy struct {
+	policy    *runtimev1.RunnableResourcePolicySet_Policy
+	evalCtx   *evalContext
+	variables map[string]any
+}
+
+type remediationCandidate struct {
+	hint    *enginev1.RemediationHint
+	missing int
+}
+
+// remediationHints returns up to maxHints hints for each of the denied actions, derived from the allow rules that came closest to matching.
+// A rule is closer to matching if fewer of its prerequisites (roles and condition) are missing. Ties are broken by the order in which the rules are evaluated.
+func remediationHints(meta *runtimev1.RunnableResourcePolicySet_Metadata, evaluated []evaluatedPolicy, denied []string, effectiveRoles internal.StringSet, maxHints uint) map[string][]*enginev1.RemediationHint {
+	if len(denied) == 0 {
+		return nil
+	}
+
+	candidates := make(map[string][]remediationCandidate, len(denied))
+	for _, ep := range evaluated {
+		for _, rule := range ep.policy.Rules {
+			if rule.Effect != effectv1.Effect_EFFECT_ALLOW {
+				continue
+			}
+
+			actions := make(internal.StringSet)
+			for actionGlob := range rule.Actions {
+				for _, a := range util.FilterGlob(actionGlob, denied) {
+					actions[a] = struct{}{}
+				}
+			}
+
+			if len(actions) == 0 {
+				continue
+			}
+
+			c, ok := ep.remediationCandidate(meta, rule, effectiveRoles)
+			if !ok {
+				continue
+			}
+
+			for action := range actions {
+				candidates[action] = append(candidates[action], c)
+			}
+		}
+	}
+
+	hints := make(map[string][]*enginev1.RemediationHint, len(candidates))
+	for action, cs := range candidates {
+		sort.SliceStable(cs, func(i, j int) bool { return cs[i].missing < cs[j].missing })
+		if uint(len(cs)) > maxHints {
+			cs = cs[:maxHints]
+		}
+
+		actionHints := make([]*enginev1.RemediationHint, len(cs))
+		for i, c := range cs {
+			actionHints[i] = c.hint
+		}
+		hints[action] = actionHints
+	}
+
+	return hints
+}
+
+func (ep evaluatedPolicy) remediationCandidate(meta *runtimev1.RunnableResourcePolicySet_Metadata, rule *runtimev1.RunnableResourcePolicySet_Policy_Rule, effectiveRoles internal.StringSet) (remediationCandidate, bool) {
+	c := remediationCandidate{hint: &enginev1.RemediationHint{Rule: namer.RuleFQN(meta, ep.policy.Scope, rule.Name)}}
+
+	if !internal.SetIntersects(rule.Roles, effectiveRoles) && !internal.SetIntersects(rule.DerivedRoles, ep.evalCtx.effectiveDerivedRoles) {
+		c.hint.Roles = sortedKeys(rule.Roles)
+		c.hint.DerivedRoles = sortedKeys(rule.DerivedRoles)
+		c.missing++
+	}
+
+	if ok, err := ep.evalCtx.satisfiesCondition(tracer.Start(nil), rule.Condition, ep.variables); err != nil || !ok {
+		c.hint.Condition = truncate(conditionText(rule.Condition), maxRemediationConditionLen)
+		c.missing++
+	}
+
+	// A rule with nothing missing would have matched, so it can't explain the denial.
+	return c, c.missing > 0
+}
+
+// conditionText reconstructs the source of a condition from the original expressions.
+func conditionText(cond *runtimev1.Condition) string {
+	join := func(exprs []*runtimev1.Condition, op string) string {
+		parts := make([]string, len(exprs))
+		for i, e := range exprs {
+			parts[i] = "(" + conditionText(e) + ")"
+		}
+		return strings.Join(parts, op)
+	}
+
+	switch t := cond.GetOp().(type) {
+	case *runtimev1.Condition_Expr:
+		return t.Expr.Original
+	case *runtimev1.Condition_All:
+		return join(t.All.Expr, " && ")
+	case *runtimev1.Condition_Any:
+		return join(t.Any.Expr, " || ")
+	case *runtimev1.Condition_None:
+		return "!(" + join(t.None.Expr, " || ") + ")"
+	default:
+		return ""
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:n], "") + "..."
+}
@@ -0,0 +1,126 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const remediationTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - name: finance-can-approve
+      actions: ["approve"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: request.principal.attr.department == "finance"
+    - name: editors-can-edit
+      actions: ["edit", "approve"]
+      roles: ["editor", "admin"]
+      effect: EFFECT_ALLOW
+    - name: nobody-can-delete
+      actions: ["delete"]
+      roles: ["*"]
+      effect: EFFECT_DENY
+    - name: users-can-view
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+func TestRemediationHints(t *testing.T) {
+	input := func(dept string) *enginev1.CheckInput {
+		return &enginev1.CheckInput{
+			RequestId: "test",
+			Actions:   []string{"edit", "approve", "delete", "view"},
+			Principal: &enginev1.Principal{
+				Id:    "alice",
+				Roles: []string{"user"},
+				Attr:  map[string]*structpb.Value{"department": structpb.NewStringValue(dept)},
+			},
+			Resource: &enginev1.Resource{Kind: "document", Id: "doc1"},
+		}
+	}
+
+	mkRemediationEngine := func(t *testing.T, rc *RemediationHintsConf) *Engine {
+		t.Helper()
+
+		eng, cancelFunc := mkEngine(t, param{
+			policies:         map[string]string{"document.yaml": remediationTestPolicy},
+			remediationHints: rc,
+		})
+		t.Cleanup(cancelFunc)
+
+		return eng
+	}
+
+	t.Run("missing_role", func(t *testing.T) {
+		eng := mkRemediationEngine(t, &RemediationHintsConf{})
+
+		out, err := eng.Check(context.Background(), []*enginev1.CheckInput{input("finance")})
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+
+		edit := out[0].Actions["edit"]
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, edit.Effect)
+		require.Len(t, edit.RemediationHints, 1)
+		require.Equal(t, "resource.document.vdefault#editors-can-edit", edit.RemediationHints[0].Rule)
+		require.Equal(t, []string{"admin", "editor"}, edit.RemediationHints[0].Roles)
+		require.Empty(t, edit.RemediationHints[0].Condition)
+
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, out[0].Actions["approve"].Effect)
+		require.Empty(t, out[0].Actions["approve"].RemediationHints, "Allowed actions must not have hints")
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, out[0].Actions["delete"].Effect)
+		require.Empty(t, out[0].Actions["delete"].RemediationHints, "Explicitly denied actions must not have hints")
+	})
+
+	t.Run("nearest_rule_first", func(t *testing.T) {
+		eng := mkRemediationEngine(t, &RemediationHintsConf{MaxHints: 5})
+
+		out, err := eng.Check(context.Background(), []*enginev1.CheckInput{input("engineering")})
+		require.NoError(t, err)
+
+		approve := out[0].Actions["approve"]
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, approve.Effect)
+		require.Len(t, approve.RemediationHints, 2)
+
+		// Both rules are missing one prerequisite, so they are returned in the order they are defined.
+		require.Equal(t, "resource.document.vdefault#finance-can-approve", approve.RemediationHints[0].Rule)
+		require.Empty(t, approve.RemediationHints[0].Roles)
+		require.Equal(t, `request.principal.attr.department == "finance"`, approve.RemediationHints[0].Condition)
+		require.Equal(t, "resource.document.vdefault#editors-can-edit", approve.RemediationHints[1].Rule)
+		require.Equal(t, []string{"admin", "editor"}, approve.RemediationHints[1].Roles)
+	})
+
+	t.Run("max_hints", func(t *testing.T) {
+		eng := mkRemediationEngine(t, &RemediationHintsConf{MaxHints: 1})
+
+		out, err := eng.Check(context.Background(), []*enginev1.CheckInput{input("engineering")})
+		require.NoError(t, err)
+		require.Len(t, out[0].Actions["approve"].RemediationHints, 1)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		eng := mkRemediationEngine(t, nil)
+
+		out, err := eng.Check(context.Background(), []*enginev1.CheckInput{input("engineering")})
+		require.NoError(t, err)
+
+		for action, ae := range out[0].Actions {
+			require.Empty(t, ae.RemediationHints, "Action %q has hints", action)
+		}
+	})
+}
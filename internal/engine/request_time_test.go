@@ -0,0 +1,80 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+)
+
+const requestTimeTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - name: view-until-expiry
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: now() < timestamp(request.resource.attr.expiresAt)
+`
+
+func TestRequestTime(t *testing.T) {
+	eng, cancelFunc := mkEngine(t, param{policies: map[string]string{"document.yaml": requestTimeTestPolicy}})
+	t.Cleanup(cancelFunc)
+
+	inputs := []*enginev1.CheckInput{
+		{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource: &enginev1.Resource{
+				Kind: "document",
+				Id:   "doc1",
+				Attr: map[string]*structpb.Value{"expiresAt": structpb.NewStringValue("2020-01-01T00:00:00Z")},
+			},
+		},
+	}
+
+	check := func(t *testing.T, ctx context.Context, opts ...CheckOpt) effectv1.Effect {
+		t.Helper()
+
+		outputs, err := eng.Check(ctx, inputs, opts...)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		return outputs[0].Actions["view"].Effect
+	}
+
+	t.Run("pinned_before_expiry", func(t *testing.T) {
+		ctx := ContextWithRequestTime(context.Background(), time.Date(2019, 12, 31, 23, 59, 59, 0, time.UTC))
+		for i := 0; i < 3; i++ {
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, check(t, ctx))
+		}
+	})
+
+	t.Run("pinned_after_expiry", func(t *testing.T) {
+		ctx := ContextWithRequestTime(context.Background(), time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC))
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, check(t, ctx))
+	})
+
+	t.Run("not_pinned", func(t *testing.T) {
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, check(t, context.Background()))
+	})
+
+	t.Run("explicit_now_func_wins", func(t *testing.T) {
+		ctx := ContextWithRequestTime(context.Background(), time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC))
+		nowFunc := func() time.Time { return time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) }
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, check(t, ctx, WithNowFunc(nowFunc)))
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+)
+
+const (
+	shadowKindCheck = "check"
+	shadowKindPlan  = "plan"
+)
+
+// shadowCheck evaluates the inputs against the shadow policy set in the background and records the decisions that differ from the primary outputs.
+// The primary outputs are never modified.
+func (engine *Engine) shadowCheck(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, opts ...CheckOpt) {
+	checkOpts := newCheckOptions(ctx, engine.conf, opts...)
+	// Traces from the shadow evaluation would be indistinguishable from the primary traces.
+	checkOpts.tracerSink = nil
+
+	// The caller owns the inputs and outputs, so the background evaluation works on copies.
+	shadowInputs := make([]*enginev1.CheckInput, len(inputs))
+	primaryOutputs := make([]*enginev1.CheckOutput, len(outputs))
+	for i, input := range inputs {
+		shadowInputs[i] = proto.Clone(input).(*enginev1.CheckInput)         //nolint:forcetypeassert
+		primaryOutputs[i] = proto.Clone(outputs[i]).(*enginev1.CheckOutput) //nolint:forcetypeassert
+	}
+	checkOpts.parents = resolveParents(shadowInputs)
+
+	engine.goShadow(ctx, func(ctx context.Context) {
+		engine.compareShadowCheck(ctx, shadowInputs, primaryOutputs, checkOpts)
+	})
+}
+
+func (engine *Engine) compareShadowCheck(ctx context.Context, inputs []*enginev1.CheckInput, outputs []*enginev1.CheckOutput, checkOpts *CheckOptions) {
+	ctx, span := tracing.StartSpan(ctx, "engine.ShadowCheck")
+	defer span.End()
+
+	log := logging.FromContext(ctx).Named("shadow")
+
+	shadowOutputs, err := engine.shadow.checkSerial(ctx, inputs, checkOpts)
+	if err != nil {
+		log.Warn("Shadow evaluation failed", zap.Error(err))
+		return
+	}
+
+	for i, output := range outputs {
+		input := inputs[i]
+		shadowActions := shadowOutputs[i].GetActions()
+		for action, effect := range output.Actions {
+			shadowEffect := shadowActions[action].GetEffect()
+			if effect.GetEffect() == shadowEffect {
+				continue
+			}
+
+			recordShadowDivergence(shadowKindCheck)
+			log.Info("Shadow decision diverged",
+				zap.String("request_id", output.RequestId),
+				zap.String("principal", input.GetPrincipal().GetId()),
+				zap.String("resource_kind", input.GetResource().GetKind()),
+				zap.String("resource_id", output.ResourceId),
+				zap.String("action", action),
+				zap.Stringer("effect", effect.GetEffect()),
+				zap.Stringer("shadow_effect", shadowEffect),
+			)
+		}
+	}
+}
+
+// shadowPlanResources produces a query plan from the shadow policy set in the background and records it if the filter differs from the primary output.
+func (engine *Engine) shadowPlanResources(ctx context.Context, input *enginev1.PlanResourcesInput, output *enginev1.PlanResourcesOutput) {
+	shadowInput := proto.Clone(input).(*enginev1.PlanResourcesInput)     //nolint:forcetypeassert
+	primaryOutput := proto.Clone(output).(*enginev1.PlanResourcesOutput) //nolint:forcetypeassert
+
+	engine.goShadow(ctx, func(ctx context.Context) {
+		engine.compareShadowPlanResources(ctx, shadowInput, primaryOutput)
+	})
+}
+
+func (engine *Engine) compareShadowPlanResources(ctx context.Context, input *enginev1.PlanResourcesInput, output *enginev1.PlanResourcesOutput) {
+	ctx, span := tracing.StartSpan(ctx, "engine.ShadowPlan")
+	defer span.End()
+
+	log := logging.FromContext(ctx).Named("shadow")
+
+	shadowOutput, err := engine.shadow.doPlanResources(ctx, input)
+	if err != nil {
+		log.Warn("Shadow query plan failed", zap.Error(err))
+		return
+	}
+
+	if proto.Equal(output.GetFilter(), shadowOutput.GetFilter()) {
+		return
+	}
+
+	recordShadowDivergence(shadowKindPlan)
+	log.Info("Shadow query plan diverged",
+		zap.String("request_id", input.RequestId),
+		zap.String("principal", input.GetPrincipal().GetId()),
+		zap.String("resource_kind", input.GetResource().GetKind()),
+		zap.String("action", input.Action),
+		zap.String("filter", output.FilterDebug),
+		zap.String("shadow_filter", shadowOutput.FilterDebug),
+	)
+}
+
+// goShadow runs the shadow evaluation in a new goroutine so that it doesn't add to the latency of the request.
+// The number of shadow evaluations in progress is bounded and the evaluation is skipped if the limit has been reached.
+func (engine *Engine) goShadow(ctx context.Context, evalFn func(context.Context)) {
+	select {
+	case engine.shadowSlots <- struct{}{}:
+	default:
+		logging.FromContext(ctx).Named("shadow").Debug("Skipping shadow evaluation because too many are in progress")
+		return
+	}
+
+	// The shadow evaluation must not be cancelled when the request completes.
+	ctx = context.WithoutCancel(ctx)
+
+	engine.shadowPending.Add(1)
+	go func() {
+		defer func() {
+			<-engine.shadowSlots
+			engine.shadowPending.Done()
+		}()
+
+		evalFn(ctx)
+	}()
+}
+
+func recordShadowDivergence(kind string) {
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeyEngineShadowKind, kind)},
+		metrics.EngineShadowDivergenceCount.M(1),
+	)
+}
@@ -0,0 +1,211 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	"github.com/cerbos/cerbos/internal/observability/logging"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+const shadowTestPolicyTmpl = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: document
+  rules:
+    - actions: ["edit"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+    - actions: ["view"]
+      roles: ["user"]
+      effect: %s
+`
+
+func TestShadowEvaluation(t *testing.T) {
+	require.NoError(t, view.Register(metrics.EngineShadowDivergenceCountView))
+	t.Cleanup(func() { view.Unregister(metrics.EngineShadowDivergenceCountView) })
+
+	eng, cancelFunc := mkEngine(t, param{
+		policies:       map[string]string{"document.yaml": fmt.Sprintf(shadowTestPolicyTmpl, "EFFECT_ALLOW")},
+		shadowPolicies: map[string]string{"document.yaml": fmt.Sprintf(shadowTestPolicyTmpl, "EFFECT_DENY")},
+	})
+	t.Cleanup(cancelFunc)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	ctx := logging.ToContext(context.Background(), zap.New(core))
+
+	t.Run("check", func(t *testing.T) {
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   []string{"view", "edit"},
+				Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view"].Effect, "Primary decision must be served")
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["edit"].Effect)
+
+		eng.shadowPending.Wait()
+
+		entries := logs.FilterMessage("Shadow decision diverged").All()
+		require.Len(t, entries, 1)
+		fields := entries[0].ContextMap()
+		require.Equal(t, "view", fields["action"])
+		require.Equal(t, "doc1", fields["resource_id"])
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW.String(), fields["effect"])
+		require.Equal(t, effectv1.Effect_EFFECT_DENY.String(), fields["shadow_effect"])
+
+		require.Equal(t, int64(1), shadowDivergenceCount(t, shadowKindCheck))
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		output, err := eng.PlanResources(ctx, &enginev1.PlanResourcesInput{
+			RequestId: "test",
+			Action:    "view",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "document"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, output.Filter.Kind, "Primary plan must be served")
+
+		eng.shadowPending.Wait()
+
+		require.Len(t, logs.FilterMessage("Shadow query plan diverged").All(), 1)
+		require.Equal(t, int64(1), shadowDivergenceCount(t, shadowKindPlan))
+	})
+
+	t.Run("no_divergence", func(t *testing.T) {
+		before := logs.Len()
+
+		_, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   []string{"edit"},
+				Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			},
+		})
+		require.NoError(t, err)
+
+		eng.shadowPending.Wait()
+		require.Equal(t, before, logs.Len())
+		require.Equal(t, int64(1), shadowDivergenceCount(t, shadowKindCheck))
+	})
+
+	t.Run("skipped_when_busy", func(t *testing.T) {
+		for i := 0; i < cap(eng.shadowSlots); i++ {
+			eng.shadowSlots <- struct{}{}
+		}
+		t.Cleanup(func() {
+			for len(eng.shadowSlots) > 0 {
+				<-eng.shadowSlots
+			}
+		})
+
+		outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+			{
+				RequestId: "test",
+				Actions:   []string{"view"},
+				Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+				Resource:  &enginev1.Resource{Kind: "document", Id: "doc1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[0].Actions["view"].Effect)
+
+		eng.shadowPending.Wait()
+		require.Equal(t, int64(1), shadowDivergenceCount(t, shadowKindCheck))
+	})
+}
+
+func TestShadowEvaluationWithParents(t *testing.T) {
+	require.NoError(t, view.Register(metrics.EngineShadowDivergenceCountView))
+	t.Cleanup(func() { view.Unregister(metrics.EngineShadowDivergenceCountView) })
+
+	eng, cancelFunc := mkEngine(t, param{
+		policies:       map[string]string{"photo.yaml": parentTestPolicy},
+		shadowPolicies: map[string]string{"photo.yaml": parentTestPolicy},
+	})
+	t.Cleanup(cancelFunc)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	ctx := logging.ToContext(context.Background(), zap.New(core))
+
+	principal := &enginev1.Principal{Id: "alice", Roles: []string{"user"}}
+	outputs, err := eng.Check(ctx, []*enginev1.CheckInput{
+		{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: principal,
+			Resource: &enginev1.Resource{
+				Kind: "album",
+				Id:   "public-album",
+				Attr: map[string]*structpb.Value{"public": structpb.NewBoolValue(true)},
+			},
+		},
+		{
+			RequestId: "test",
+			Actions:   []string{"view"},
+			Principal: principal,
+			Resource: &enginev1.Resource{
+				Kind:   "photo",
+				Id:     "photo1",
+				Parent: &enginev1.Resource_Ref{Kind: "album", Id: "public-album"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, outputs, 2)
+	require.Equal(t, effectv1.Effect_EFFECT_ALLOW, outputs[1].Actions["view"].Effect)
+
+	eng.shadowPending.Wait()
+
+	require.Empty(t, logs.FilterMessage("Shadow decision diverged").All(), "Shadow evaluation must see the parent attributes")
+	require.Equal(t, int64(0), shadowDivergenceCount(t, shadowKindCheck))
+}
+
+func TestShadowConfValidation(t *testing.T) {
+	conf := &Conf{}
+	conf.SetDefaults()
+	conf.Shadow = &ShadowConf{Storage: map[string]any{"disk": map[string]any{"directory": "/path/to/shadow/policies"}}}
+	require.ErrorIs(t, conf.Validate(), errEmptyShadowStorageDriver)
+
+	conf.Shadow.Storage["driver"] = "disk"
+	require.NoError(t, conf.Validate())
+}
+
+func shadowDivergenceCount(t *testing.T, kind string) int64 {
+	t.Helper()
+
+	rows, err := view.RetrieveData(metrics.EngineShadowDivergenceCountView.Name)
+	require.NoError(t, err)
+
+	for _, row := range rows {
+		for _, tag := range row.Tags {
+			if tag.Key == metrics.KeyEngineShadowKind && tag.Value == kind {
+				if data, ok := row.Data.(*view.CountData); ok {
+					return data.Value
+				}
+			}
+		}
+	}
+
+	return 0
+}
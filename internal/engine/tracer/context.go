@@ -191,6 +191,10 @@ func (c *context) ComputedResult(result any) {
 }
 
 func protobufValue(goValue any) *structpb.Value {
+	if pbVal, ok := goValue.(*structpb.Value); ok {
+		return pbVal
+	}
+
 	data, err := json.Marshal(goValue)
 	if err != nil {
 		return structpb.NewStringValue("<failed to marshal value to JSON>")
@@ -45,6 +45,34 @@ func (c *Collector) Traces() []*enginev1.Trace {
 	return c.traces
 }
 
+// Tee returns a sink that forwards traces to all the given sinks that are enabled.
+func Tee(sinks ...Sink) Sink {
+	var enabled multiSink
+	for _, s := range sinks {
+		if s != nil && s.Enabled() {
+			enabled = append(enabled, s)
+		}
+	}
+
+	if len(enabled) == 1 {
+		return enabled[0]
+	}
+
+	return enabled
+}
+
+type multiSink []Sink
+
+func (ms multiSink) Enabled() bool {
+	return len(ms) > 0
+}
+
+func (ms multiSink) AddTrace(trace *enginev1.Trace) {
+	for _, s := range ms {
+		s.AddTrace(trace)
+	}
+}
+
 type ZapSink struct {
 	log *zap.Logger
 }
@@ -33,6 +33,7 @@ func init() {
 
 var (
 	KeyAuditKind            = tag.MustNewKey("kind")
+	KeyAuxDataResolver      = tag.MustNewKey("resolver")
 	KeyBundleSource         = tag.MustNewKey("source")
 	KeyBundleOp             = tag.MustNewKey("op")
 	KeyBundleOpStatus       = tag.MustNewKey("status")
@@ -42,7 +43,9 @@ var (
 	KeyCompileStatus        = tag.MustNewKey("status")
 	KeyEngineDecisionStatus = tag.MustNewKey("status")
 	KeyEnginePlanStatus     = tag.MustNewKey("status")
+	KeyEngineShadowKind     = tag.MustNewKey("kind")
 	KeyIndexCRUDKind        = tag.MustNewKey("kind")
+	KeySpanName             = tag.MustNewKey("span_name")
 	KeyStoreDriver          = tag.MustNewKey("driver")
 )
 
@@ -59,6 +62,18 @@ var (
 		Aggregation: view.Count(),
 	}
 
+	AuxDataCircuitBreakerState = stats.Int64(
+		"cerbos.dev/aux_data/circuit_breaker_state",
+		"State of the circuit breaker around an auxiliary data resolver (0: closed, 1: half-open, 2: open)",
+		stats.UnitDimensionless,
+	)
+
+	AuxDataCircuitBreakerStateView = &view.View{
+		Measure:     AuxDataCircuitBreakerState,
+		TagKeys:     []tag.Key{KeyAuxDataResolver},
+		Aggregation: view.LastValue(),
+	}
+
 	BundleFetchErrorsCount = stats.Int64(
 		"cerbos.dev/store/bundle_fetch_errors_count",
 		"Count of errors encountered during bundle downloads",
@@ -81,6 +96,18 @@ var (
 		Aggregation: view.Count(),
 	}
 
+	BundleValidationFailuresCount = stats.Int64(
+		"cerbos.dev/store/bundle_validation_failures_count",
+		"Count of bundles that were not activated because they failed the validation tests",
+		stats.UnitNone,
+	)
+
+	BundleValidationFailuresCountView = &view.View{
+		Measure:     BundleValidationFailuresCount,
+		TagKeys:     []tag.Key{KeyBundleSource},
+		Aggregation: view.Count(),
+	}
+
 	BundleStoreLatency = stats.Float64(
 		"cerbos.dev/store/bundle_op_latency",
 		"Time to do an operation with the bundle store",
@@ -151,6 +178,17 @@ var (
 		Aggregation: view.LastValue(),
 	}
 
+	CanaryFailureCount = stats.Int64(
+		"cerbos.dev/canary/failure_count",
+		"Number of canary checks that failed or returned an unexpected effect",
+		stats.UnitDimensionless,
+	)
+
+	CanaryFailureCountView = &view.View{
+		Measure:     CanaryFailureCount,
+		Aggregation: view.Count(),
+	}
+
 	CompileDuration = stats.Float64(
 		"cerbos.dev/compiler/compile_duration",
 		"Time to compile a set of policies",
@@ -186,6 +224,28 @@ var (
 		Aggregation: view.Distribution(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 16, 18, 20, 25, 30, 35, 40, 45, 50), //nolint:gomnd
 	}
 
+	EngineConcurrencyQueueDepth = stats.Int64(
+		"cerbos.dev/engine/concurrency_queue_depth",
+		"Number of evaluations waiting for a slot because the concurrency limit has been reached",
+		stats.UnitDimensionless,
+	)
+
+	EngineConcurrencyQueueDepthView = &view.View{
+		Measure:     EngineConcurrencyQueueDepth,
+		Aggregation: view.LastValue(),
+	}
+
+	EngineConcurrencyRejectedCount = stats.Int64(
+		"cerbos.dev/engine/concurrency_rejected_count",
+		"Number of evaluations rejected because the concurrency limit has been reached and the queue is full",
+		stats.UnitDimensionless,
+	)
+
+	EngineConcurrencyRejectedCountView = &view.View{
+		Measure:     EngineConcurrencyRejectedCount,
+		Aggregation: view.Count(),
+	}
+
 	EnginePlanLatency = stats.Float64(
 		"cerbos.dev/engine/plan_latency",
 		"Time to produce a query plan",
@@ -198,6 +258,18 @@ var (
 		Aggregation: defaultLatencyDistribution(),
 	}
 
+	EngineShadowDivergenceCount = stats.Int64(
+		"cerbos.dev/engine/shadow_divergence_count",
+		"Number of decisions where the shadow policy set disagreed with the primary",
+		stats.UnitDimensionless,
+	)
+
+	EngineShadowDivergenceCountView = &view.View{
+		Measure:     EngineShadowDivergenceCount,
+		TagKeys:     []tag.Key{KeyEngineShadowKind},
+		Aggregation: view.Count(),
+	}
+
 	IndexCRUDCount = stats.Int64(
 		"cerbos.dev/index/crud_count",
 		"Number of create/update/delete operations",
@@ -221,6 +293,42 @@ var (
 		Aggregation: view.LastValue(),
 	}
 
+	SpanCount = stats.Int64(
+		"cerbos.dev/span/count",
+		"Number of finished spans",
+		stats.UnitDimensionless,
+	)
+
+	SpanCountView = &view.View{
+		Measure:     SpanCount,
+		TagKeys:     []tag.Key{KeySpanName},
+		Aggregation: view.Count(),
+	}
+
+	SpanDuration = stats.Float64(
+		"cerbos.dev/span/duration",
+		"Duration of finished spans",
+		stats.UnitMilliseconds,
+	)
+
+	SpanDurationView = &view.View{
+		Measure:     SpanDuration,
+		TagKeys:     []tag.Key{KeySpanName},
+		Aggregation: defaultLatencyDistribution(),
+	}
+
+	SpanErrorCount = stats.Int64(
+		"cerbos.dev/span/error_count",
+		"Number of finished spans with an error status",
+		stats.UnitDimensionless,
+	)
+
+	SpanErrorCountView = &view.View{
+		Measure:     SpanErrorCount,
+		TagKeys:     []tag.Key{KeySpanName},
+		Aggregation: view.Count(),
+	}
+
 	StorePollCount = stats.Int64(
 		"cerbos.dev/store/poll_count",
 		"Number of times the remote store was polled for updates",
@@ -244,26 +352,47 @@ var (
 		TagKeys:     []tag.Key{KeyStoreDriver},
 		Aggregation: view.Count(),
 	}
+
+	TracingErrorCount = stats.Int64(
+		"cerbos.dev/tracing/error_count",
+		"Number of errors reported by the OpenTelemetry SDK",
+		stats.UnitDimensionless,
+	)
+
+	TracingErrorCountView = &view.View{
+		Measure:     TracingErrorCount,
+		Aggregation: view.Count(),
+	}
 )
 
 var DefaultCerbosViews = []*view.View{
 	AuditErrorCountView,
+	AuxDataCircuitBreakerStateView,
 	BundleFetchErrorsCountView,
 	BundleNotFoundErrorsCountView,
 	BundleStoreLatencyView,
 	BundleStoreRemoteEventsCountView,
 	BundleStoreUpdatesCountView,
+	BundleValidationFailuresCountView,
 	CacheAccessCountView,
 	CacheMaxSizeView,
+	CanaryFailureCountView,
 	CompileDurationView,
 	EngineCheckLatencyView,
 	EngineCheckBatchSizeView,
+	EngineConcurrencyQueueDepthView,
+	EngineConcurrencyRejectedCountView,
 	EnginePlanLatencyView,
+	EngineShadowDivergenceCountView,
 	HubConnectedCountView,
 	IndexCRUDCountView,
 	IndexEntryCountView,
+	SpanCountView,
+	SpanDurationView,
+	SpanErrorCountView,
 	StorePollCountView,
 	StoreSyncErrorCountView,
+	TracingErrorCountView,
 }
 
 func defaultLatencyDistribution() *view.Aggregation {
@@ -9,6 +9,7 @@ const (
 	bundleSourceKey  = attribute.Key("cerbos.bundle.source")
 	requestIDKey     = attribute.Key("cerbos.request.id")
 	reqResourceIDKey = attribute.Key("cerbos.request.resource_id")
+	resourceKindKey  = attribute.Key("cerbos.resource.kind")
 	policyFQNKey     = attribute.Key("cerbos.policy.fqn")
 	policyNameKey    = attribute.Key("cerbos.policy.name")
 	policyScopeKey   = attribute.Key("cerbos.policy.scope")
@@ -19,6 +20,7 @@ var (
 	BundleSource  = bundleSourceKey.String
 	RequestID     = requestIDKey.String
 	ReqResourceID = reqResourceIDKey.String
+	ResourceKind  = resourceKindKey.String
 	PolicyFQN     = policyFQNKey.String
 	PolicyName    = policyNameKey.String
 	PolicyScope   = policyScopeKey.String
@@ -6,20 +6,58 @@ package tracing
 import (
 	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/cerbos/cerbos/internal/util"
 )
 
 const (
 	confKey        = "tracing"
 	jaegerExporter = "jaeger"
 	otlpExporter   = "otlp"
+	zipkinExporter = "zipkin"
+	stdoutExporter = "stdout"
+	// noneExporter explicitly disables tracing, which is otherwise only disabled implicitly by not configuring an exporter.
+	noneExporter = "none"
+
+	defaultShutdownTimeout = 10 * time.Second
+	defaultOTLPDialTimeout = 10 * time.Second
+
+	defaultOTLPMetricsExportInterval = time.Minute
 )
 
+var defaultExcludeSpanPrefixes = []string{"grpc.", "cerbos.svc.v1.CerbosPlaygroundService.", "/api/playground/"}
+
 var (
-	errJaegerConfigUndefined   = errors.New("jaeger configuration is empty")
-	errJaegerEndpointUndefined = errors.New("jaeger endpoint undefined")
+	errJaegerConfigUndefined   = errors.New("tracing.jaeger must be set when tracing.exporter is jaeger")
+	errJaegerEndpointUndefined = errors.New("tracing.jaeger.agentEndpoint or tracing.jaeger.collectorEndpoint must be set")
 
-	errOTLPConfigUndefined   = errors.New("otlp configuration is empty")
-	errOTLPEndpointUndefined = errors.New("otlp endpoint undefined")
+	errZipkinConfigUndefined   = errors.New("tracing.zipkin must be set when tracing.exporter is zipkin")
+	errZipkinEndpointUndefined = errors.New("tracing.zipkin.collectorEndpoint must be set")
+
+	errOTLPConfigUndefined   = errors.New("tracing.otlp must be set when tracing.exporter is otlp unless the collector endpoint is set using the " + envOTLPTracesEndpoint + " or " + envOTLPEndpoint + " environment variables")
+	errOTLPEndpointUndefined = errors.New("tracing.otlp.collectorEndpoint must be set unless the collector endpoint is set using the " + envOTLPTracesEndpoint + " or " + envOTLPEndpoint + " environment variables")
+	errInvalidPinnedCert     = errors.New("pinned certificate fingerprint must be a hex-encoded SHA-256 digest")
+	errIncompleteClientCert  = errors.New("tracing.otlp.tls.certPath and tracing.otlp.tls.keyPath must be set together")
+	errInsecureHTTPS         = errors.New("tracing.otlp.tls.insecure must not be true when tracing.otlp.collectorEndpoint is an https URL")
+	errInvalidDialTimeout    = errors.New("tracing.otlp.grpc.dialTimeout must not be negative")
+	errInvalidKeepalive      = errors.New("tracing.otlp.grpc.keepalive.time must be greater than zero and tracing.otlp.grpc.keepalive.timeout must not be negative")
+
+	errExporterConflict           = errors.New("tracing.exporter and tracing.exporters must not be set together")
+	errNoneInExporters            = errors.New("tracing.exporters must not contain none: set tracing.exporter to none to disable tracing")
+	errOTLPMetricsWithoutExporter = errors.New("tracing.otlp.metrics.enabled requires otlp to be one of the configured exporters")
+	errInvalidMetricsInterval     = errors.New("tracing.otlp.metrics.exportInterval must not be negative")
+
+	errInvalidSampleProbability = errors.New("sample probability must be between 0 and 1")
+	errInvalidGracePeriod       = errors.New("tracing.startupBuffer.gracePeriod must be greater than zero")
+	errInvalidShutdownTimeout   = errors.New("tracing.shutdownTimeout must not be negative")
+	errInvalidBatchTimeout      = errors.New("tracing.batch.batchTimeout and tracing.batch.exportTimeout must not be negative")
+	errEmptyRedactionKey        = errors.New("tracing.redaction attribute keys must not be empty")
 )
 
 // Conf is optional configuration for tracing.
@@ -30,12 +68,82 @@ type Conf struct {
 	Jaeger *JaegerConf `yaml:"jaeger"`
 	// OTLP configures the OpenTelemetry exporter.
 	OTLP *OTLPConf `yaml:"otlp"`
+	// Zipkin configures the Zipkin exporter.
+	Zipkin *ZipkinConf `yaml:"zipkin"`
+	// Stdout configures the exporter that writes spans to standard output. Intended for local debugging.
+	Stdout *StdoutConf `yaml:"stdout"`
 	// [Deprecated] PropagationFormat is no longer used. Traces in trace-context, baggage, or b3 formats are automatically detected and propagated.
 	PropagationFormat string `yaml:"propagationFormat" conf:",ignore"`
-	// Exporter is the type of trace exporter to use.
+	// Exporter is the type of trace exporter to use. Set to none to explicitly disable tracing.
 	Exporter string `yaml:"exporter" conf:",example=jaeger"`
+	// Exporters are the types of trace exporters to send spans to at the same time. Use instead of Exporter to send spans to more than one backend.
+	Exporters []string `yaml:"exporters" conf:",example=[\"otlp\", \"jaeger\"]"`
 	// SampleProbability is the probability of sampling expressed as a number between 0 and 1.
 	SampleProbability float64 `yaml:"sampleProbability" conf:",example=0.1"`
+	// ResourceKindSampleProbabilities overrides sampleProbability for the decision spans of the listed resource kinds. Spans of other kinds use sampleProbability.
+	ResourceKindSampleProbabilities map[string]float64 `yaml:"resourceKindSampleProbabilities" conf:",example={\"payment\": 1.0, \"document\": 0.01}"`
+	// ErrorHandler configures how the errors reported by the OpenTelemetry SDK are handled.
+	ErrorHandler ErrorHandlerConf `yaml:"errorHandler"`
+	// SpanMetrics enables recording the cerbos_dev_span_count, cerbos_dev_span_error_count and cerbos_dev_span_duration metrics from finished spans. Spans that are not sampled are counted as well.
+	SpanMetrics bool `yaml:"spanMetrics" conf:",example=false"`
+	// KeepFailedSpans exports the spans that have an error status even if their trace was not sampled. Errors caused by the request, such as missing policies, don't set the error status. Unsampled spans are recorded to make this possible, which adds overhead to every request.
+	KeepFailedSpans bool `yaml:"keepFailedSpans" conf:",example=false"`
+	// ResponseHeaders adds the X-Trace-Sampled header to HTTP responses to indicate whether the request was sampled. The X-Trace-Id header is included as well when the request was sampled.
+	ResponseHeaders bool `yaml:"responseHeaders" conf:",example=false"`
+	// DebugTraceHeader enables sampling all spans of the requests that have the X-Cerbos-Debug-Trace header set to true regardless of the sample probability. Any client can use the header to force tracing, so only enable it while investigating an issue.
+	DebugTraceHeader bool `yaml:"debugTraceHeader" conf:",example=false"`
+	// ExcludeSpanPrefixes drops the spans with names starting with any of these prefixes. Defaults to the gRPC internal and playground spans. Set to an empty list to keep all spans.
+	ExcludeSpanPrefixes []string `yaml:"excludeSpanPrefixes" conf:",example=[\"grpc.\", \"/api/playground/\"]"`
+	// ExcludeHTTPPaths are the URL path prefixes of the HTTP requests that should not be traced. The trace context of those requests is still propagated.
+	ExcludeHTTPPaths []string `yaml:"excludeHTTPPaths" conf:",example=[\"/grpc.health.v1.Health/\"]"`
+	// Propagators are the formats used to extract and inject trace context. Valid values are tracecontext, baggage, b3, b3multi, jaeger, xray, ottrace and none. Defaults to tracecontext, baggage and b3.
+	Propagators []string `yaml:"propagators" conf:",example=[\"tracecontext\", \"baggage\"]"`
+	// B3InjectEncoding selects the headers used to propagate trace context in the b3 format. Valid values are "single" (default) for the single b3 header and "multi" for the X-B3-* headers. Both are accepted from incoming requests regardless of this setting.
+	B3InjectEncoding string `yaml:"b3InjectEncoding" conf:",example=single"`
+	// Redaction removes or hashes the span attributes that might contain sensitive data before the spans are exported.
+	Redaction *RedactionConf `yaml:"redaction"`
+	// ResourceAttributes are added to the resource describing the Cerbos process in the exported traces. They override the automatically detected attributes with the same keys.
+	ResourceAttributes map[string]string `yaml:"resourceAttributes" conf:",example={\"deployment.environment\": \"production\"}"`
+	// VerifyConnectivity checks that the endpoints of the configured exporters are reachable during startup and fails the startup if they are not. Each check times out after 10s. The Jaeger agent endpoint receives spans over UDP, so only its address can be checked.
+	VerifyConnectivity bool `yaml:"verifyConnectivity" conf:",example=false"`
+	// ShutdownTimeout is the maximum time to wait for pending spans to be exported when the server shuts down. Defaults to 10s.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" conf:",example=10s"`
+	// Batch configures how finished spans are batched before they are exported.
+	Batch *BatchConf `yaml:"batch"`
+	// StartupBuffer defers exporting the spans produced while the server is starting up until the grace period ends. Spans finished afterwards are batched as normal.
+	StartupBuffer *StartupBufferConf `yaml:"startupBuffer"`
+}
+
+type RedactionConf struct {
+	// DropAttributes are the keys of the span attributes to remove before the spans are exported.
+	DropAttributes []string `yaml:"dropAttributes" conf:",example=[\"cerbos.request.resource_id\"]"`
+	// HashAttributes are the keys of the span attributes to replace with the hex-encoded SHA-256 digest of their values before the spans are exported. Spans with the same attribute value can still be correlated.
+	HashAttributes []string `yaml:"hashAttributes" conf:",example=[\"enduser.id\"]"`
+}
+
+type StartupBufferConf struct {
+	// GracePeriod is how long to buffer spans for after startup before exporting them.
+	GracePeriod time.Duration `yaml:"gracePeriod" conf:",example=30s"`
+	// MaxQueueSize is the maximum number of spans to hold in the buffer during the grace period. Spans finished after the buffer is full are dropped. Defaults to 16384.
+	MaxQueueSize uint `yaml:"maxQueueSize" conf:",example=16384"`
+}
+
+type BatchConf struct {
+	// MaxQueueSize is the maximum number of spans waiting to be exported. Spans finished after the queue is full are dropped. Defaults to 2048.
+	MaxQueueSize uint `yaml:"maxQueueSize" conf:",example=2048"`
+	// MaxExportBatchSize is the maximum number of spans sent in a single export request. Defaults to 512.
+	MaxExportBatchSize uint `yaml:"maxExportBatchSize" conf:",example=512"`
+	// BatchTimeout is the maximum time to wait before exporting the queued spans. Defaults to 5s.
+	BatchTimeout time.Duration `yaml:"batchTimeout" conf:",example=5s"`
+	// ExportTimeout is the maximum time to wait for an export request to complete. Defaults to 30s.
+	ExportTimeout time.Duration `yaml:"exportTimeout" conf:",example=30s"`
+}
+
+type ErrorHandlerConf struct {
+	// LogLevel is the level at which OpenTelemetry errors are logged. Valid values are "debug", "warn" (default) and "error".
+	LogLevel string `yaml:"logLevel" conf:",example=warn"`
+	// Metric enables counting OpenTelemetry errors in the cerbos_dev_tracing_error_count metric.
+	Metric bool `yaml:"metric" conf:",example=false"`
 }
 
 type JaegerConf struct {
@@ -45,13 +153,83 @@ type JaegerConf struct {
 	AgentEndpoint string `yaml:"agentEndpoint" conf:",example=\"localhost:6831\""`
 	// CollectorEndpoint is the Jaeger collector endpoint to report to.
 	CollectorEndpoint string `yaml:"collectorEndpoint" conf:",example=\"http://localhost:14268/api/traces\""`
+	// SampleProbability overrides the top-level sampleProbability for spans sent to this exporter.
+	SampleProbability *float64 `yaml:"sampleProbability" conf:",example=0.1"`
+	// UseOTLP sends the traces to the OTLP receiver of Jaeger instead of using the deprecated native Jaeger exporter. The gRPC receiver (port 4317) on the host of AgentEndpoint or the HTTP receiver (port 4318) on the host of CollectorEndpoint is used.
+	UseOTLP bool `yaml:"useOTLP" conf:",example=true"`
 }
 
 type OTLPConf struct {
-	// Protocol is the protocol to use for the OTLP exporter. Valid values are "grpc" (default) or "http/protobuf".
+	// Protocol is the protocol to use for the OTLP exporter. Valid values are "grpc" (default) or "http/protobuf". Falls back to the OTEL_EXPORTER_OTLP_PROTOCOL environment variable if not set.
 	Protocol string `yaml:"protocol" conf:",example=grpc"`
-	// CollectorEndpoint is the Open Telemetry collector endpoint to export to.
+	// CollectorEndpoint is the Open Telemetry collector endpoint to export to. For the http/protobuf protocol, it can be a URL such as https://otel:4318/v1/traces to set the scheme and the path. Falls back to the OTEL_EXPORTER_OTLP_ENDPOINT environment variable if not set.
 	CollectorEndpoint string `yaml:"collectorEndpoint" conf:",example=\"otel:4317\""`
+	// SampleProbability overrides the top-level sampleProbability for spans sent to this exporter.
+	SampleProbability *float64 `yaml:"sampleProbability" conf:",example=0.1"`
+	// Compression is the compression applied to export requests. Valid values are "none" (default) or "gzip".
+	Compression string `yaml:"compression" conf:",example=gzip"`
+	// Headers are sent with every export request. Useful for collectors that require authentication. Values can reference environment variables. Falls back to the OTEL_EXPORTER_OTLP_HEADERS environment variable if not set.
+	Headers map[string]string `yaml:"headers" conf:",example={\"X-Scope-OrgID\": \"tenant-1\"}"`
+	// TLS configures a secure connection to the collector. The connection is insecure unless TLS.Insecure is set to false, as in the previous releases.
+	TLS *OTLPTLSConf `yaml:"tls"`
+	// GRPC configures the connection to the collector when the protocol is grpc.
+	GRPC *OTLPGRPCConf `yaml:"grpc"`
+	// Metrics configures exporting the Cerbos metrics to the collector using the same connection settings.
+	Metrics *OTLPMetricsConf `yaml:"metrics"`
+}
+
+type OTLPMetricsConf struct {
+	// Enabled exports the Cerbos metrics to the collector in addition to exposing them to Prometheus.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// ExportInterval is how often the metrics are exported. Defaults to 60s.
+	ExportInterval time.Duration `yaml:"exportInterval" conf:",example=60s"`
+}
+
+type OTLPGRPCConf struct {
+	// Keepalive configures the keepalive pings sent to the collector. Useful for preventing load balancers from closing idle connections.
+	Keepalive *OTLPKeepaliveConf `yaml:"keepalive"`
+	// WaitForConnection blocks startup until the connection to the collector is established. Startup fails if the connection is not established within DialTimeout.
+	WaitForConnection bool `yaml:"waitForConnection" conf:",example=false"`
+	// DialTimeout is how long to wait for the connection to the collector to be established when WaitForConnection is enabled. Defaults to 10s.
+	DialTimeout time.Duration `yaml:"dialTimeout" conf:",example=10s"`
+}
+
+type OTLPKeepaliveConf struct {
+	// Time is the duration of inactivity after which a keepalive ping is sent to the collector.
+	Time time.Duration `yaml:"time" conf:"required,example=30s"`
+	// Timeout is how long to wait for the collector to acknowledge a keepalive ping before closing the connection. Defaults to 20s.
+	Timeout time.Duration `yaml:"timeout" conf:",example=20s"`
+	// PermitWithoutStream sends keepalive pings even when there are no exports in progress.
+	PermitWithoutStream bool `yaml:"permitWithoutStream" conf:",example=true"`
+}
+
+type ZipkinConf struct {
+	// CollectorEndpoint is the URL of the Zipkin collector to report spans to.
+	CollectorEndpoint string `yaml:"collectorEndpoint" conf:",example=\"http://localhost:9411/api/v2/spans\""`
+	// SampleProbability overrides the top-level sampleProbability for spans sent to this exporter.
+	SampleProbability *float64 `yaml:"sampleProbability" conf:",example=0.1"`
+	// Headers are sent with every export request. Values can reference environment variables.
+	Headers map[string]string `yaml:"headers" conf:",example={\"X-Scope-OrgID\": \"tenant-1\"}"`
+}
+
+type StdoutConf struct {
+	// PrettyPrint writes each span as indented JSON instead of compact JSON.
+	PrettyPrint bool `yaml:"prettyPrint" conf:",example=true"`
+}
+
+type OTLPTLSConf struct {
+	// CAPath is the path to the CA certificate used to verify the collector. The system certificate pool is used if it's not set.
+	CAPath string `yaml:"caPath" conf:",example=/path/to/ca.crt"`
+	// CertPath is the path to the client certificate presented to collectors that require mutual TLS. Must be set together with KeyPath.
+	CertPath string `yaml:"certPath" conf:",example=/path/to/tls.crt"`
+	// KeyPath is the path to the key of the client certificate.
+	KeyPath string `yaml:"keyPath" conf:",example=/path/to/tls.key"`
+	// ServerName overrides the host name used to verify the collector's certificate. Defaults to the host of the collector endpoint.
+	ServerName string `yaml:"serverName" conf:",example=otel.example.com"`
+	// Insecure disables TLS for the connection to the collector, ignoring the rest of the TLS settings. Defaults to true to preserve the behaviour of the previous releases, so set it to false to enable TLS. The TLS settings are always applied if the collector endpoint is an https URL, in which case it must not be set to true.
+	Insecure *bool `yaml:"insecure" conf:",example=false"`
+	// PinnedCertSHA256 is the hex-encoded SHA-256 fingerprint of the collector's certificate. If it's set, the connection is rejected unless the collector presents a certificate with this fingerprint and the certificate chain is not verified against a CA.
+	PinnedCertSHA256 string `yaml:"pinnedCertSHA256" conf:",example=\"5d41402abc4b2a76b9719d911017c592ae3f1e0e2b2c6e4a3fbe8d5a3c2b7a1f\""`
 }
 
 func (c *Conf) Key() string {
@@ -59,10 +237,105 @@ func (c *Conf) Key() string {
 }
 
 func (c *Conf) Validate() error {
-	switch c.Exporter {
-	case "":
-		return nil
+	if _, err := c.ErrorHandler.level(); err != nil {
+		return err
+	}
+
+	if err := validateProbability("tracing.sampleProbability", &c.SampleProbability); err != nil {
+		return err
+	}
+
+	for kind, p := range c.ResourceKindSampleProbabilities {
+		p := p
+		if err := validateProbability(fmt.Sprintf("tracing.resourceKindSampleProbabilities[%q]", kind), &p); err != nil {
+			return err
+		}
+	}
+
+	if c.StartupBuffer != nil && c.StartupBuffer.GracePeriod <= 0 {
+		return errInvalidGracePeriod
+	}
+
+	if c.ShutdownTimeout < 0 {
+		return errInvalidShutdownTimeout
+	}
+
+	if c.Batch != nil && (c.Batch.BatchTimeout < 0 || c.Batch.ExportTimeout < 0) {
+		return errInvalidBatchTimeout
+	}
+
+	if _, err := mkPropagator(c.Propagators, c.B3InjectEncoding); err != nil {
+		return err
+	}
+
+	for _, p := range c.ExcludeHTTPPaths {
+		if !strings.HasPrefix(p, "/") {
+			return fmt.Errorf("invalid tracing.excludeHTTPPaths entry %q: paths must start with /", p)
+		}
+	}
+
+	if c.Redaction != nil {
+		for _, k := range c.Redaction.DropAttributes {
+			if k == "" {
+				return errEmptyRedactionKey
+			}
+
+			if slices.Contains(c.Redaction.HashAttributes, k) {
+				return fmt.Errorf("invalid tracing.redaction.hashAttributes entry %q: the attribute is dropped by tracing.redaction.dropAttributes", k)
+			}
+		}
+
+		if slices.Contains(c.Redaction.HashAttributes, "") {
+			return errEmptyRedactionKey
+		}
+	}
+
+	for k, v := range c.ResourceAttributes {
+		if k == "" || v == "" {
+			return fmt.Errorf("invalid tracing.resourceAttributes entry %q: %q: keys and values must not be empty", k, v)
+		}
+	}
+
+	if c.Exporter != "" && len(c.Exporters) > 0 {
+		return errExporterConflict
+	}
+
+	if slices.Contains(c.Exporters, noneExporter) {
+		return errNoneInExporters
+	}
+
+	if c.otlpMetricsEnabled() {
+		if !slices.Contains(c.exporters(), otlpExporter) {
+			return errOTLPMetricsWithoutExporter
+		}
+
+		if c.OTLP.Metrics.ExportInterval < 0 {
+			return errInvalidMetricsInterval
+		}
+	}
+
+	key := "tracing.exporter"
+	if len(c.Exporters) > 0 {
+		key = "tracing.exporters"
+	}
+
+	seen := make(map[string]struct{}, len(c.Exporters))
+	for _, name := range c.exporters() {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("invalid %s: %q is listed more than once", key, name)
+		}
+		seen[name] = struct{}{}
+
+		if err := c.validateExporter(key, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+func (c *Conf) validateExporter(key, name string) error {
+	switch name {
 	case jaegerExporter:
 		if c.Jaeger == nil {
 			return errJaegerConfigUndefined
@@ -70,23 +343,199 @@ func (c *Conf) Validate() error {
 		if c.Jaeger.AgentEndpoint == "" && c.Jaeger.CollectorEndpoint == "" {
 			return errJaegerEndpointUndefined
 		}
-		return nil
+		if c.Jaeger.UseOTLP {
+			if _, err := jaegerOTLPConf(c.Jaeger); err != nil {
+				return err
+			}
+		}
+		return validateProbability("tracing.jaeger.sampleProbability", c.Jaeger.SampleProbability)
 
 	case otlpExporter:
-		if c.OTLP == nil {
-			return errOTLPConfigUndefined
+		otlpConf := c.OTLP
+		if otlpConf == nil {
+			if otlpEndpointFromEnv() == "" {
+				return errOTLPConfigUndefined
+			}
+			otlpConf = &OTLPConf{}
 		}
-		if c.OTLP.CollectorEndpoint == "" {
+		if otlpConf.CollectorEndpoint == "" && otlpEndpointFromEnv() == "" {
 			return errOTLPEndpointUndefined
 		}
+		if p := otlpConf.protocol(); p != otlpProtocolGRPC && p != otlpProtocolHTTP {
+			return fmt.Errorf("invalid tracing.otlp.protocol %q: valid values are %s and %s", p, otlpProtocolGRPC, otlpProtocolHTTP)
+		}
+		if cmp := otlpConf.compression(); cmp != otlpCompressionNone && cmp != otlpCompressionGzip {
+			return fmt.Errorf("invalid tracing.otlp.compression %q: valid values are %s and %s", otlpConf.Compression, otlpCompressionNone, otlpCompressionGzip)
+		}
+		if otlpConf.TLS != nil {
+			if otlpConf.TLS.PinnedCertSHA256 != "" {
+				if _, err := parseFingerprint(otlpConf.TLS.PinnedCertSHA256); err != nil {
+					return err
+				}
+			}
+
+			if (otlpConf.TLS.CertPath == "") != (otlpConf.TLS.KeyPath == "") {
+				return errIncompleteClientCert
+			}
+
+			// The scheme of the endpoint URL decides whether the HTTP exporter uses TLS, so it can't be disabled separately.
+			if otlpConf.TLS.Insecure != nil && *otlpConf.TLS.Insecure && otlpConf.protocol() == otlpProtocolHTTP && strings.HasPrefix(otlpConf.CollectorEndpoint, "https://") {
+				return errInsecureHTTPS
+			}
+		}
+
+		if g := otlpConf.GRPC; g != nil {
+			if g.DialTimeout < 0 {
+				return errInvalidDialTimeout
+			}
+
+			if g.Keepalive != nil && (g.Keepalive.Time <= 0 || g.Keepalive.Timeout < 0) {
+				return errInvalidKeepalive
+			}
+		}
+
+		return validateProbability("tracing.otlp.sampleProbability", otlpConf.SampleProbability)
+
+	case zipkinExporter:
+		if c.Zipkin == nil {
+			return errZipkinConfigUndefined
+		}
+		if c.Zipkin.CollectorEndpoint == "" {
+			return errZipkinEndpointUndefined
+		}
+		return validateProbability("tracing.zipkin.sampleProbability", c.Zipkin.SampleProbability)
+
+	case stdoutExporter:
+		return nil
+
+	default:
+		if len(c.Exporters) == 0 {
+			return fmt.Errorf("invalid %s %q: valid values are %s, %s, %s, %s and %s", key, name, otlpExporter, jaegerExporter, zipkinExporter, stdoutExporter, noneExporter)
+		}
+		return fmt.Errorf("invalid %s %q: valid values are %s, %s, %s and %s", key, name, otlpExporter, jaegerExporter, zipkinExporter, stdoutExporter)
+	}
+}
+
+// exporters returns the names of the configured exporters. It's empty if tracing is disabled, either explicitly or by not configuring an exporter.
+func (c *Conf) exporters() []string {
+	if len(c.Exporters) > 0 {
+		return c.Exporters
+	}
+
+	if c.Exporter != "" && c.Exporter != noneExporter {
+		return []string{c.Exporter}
+	}
+
+	return nil
+}
+
+// serviceName returns the service name to report to the exporters, falling back to the deprecated Jaeger setting if the Jaeger exporter is used.
+func (c *Conf) serviceName() string {
+	if c.ServiceName != nil {
+		return *c.ServiceName
+	}
+
+	if c.Jaeger != nil && c.Jaeger.ServiceName != "" && slices.Contains(c.exporters(), jaegerExporter) {
+		return c.Jaeger.ServiceName
+	}
+
+	return util.AppName
+}
+
+// otlpMetricsEnabled returns true if the metrics should be exported to the OTLP collector.
+func (c *Conf) otlpMetricsEnabled() bool {
+	return c.OTLP != nil && c.OTLP.Metrics != nil && c.OTLP.Metrics.Enabled
+}
 
+func (mc *OTLPMetricsConf) exportInterval() time.Duration {
+	if mc.ExportInterval == 0 {
+		return defaultOTLPMetricsExportInterval
+	}
+
+	return mc.ExportInterval
+}
+
+func validateProbability(key string, p *float64) error {
+	if p == nil || (*p >= 0 && *p <= 1) {
 		return nil
+	}
 
+	return fmt.Errorf("invalid %s %v: %w", key, *p, errInvalidSampleProbability)
+}
+
+func (ehc ErrorHandlerConf) level() (zapcore.Level, error) {
+	switch strings.ToLower(ehc.LogLevel) {
+	case "", "warn":
+		return zapcore.WarnLevel, nil
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
 	default:
-		return fmt.Errorf("unknown trace exporter %s", c.Exporter)
+		return zapcore.InvalidLevel, fmt.Errorf("invalid tracing.errorHandler.logLevel %q: valid values are debug, warn and error", ehc.LogLevel)
+	}
+}
+
+func (c *Conf) excludeSpanPrefixes() []string {
+	if c.ExcludeSpanPrefixes == nil {
+		return defaultExcludeSpanPrefixes
+	}
+
+	return c.ExcludeSpanPrefixes
+}
+
+func (c *Conf) shutdownTimeout() time.Duration {
+	if c.ShutdownTimeout == 0 {
+		return defaultShutdownTimeout
+	}
+
+	return c.ShutdownTimeout
+}
+
+func (gc *OTLPGRPCConf) dialTimeout() time.Duration {
+	if gc.DialTimeout == 0 {
+		return defaultOTLPDialTimeout
 	}
+
+	return gc.DialTimeout
+}
+
+func (oc *OTLPConf) protocol() string {
+	if oc.Protocol != "" {
+		return oc.Protocol
+	}
+
+	for _, env := range []string{envOTLPTracesProtocol, envOTLPProtocol} {
+		if p := os.Getenv(env); p != "" {
+			return p
+		}
+	}
+
+	return otlpProtocolGRPC
+}
+
+// tlsEnabled reports whether the TLS settings are applied to the connection to the collector.
+func (oc *OTLPConf) tlsEnabled() bool {
+	if oc.TLS == nil {
+		return false
+	}
+
+	if oc.TLS.Insecure != nil {
+		return !*oc.TLS.Insecure
+	}
+
+	return oc.protocol() == otlpProtocolHTTP && strings.HasPrefix(oc.CollectorEndpoint, "https://")
 }
 
-func (c *Conf) SetDefaults() {
-	c.OTLP.Protocol = "grpc"
+// forceInsecure reports whether TLS is explicitly disabled, overriding the defaults of the exporters.
+func (oc *OTLPConf) forceInsecure() bool {
+	return oc.TLS != nil && oc.TLS.Insecure != nil && *oc.TLS.Insecure
+}
+
+func (oc *OTLPConf) compression() string {
+	if oc.Compression == "" {
+		return otlpCompressionNone
+	}
+
+	return oc.Compression
 }
@@ -0,0 +1,163 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	insecure := true
+
+	for _, env := range []string{envOTLPEndpoint, envOTLPTracesEndpoint, envOTLPProtocol, envOTLPTracesProtocol} {
+		t.Setenv(env, "")
+	}
+
+	invalidProbability := 1.5
+
+	testCases := []struct {
+		name    string
+		conf    Conf
+		wantErr string
+	}{
+		{
+			name: "disabled",
+			conf: Conf{},
+		},
+		{
+			name:    "unknown_exporter",
+			conf:    Conf{Exporter: "carrier-pigeon"},
+			wantErr: `invalid tracing.exporter "carrier-pigeon": valid values are otlp, jaeger, zipkin, stdout and none`,
+		},
+		{
+			name:    "exporter_typo",
+			conf:    Conf{Exporter: "otpl", OTLP: &OTLPConf{CollectorEndpoint: "otel:4317"}},
+			wantErr: `invalid tracing.exporter "otpl": valid values are otlp, jaeger, zipkin, stdout and none`,
+		},
+		{
+			name: "explicitly_disabled",
+			conf: Conf{Exporter: noneExporter, OTLP: &OTLPConf{CollectorEndpoint: "otel:4317"}},
+		},
+		{
+			name:    "none_in_exporters",
+			conf:    Conf{Exporters: []string{stdoutExporter, noneExporter}},
+			wantErr: "tracing.exporters must not contain none: set tracing.exporter to none to disable tracing",
+		},
+		{
+			name:    "sample_probability",
+			conf:    Conf{SampleProbability: -0.5},
+			wantErr: "invalid tracing.sampleProbability -0.5: sample probability must be between 0 and 1",
+		},
+		{
+			name:    "resource_kind_sample_probability",
+			conf:    Conf{ResourceKindSampleProbabilities: map[string]float64{"payment": 2}},
+			wantErr: `invalid tracing.resourceKindSampleProbabilities["payment"] 2: sample probability must be between 0 and 1`,
+		},
+		{
+			name:    "exporter_sample_probability",
+			conf:    Conf{Exporter: zipkinExporter, Zipkin: &ZipkinConf{CollectorEndpoint: "http://zipkin:9411/api/v2/spans", SampleProbability: &invalidProbability}},
+			wantErr: "invalid tracing.zipkin.sampleProbability 1.5: sample probability must be between 0 and 1",
+		},
+		{
+			name:    "jaeger_missing",
+			conf:    Conf{Exporter: jaegerExporter},
+			wantErr: "tracing.jaeger must be set when tracing.exporter is jaeger",
+		},
+		{
+			name:    "jaeger_endpoint_missing",
+			conf:    Conf{Exporter: jaegerExporter, Jaeger: &JaegerConf{}},
+			wantErr: "tracing.jaeger.agentEndpoint or tracing.jaeger.collectorEndpoint must be set",
+		},
+		{
+			name:    "otlp_missing",
+			conf:    Conf{Exporter: otlpExporter},
+			wantErr: "tracing.otlp must be set when tracing.exporter is otlp",
+		},
+		{
+			name:    "otlp_endpoint_missing",
+			conf:    Conf{Exporter: otlpExporter, OTLP: &OTLPConf{}},
+			wantErr: "tracing.otlp.collectorEndpoint must be set",
+		},
+		{
+			name:    "otlp_protocol",
+			conf:    Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: "otel:4317", Protocol: "http/json"}},
+			wantErr: `invalid tracing.otlp.protocol "http/json": valid values are grpc and http/protobuf`,
+		},
+		{
+			name:    "otlp_insecure_https",
+			conf:    Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: "https://otel:4318", Protocol: otlpProtocolHTTP, TLS: &OTLPTLSConf{Insecure: &insecure}}},
+			wantErr: "tracing.otlp.tls.insecure must not be true when tracing.otlp.collectorEndpoint is an https URL",
+		},
+		{
+			name: "otlp_default_protocol",
+			conf: Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: "otel:4317"}},
+		},
+		{
+			name:    "zipkin_missing",
+			conf:    Conf{Exporter: zipkinExporter},
+			wantErr: "tracing.zipkin must be set when tracing.exporter is zipkin",
+		},
+		{
+			name:    "zipkin_endpoint_missing",
+			conf:    Conf{Exporter: zipkinExporter, Zipkin: &ZipkinConf{}},
+			wantErr: "tracing.zipkin.collectorEndpoint must be set",
+		},
+		{
+			name: "stdout",
+			conf: Conf{Exporter: stdoutExporter},
+		},
+		{
+			name: "multiple_exporters",
+			conf: Conf{Exporters: []string{stdoutExporter, zipkinExporter}, Zipkin: &ZipkinConf{CollectorEndpoint: "http://zipkin:9411/api/v2/spans"}},
+		},
+		{
+			name:    "multiple_exporters_invalid",
+			conf:    Conf{Exporters: []string{stdoutExporter, zipkinExporter}},
+			wantErr: "tracing.zipkin must be set when tracing.exporter is zipkin",
+		},
+		{
+			name:    "multiple_exporters_unknown",
+			conf:    Conf{Exporters: []string{stdoutExporter, "carrier-pigeon"}},
+			wantErr: `invalid tracing.exporters "carrier-pigeon": valid values are otlp, jaeger, zipkin and stdout`,
+		},
+		{
+			name:    "multiple_exporters_duplicate",
+			conf:    Conf{Exporters: []string{stdoutExporter, stdoutExporter}},
+			wantErr: `invalid tracing.exporters: "stdout" is listed more than once`,
+		},
+		{
+			name:    "redaction_empty_key",
+			conf:    Conf{Redaction: &RedactionConf{HashAttributes: []string{""}}},
+			wantErr: "tracing.redaction attribute keys must not be empty",
+		},
+		{
+			name:    "redaction_drop_and_hash",
+			conf:    Conf{Redaction: &RedactionConf{DropAttributes: []string{"enduser.id"}, HashAttributes: []string{"enduser.id"}}},
+			wantErr: `invalid tracing.redaction.hashAttributes entry "enduser.id": the attribute is dropped by tracing.redaction.dropAttributes`,
+		},
+		{
+			name:    "exporter_and_exporters",
+			conf:    Conf{Exporter: stdoutExporter, Exporters: []string{stdoutExporter}},
+			wantErr: "tracing.exporter and tracing.exporters must not be set together",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conf.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.wantErr)
+			// The configuration must be rejected before any exporter is created.
+			require.ErrorContains(t, InitFromConf(context.Background(), tc.conf), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// connectivityTimeout is how long to wait for the endpoint of an exporter to respond when VerifyConnectivity is enabled.
+const connectivityTimeout = 10 * time.Second
+
+// verifyConnectivity checks that the endpoint of the named exporter is reachable.
+// Exporters that don't send spans over the network (stdout) are always considered to be reachable.
+func verifyConnectivity(ctx context.Context, name string) error {
+	ctx, cancelFn := context.WithTimeout(ctx, connectivityTimeout)
+	defer cancelFn()
+
+	var err error
+	switch name {
+	case jaegerExporter:
+		err = verifyJaegerConnectivity(ctx, conf.Jaeger)
+	case otlpExporter:
+		otlpConf := conf.OTLP
+		if otlpConf == nil {
+			otlpConf = &OTLPConf{}
+		}
+		err = verifyOTLPConnectivity(ctx, otlpConf)
+	case zipkinExporter:
+		err = probeHTTPEndpoint(ctx, conf.Zipkin.CollectorEndpoint, nil)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to verify connectivity to the %s exporter endpoint: %w", name, err)
+	}
+
+	return nil
+}
+
+// verifyJaegerConnectivity checks the collector endpoint with an HTTP request.
+// The agent endpoint receives spans over UDP, which doesn't acknowledge anything, so only the agent address is resolved.
+func verifyJaegerConnectivity(ctx context.Context, jc *JaegerConf) error {
+	if jc.UseOTLP {
+		otlpConf, err := jaegerOTLPConf(jc)
+		if err != nil {
+			return err
+		}
+
+		return verifyOTLPConnectivity(ctx, otlpConf)
+	}
+
+	if jc.AgentEndpoint != "" {
+		conn, err := (&net.Dialer{}).DialContext(ctx, "udp", jc.AgentEndpoint)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+
+	return probeHTTPEndpoint(ctx, jc.CollectorEndpoint, nil)
+}
+
+// verifyOTLPConnectivity establishes a gRPC connection to the collector or sends an HTTP request to it, depending on the protocol.
+func verifyOTLPConnectivity(ctx context.Context, otlpConf *OTLPConf) error {
+	_, tlsConf, err := otlpConnSettings(otlpConf)
+	if err != nil {
+		return err
+	}
+
+	endpoint := otlpConf.CollectorEndpoint
+	if endpoint == "" {
+		endpoint = otlpEndpointFromEnv()
+	}
+
+	if otlpConf.protocol() == otlpProtocolHTTP {
+		e, err := parseOTLPHTTPEndpoint(endpoint, tlsConf)
+		if err != nil {
+			return err
+		}
+
+		u := url.URL{Scheme: "http", Host: e.host, Path: e.path}
+		if e.secure {
+			u.Scheme = "https"
+		}
+		if u.Path == "" {
+			u.Path = "/v1/traces"
+		}
+
+		return probeHTTPEndpoint(ctx, u.String(), tlsConf)
+	}
+
+	// The endpoints set using the environment variables are URLs with a scheme that decides whether the connection is encrypted.
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return fmt.Errorf("invalid otlp collector endpoint %q: %w", endpoint, err)
+		}
+
+		endpoint = u.Host
+		if u.Scheme == "https" && tlsConf == nil {
+			tlsConf = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsConf != nil {
+		creds = credentials.NewTLS(tlsConf)
+	}
+
+	dialOpts := append(otlpGRPCDialOptions(otlpConf.GRPC),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithBlock(),
+		grpc.WithReturnConnectionError(),
+		grpc.FailOnNonTempDialError(true),
+	)
+
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// probeHTTPEndpoint sends a HEAD request to the endpoint. Any response counts as success because collectors typically
+// reject HEAD requests to their export paths, so only the failures to connect or to complete the TLS handshake are reported.
+func probeHTTPEndpoint(ctx context.Context, endpoint string, tlsConf *tls.Config) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConf}}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
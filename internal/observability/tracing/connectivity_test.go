@@ -0,0 +1,105 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestVerifyConnectivity(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, grpcAddr := startTraceCollector(t)
+
+	testCases := []struct {
+		name    string
+		conf    Conf
+		wantErr bool
+	}{
+		{
+			name: "otlp_grpc",
+			conf: Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: grpcAddr}},
+		},
+		{
+			name:    "otlp_grpc_unreachable",
+			conf:    Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: closedAddr(t)}},
+			wantErr: true,
+		},
+		{
+			name: "otlp_http",
+			conf: Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: srv.URL, Protocol: otlpProtocolHTTP}},
+		},
+		{
+			name:    "otlp_http_unreachable",
+			conf:    Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: closedAddr(t), Protocol: otlpProtocolHTTP}},
+			wantErr: true,
+		},
+		{
+			name: "zipkin",
+			conf: Conf{Exporter: zipkinExporter, Zipkin: &ZipkinConf{CollectorEndpoint: srv.URL + "/api/v2/spans"}},
+		},
+		{
+			name:    "zipkin_unreachable",
+			conf:    Conf{Exporter: zipkinExporter, Zipkin: &ZipkinConf{CollectorEndpoint: "http://" + closedAddr(t) + "/api/v2/spans"}},
+			wantErr: true,
+		},
+		{
+			name: "jaeger_agent",
+			conf: Conf{Exporter: jaegerExporter, Jaeger: &JaegerConf{AgentEndpoint: "127.0.0.1:6831"}},
+		},
+		{
+			name: "jaeger_collector",
+			conf: Conf{Exporter: jaegerExporter, Jaeger: &JaegerConf{CollectorEndpoint: srv.URL + "/api/traces"}},
+		},
+		{
+			name:    "jaeger_collector_unreachable",
+			conf:    Conf{Exporter: jaegerExporter, Jaeger: &JaegerConf{CollectorEndpoint: "http://" + closedAddr(t) + "/api/traces"}},
+			wantErr: true,
+		},
+		{
+			name:    "one_of_many_unreachable",
+			conf:    Conf{Exporters: []string{stdoutExporter, otlpExporter}, OTLP: &OTLPConf{CollectorEndpoint: closedAddr(t)}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			t.Cleanup(func() {
+				_ = Shutdown(ctx)
+				otel.SetTracerProvider(trace.NewNoopTracerProvider())
+			})
+
+			tc.conf.VerifyConnectivity = true
+			err := InitFromConf(ctx, tc.conf)
+			if tc.wantErr {
+				require.ErrorContains(t, err, "failed to verify connectivity")
+
+				// Without the check, the misconfigured endpoint is only discovered when spans are exported.
+				tc.conf.VerifyConnectivity = false
+				require.NoError(t, InitFromConf(ctx, tc.conf))
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	require.Positive(t, requests.Load())
+}
@@ -0,0 +1,96 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// DebugTraceHeader is the header used to request that every span of a request is sampled.
+const DebugTraceHeader = "X-Cerbos-Debug-Trace"
+
+var debugTraceEnabled bool
+
+type debugTraceCtxKeyType struct{}
+
+var debugTraceCtxKey = debugTraceCtxKeyType{}
+
+// DebugTraceEnabled returns true if requests are allowed to force sampling using the debug trace header.
+func DebugTraceEnabled() bool {
+	return debugTraceEnabled
+}
+
+// isDebugTrace returns true if the context belongs to a request that asked to be traced using the debug trace header.
+func isDebugTrace(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	forced, _ := ctx.Value(debugTraceCtxKey).(bool)
+	return forced
+}
+
+func withDebugTrace(ctx context.Context, value string) context.Context {
+	if forced, err := strconv.ParseBool(value); err != nil || !forced {
+		return ctx
+	}
+
+	return context.WithValue(ctx, debugTraceCtxKey, true)
+}
+
+// debugTraceHandler marks the requests with the debug trace header so that the sampler samples their spans.
+// It must wrap the handler that starts the request span.
+func debugTraceHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if value := r.Header.Get(DebugTraceHeader); value != "" {
+			r = r.WithContext(withDebugTrace(r.Context(), value))
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// DebugTraceUnaryServerInterceptor marks the requests with the debug trace header so that the sampler samples their spans.
+// It must run before the interceptor that starts the request span.
+func DebugTraceUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(debugTraceFromMetadata(ctx), req)
+}
+
+// DebugTraceStreamServerInterceptor marks the streams with the debug trace header so that the sampler samples their spans.
+// It must run before the interceptor that starts the request span.
+func DebugTraceStreamServerInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := debugTraceFromMetadata(stream.Context())
+	if ctx == stream.Context() {
+		return handler(srv, stream)
+	}
+
+	return handler(srv, debugTraceServerStream{ServerStream: stream, ctx: ctx})
+}
+
+func debugTraceFromMetadata(ctx context.Context) context.Context {
+	if !debugTraceEnabled {
+		return ctx
+	}
+
+	if values := metadata.ValueFromIncomingContext(ctx, strings.ToLower(DebugTraceHeader)); len(values) > 0 {
+		return withDebugTrace(ctx, values[0])
+	}
+
+	return ctx
+}
+
+type debugTraceServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s debugTraceServerStream) Context() context.Context {
+	return s.ctx
+}
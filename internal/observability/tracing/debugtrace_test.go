@@ -0,0 +1,101 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	otelprop "go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDebugTraceHeader(t *testing.T) {
+	provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(0.0, false, nil)))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		debugTraceEnabled = false
+	})
+
+	testCases := []struct {
+		name        string
+		enabled     bool
+		headerValue string
+		wantSampled bool
+	}{
+		{name: "enabled", enabled: true, headerValue: "true", wantSampled: true},
+		{name: "enabled_false_value", enabled: true, headerValue: "false"},
+		{name: "enabled_no_header", enabled: true},
+		{name: "disabled", headerValue: "true"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			debugTraceEnabled = tc.enabled
+
+			t.Run("http", func(t *testing.T) {
+				var childCtx trace.SpanContext
+				carrier := otelprop.MapCarrier{}
+				handler := HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ctx, span := StartSpan(r.Context(), "cerbos.engine.Check")
+					defer span.End()
+
+					childCtx = span.SpanContext()
+					otelprop.TraceContext{}.Inject(ctx, carrier)
+					w.WriteHeader(http.StatusOK)
+				}), "/api")
+
+				req := httptest.NewRequest(http.MethodGet, "/api/check", http.NoBody)
+				if tc.headerValue != "" {
+					req.Header.Set(DebugTraceHeader, tc.headerValue)
+				}
+				handler.ServeHTTP(httptest.NewRecorder(), req)
+
+				require.Equal(t, tc.wantSampled, childCtx.IsSampled())
+				// The decision must be propagated to downstream services.
+				require.Equal(t, tc.wantSampled, strings.HasSuffix(carrier.Get("traceparent"), "-01"))
+			})
+
+			t.Run("grpc", func(t *testing.T) {
+				ctx := context.Background()
+				if tc.headerValue != "" {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(strings.ToLower(DebugTraceHeader), tc.headerValue))
+				}
+
+				var sampled bool
+				_, err := DebugTraceUnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+					_, span := StartSpan(ctx, "cerbos.svc.v1.CerbosService/CheckResources")
+					defer span.End()
+
+					sampled = span.SpanContext().IsSampled()
+					return nil, nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, tc.wantSampled, sampled)
+			})
+		})
+	}
+
+	t.Run("excluded_spans", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), debugTraceCtxKey, true)
+		tracer := mkExcludingProvider(provider, defaultExcludeSpanPrefixes).Tracer("test")
+
+		_, span := tracer.Start(ctx, "grpc.health.v1.Health/Check")
+		require.False(t, span.IsRecording())
+
+		_, span = tracer.Start(ctx, "cerbos.engine.Check")
+		require.True(t, span.SpanContext().IsSampled())
+	})
+}
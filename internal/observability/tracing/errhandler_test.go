@@ -0,0 +1,74 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+func TestOtelErrHandler(t *testing.T) {
+	testCases := []struct {
+		logLevel string
+		want     zapcore.Level
+	}{
+		{logLevel: "", want: zapcore.WarnLevel},
+		{logLevel: "warn", want: zapcore.WarnLevel},
+		{logLevel: "debug", want: zapcore.DebugLevel},
+		{logLevel: "ERROR", want: zapcore.ErrorLevel},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.want.String(), func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			handler := newOtelErrHandler(zap.New(core), ErrorHandlerConf{LogLevel: tc.logLevel})
+
+			handler.Handle(errors.New("unsupported sampler: ProbabilitySampler"))
+			require.Zero(t, logs.Len(), "Benign sampler error must be filtered")
+
+			handler.Handle(errors.New("export failed"))
+			entries := logs.AllUntimed()
+			require.Len(t, entries, 1)
+			require.Equal(t, tc.want, entries[0].Level)
+			require.Equal(t, "export failed", entries[0].ContextMap()["error"])
+		})
+	}
+
+	t.Run("below_logger_level", func(t *testing.T) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		handler := newOtelErrHandler(zap.New(core), ErrorHandlerConf{LogLevel: "debug"})
+
+		handler.Handle(errors.New("export failed"))
+		require.Zero(t, logs.Len())
+	})
+
+	t.Run("metric", func(t *testing.T) {
+		require.NoError(t, view.Register(metrics.TracingErrorCountView))
+		t.Cleanup(func() { view.Unregister(metrics.TracingErrorCountView) })
+
+		handler := newOtelErrHandler(zap.NewNop(), ErrorHandlerConf{Metric: true})
+		handler.Handle(errors.New("unsupported sampler: ProbabilitySampler"))
+		handler.Handle(errors.New("export failed"))
+		handler.Handle(errors.New("export failed"))
+
+		rows, err := view.RetrieveData(metrics.TracingErrorCountView.Name)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(2), rows[0].Data.(*view.CountData).Value)
+	})
+
+	t.Run("invalid_level", func(t *testing.T) {
+		conf := Conf{ErrorHandler: ErrorHandlerConf{LogLevel: "info"}}
+		require.Error(t, conf.Validate())
+	})
+}
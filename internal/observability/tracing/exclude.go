@@ -0,0 +1,70 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// mkExcludingProvider wraps tp with a provider that doesn't create the spans with names starting with any of the prefixes.
+// It returns tp unchanged if there are no prefixes.
+func mkExcludingProvider(tp *tracesdk.TracerProvider, prefixes []string) trace.TracerProvider {
+	if len(prefixes) == 0 {
+		return tp
+	}
+
+	return excludingProvider{TracerProvider: tp, prefixes: prefixes}
+}
+
+type excludingProvider struct {
+	*tracesdk.TracerProvider
+	prefixes []string
+}
+
+func (ep excludingProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
+	return excludingTracer{Tracer: ep.TracerProvider.Tracer(name, opts...), provider: ep}
+}
+
+type excludingTracer struct {
+	trace.Tracer
+	provider excludingProvider
+}
+
+// Start creates the span unless its name is excluded.
+// Instead of excluded spans, the context carries a placeholder with the span context of the parent. That way, the trace context
+// propagated to the downstream services and the parent of the spans started within the excluded span are the same as if the
+// excluded span didn't exist.
+func (et excludingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	for _, prefix := range et.provider.prefixes {
+		if strings.HasPrefix(name, prefix) {
+			span := excludedSpan{
+				Span:        trace.SpanFromContext(context.Background()),
+				spanContext: trace.SpanContextFromContext(ctx),
+				provider:    et.provider,
+			}
+			return trace.ContextWithSpan(ctx, span), span
+		}
+	}
+
+	return et.Tracer.Start(ctx, name, opts...)
+}
+
+// excludedSpan is a non-recording span that stands in for an excluded span.
+type excludedSpan struct {
+	trace.Span
+	spanContext trace.SpanContext
+	provider    trace.TracerProvider
+}
+
+func (s excludedSpan) SpanContext() trace.SpanContext {
+	return s.spanContext
+}
+
+func (s excludedSpan) TracerProvider() trace.TracerProvider {
+	return s.provider
+}
@@ -0,0 +1,73 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestExcludeSpanPrefixes(t *testing.T) {
+	spanNames := []string{"grpc.health.v1.Health/Check", "/api/playground/validate", "/admin/reload", "cerbos.svc.v1.CerbosService/CheckResources"}
+
+	testCases := []struct {
+		name         string
+		prefixes     []string
+		wantRecorded []string
+	}{
+		{name: "default", prefixes: nil, wantRecorded: []string{"/admin/reload", "cerbos.svc.v1.CerbosService/CheckResources"}},
+		{name: "empty", prefixes: []string{}, wantRecorded: spanNames},
+		{name: "custom", prefixes: []string{"/admin/"}, wantRecorded: []string{"grpc.health.v1.Health/Check", "/api/playground/validate", "cerbos.svc.v1.CerbosService/CheckResources"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(1, false, nil)), tracesdk.WithSpanProcessor(recorder))
+			t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+			c := Conf{ExcludeSpanPrefixes: tc.prefixes}
+			tracer := mkExcludingProvider(provider, c.excludeSpanPrefixes()).Tracer("test")
+			for _, name := range spanNames {
+				_, span := tracer.Start(context.Background(), name)
+				span.End()
+			}
+
+			haveRecorded := make([]string, len(recorder.Ended()))
+			for i, span := range recorder.Ended() {
+				haveRecorded[i] = span.Name()
+			}
+
+			require.Equal(t, tc.wantRecorded, haveRecorded)
+		})
+	}
+
+	t.Run("children_of_excluded_span", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(1, false, nil)), tracesdk.WithSpanProcessor(recorder))
+		t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+		tracer := mkExcludingProvider(provider, defaultExcludeSpanPrefixes).Tracer("test")
+
+		ctx, parent := tracer.Start(context.Background(), "cerbos.svc.v1.CerbosService/CheckResources")
+		excludedCtx, excluded := tracer.Start(ctx, "grpc.internal")
+		require.False(t, excluded.IsRecording())
+		require.Equal(t, parent.SpanContext(), excluded.SpanContext())
+
+		_, child := excluded.TracerProvider().Tracer("test").Start(excludedCtx, "cerbos.engine.Check")
+		child.End()
+		excluded.End()
+		parent.End()
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 2)
+		require.Equal(t, "cerbos.engine.Check", ended[0].Name())
+		require.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	})
+}
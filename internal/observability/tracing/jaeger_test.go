@@ -0,0 +1,106 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestJaegerOTLPConf(t *testing.T) {
+	testCases := []struct {
+		name    string
+		conf    JaegerConf
+		want    *OTLPConf
+		wantErr bool
+	}{
+		{
+			name: "agent",
+			conf: JaegerConf{AgentEndpoint: "jaeger-agent:6831"},
+			want: &OTLPConf{Protocol: otlpProtocolGRPC, CollectorEndpoint: "jaeger-agent:4317"},
+		},
+		{
+			name: "agent_takes_precedence",
+			conf: JaegerConf{AgentEndpoint: "jaeger-agent:6831", CollectorEndpoint: "http://jaeger:14268/api/traces"},
+			want: &OTLPConf{Protocol: otlpProtocolGRPC, CollectorEndpoint: "jaeger-agent:4317"},
+		},
+		{
+			name: "collector",
+			conf: JaegerConf{CollectorEndpoint: "http://jaeger:14268/api/traces"},
+			want: &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: "http://jaeger:4318/v1/traces"},
+		},
+		{
+			name: "collector_https",
+			conf: JaegerConf{CollectorEndpoint: "https://jaeger.example.com/api/traces?format=jaeger.thrift"},
+			want: &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: "https://jaeger.example.com:4318/v1/traces"},
+		},
+		{
+			name:    "invalid_agent",
+			conf:    JaegerConf{AgentEndpoint: "jaeger-agent"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_collector",
+			conf:    JaegerConf{CollectorEndpoint: "jaeger:14268"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := jaegerOTLPConf(&tc.conf)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func TestJaegerDeprecationWarning(t *testing.T) {
+	testCases := []struct {
+		name     string
+		useOTLP  bool
+		wantWarn bool
+	}{
+		{name: "native", useOTLP: false, wantWarn: true},
+		{name: "otlp", useOTLP: true, wantWarn: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.WarnLevel)
+			t.Cleanup(zap.ReplaceGlobals(zap.New(core)))
+
+			ctx := context.Background()
+			require.NoError(t, InitFromConf(ctx, Conf{
+				Exporter: jaegerExporter,
+				Jaeger:   &JaegerConf{AgentEndpoint: "localhost:6900", UseOTLP: tc.useOTLP},
+			}))
+			t.Cleanup(func() {
+				_ = Shutdown(ctx)
+				otel.SetTracerProvider(trace.NewNoopTracerProvider())
+			})
+
+			warnings := logs.FilterMessageSnippet("native Jaeger exporter is deprecated").Len()
+			if tc.wantWarn {
+				require.Equal(t, 1, warnings)
+			} else {
+				require.Zero(t, warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	otlpgrpc "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	otlphttp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	otlpProtocolGRPC = "grpc"
+	otlpProtocolHTTP = "http/protobuf"
+
+	otlpCompressionNone = "none"
+	otlpCompressionGzip = "gzip"
+
+	// Standard OpenTelemetry environment variables used when the corresponding settings are not in the configuration.
+	// The exporters read the rest of the OTEL_EXPORTER_OTLP_* variables (headers, compression, etc.) themselves.
+	envOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	envOTLPProtocol       = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	envOTLPTracesProtocol = "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"
+
+	// Default ports of the OTLP receiver of Jaeger.
+	jaegerOTLPGRPCPort = "4317"
+	jaegerOTLPHTTPPort = "4318"
+)
+
+func newOTLPExporter(ctx context.Context, otlpConf *OTLPConf) (*otlptrace.Exporter, error) {
+	ctx, cancelFn := otlpDialContext(ctx, otlpConf)
+	defer cancelFn()
+
+	client, err := newOTLPClient(ctx, otlpConf)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// jaegerOTLPConf derives the configuration for sending traces to the OTLP receiver of Jaeger from the Jaeger exporter configuration.
+// The agent endpoint maps to the gRPC receiver on the same host and the collector endpoint maps to the HTTP receiver on the same host.
+func jaegerOTLPConf(jc *JaegerConf) (*OTLPConf, error) {
+	if jc.AgentEndpoint != "" {
+		host, _, err := net.SplitHostPort(jc.AgentEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse agent endpoint %q: %w", jc.AgentEndpoint, err)
+		}
+
+		return &OTLPConf{Protocol: otlpProtocolGRPC, CollectorEndpoint: net.JoinHostPort(host, jaegerOTLPGRPCPort)}, nil
+	}
+
+	u, err := url.Parse(jc.CollectorEndpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("failed to parse collector endpoint %q: must be a URL", jc.CollectorEndpoint)
+	}
+
+	u.Host = net.JoinHostPort(u.Hostname(), jaegerOTLPHTTPPort)
+	u.Path = "/v1/traces"
+	u.RawQuery = ""
+
+	return &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: u.String()}, nil
+}
+
+// otlpDialContext returns the context for setting up the connection to the collector, which blocks if WaitForConnection is enabled.
+func otlpDialContext(ctx context.Context, otlpConf *OTLPConf) (context.Context, context.CancelFunc) {
+	if g := otlpConf.GRPC; g != nil && g.WaitForConnection {
+		return context.WithTimeout(ctx, g.dialTimeout())
+	}
+
+	return ctx, func() {}
+}
+
+// otlpEndpointFromEnv returns the collector endpoint set by the standard OpenTelemetry environment variables.
+func otlpEndpointFromEnv() string {
+	if e := os.Getenv(envOTLPTracesEndpoint); e != "" {
+		return e
+	}
+
+	return os.Getenv(envOTLPEndpoint)
+}
+
+// otlpConnSettings returns the compression and TLS configuration (nil if TLS is disabled) for connecting to the collector.
+// They are shared by the trace and metric exporters.
+func otlpConnSettings(otlpConf *OTLPConf) (string, *tls.Config, error) {
+	compression := otlpConf.compression()
+	if compression != otlpCompressionNone && compression != otlpCompressionGzip {
+		return "", nil, fmt.Errorf("unknown OTLP compression %q. Supported values are %q and %q", otlpConf.Compression, otlpCompressionNone, otlpCompressionGzip)
+	}
+
+	if !otlpConf.tlsEnabled() {
+		return compression, nil, nil
+	}
+
+	tlsConf, err := newOTLPTLSConfig(otlpConf.TLS)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create otlp TLS configuration: %w", err)
+	}
+
+	return compression, tlsConf, nil
+}
+
+// dialOTLPCollector creates the gRPC connection to the configured collector endpoint.
+func dialOTLPCollector(ctx context.Context, otlpConf *OTLPConf, tlsConf *tls.Config) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if tlsConf != nil {
+		creds = credentials.NewTLS(tlsConf)
+	}
+
+	dialOpts := append(otlpGRPCDialOptions(otlpConf.GRPC), grpc.WithTransportCredentials(creds))
+	conn, err := grpc.DialContext(ctx, otlpConf.CollectorEndpoint, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial otlp collector: %w", err)
+	}
+
+	return conn, nil
+}
+
+func newOTLPClient(ctx context.Context, otlpConf *OTLPConf) (otlptrace.Client, error) {
+	compression, tlsConf, err := otlpConnSettings(otlpConf)
+	if err != nil {
+		return nil, err
+	}
+
+	switch otlpConf.protocol() {
+	case otlpProtocolGRPC:
+		var opts []otlpgrpc.Option
+		if otlpConf.CollectorEndpoint == "" {
+			// The exporter resolves the endpoint from the environment and dials the collector when it starts.
+			switch {
+			case tlsConf != nil:
+				opts = append(opts, otlpgrpc.WithTLSCredentials(credentials.NewTLS(tlsConf)))
+			case otlpConf.forceInsecure():
+				opts = append(opts, otlpgrpc.WithInsecure())
+			}
+
+			if dialOpts := otlpGRPCDialOptions(otlpConf.GRPC); len(dialOpts) > 0 {
+				opts = append(opts, otlpgrpc.WithDialOption(dialOpts...))
+			}
+		} else {
+			conn, err := dialOTLPCollector(ctx, otlpConf, tlsConf)
+			if err != nil {
+				return nil, err
+			}
+
+			opts = append(opts, otlpgrpc.WithGRPCConn(conn))
+		}
+
+		if len(otlpConf.Headers) > 0 {
+			opts = append(opts, otlpgrpc.WithHeaders(otlpConf.Headers))
+		}
+		if compression == otlpCompressionGzip {
+			opts = append(opts, otlpgrpc.WithCompressor(gzip.Name))
+		}
+
+		return otlpgrpc.NewClient(opts...), nil
+
+	case otlpProtocolHTTP:
+		opts, err := otlpHTTPOptions(otlpConf.CollectorEndpoint, tlsConf)
+		if err != nil {
+			return nil, err
+		}
+		if otlpConf.CollectorEndpoint == "" && tlsConf == nil && otlpConf.forceInsecure() {
+			opts = append(opts, otlphttp.WithInsecure())
+		}
+
+		if len(otlpConf.Headers) > 0 {
+			opts = append(opts, otlphttp.WithHeaders(otlpConf.Headers))
+		}
+		if compression == otlpCompressionGzip {
+			opts = append(opts, otlphttp.WithCompression(otlphttp.GzipCompression))
+		}
+
+		return otlphttp.NewClient(opts...), nil
+
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol %q. Supported protocols are %q and %q", otlpConf.protocol(), otlpProtocolGRPC, otlpProtocolHTTP)
+	}
+}
+
+// otlpGRPCDialOptions converts the gRPC connection settings to dial options.
+func otlpGRPCDialOptions(grpcConf *OTLPGRPCConf) []grpc.DialOption {
+	if grpcConf == nil {
+		return nil
+	}
+
+	var opts []grpc.DialOption
+	if grpcConf.WaitForConnection {
+		opts = append(opts, grpc.WithBlock(), grpc.WithReturnConnectionError())
+	}
+
+	if ka := grpcConf.Keepalive; ka != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                ka.Time,
+			Timeout:             ka.Timeout,
+			PermitWithoutStream: ka.PermitWithoutStream,
+		}))
+	}
+
+	return opts
+}
+
+// otlpHTTPEndpoint is the collector endpoint for the http/protobuf protocol.
+type otlpHTTPEndpoint struct {
+	host   string
+	path   string
+	secure bool
+}
+
+// parseOTLPHTTPEndpoint parses the collector endpoint, which is either a host and port, or a URL such as https://otel:4318/v1/traces
+// that determines the scheme and the path as well.
+// The connection is encrypted if the scheme is https or, for endpoints without a scheme, if TLS is configured.
+func parseOTLPHTTPEndpoint(endpoint string, tlsConf *tls.Config) (otlpHTTPEndpoint, error) {
+	e := otlpHTTPEndpoint{host: endpoint, secure: tlsConf != nil}
+	if !strings.Contains(endpoint, "://") {
+		return e, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return e, fmt.Errorf("invalid otlp collector endpoint %q: %w", endpoint, err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		e.secure = true
+	case "http":
+		if e.secure {
+			return e, fmt.Errorf("otlp collector endpoint %q uses the http scheme but TLS is configured", endpoint)
+		}
+	default:
+		return e, fmt.Errorf("invalid otlp collector endpoint %q: scheme must be http or https", endpoint)
+	}
+
+	e.host = u.Host
+	if u.Path != "/" {
+		e.path = u.Path
+	}
+
+	return e, nil
+}
+
+// otlpHTTPOptions converts the collector endpoint to HTTP exporter options.
+// If the endpoint is empty, the exporter resolves it from the environment.
+func otlpHTTPOptions(endpoint string, tlsConf *tls.Config) ([]otlphttp.Option, error) {
+	if endpoint == "" {
+		if tlsConf != nil {
+			return []otlphttp.Option{otlphttp.WithTLSClientConfig(tlsConf)}, nil
+		}
+
+		return nil, nil
+	}
+
+	e, err := parseOTLPHTTPEndpoint(endpoint, tlsConf)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []otlphttp.Option
+	if e.path != "" {
+		opts = append(opts, otlphttp.WithURLPath(e.path))
+	}
+
+	opts = append(opts, otlphttp.WithEndpoint(e.host))
+
+	switch {
+	case !e.secure:
+		opts = append(opts, otlphttp.WithInsecure())
+	case tlsConf != nil:
+		opts = append(opts, otlphttp.WithTLSClientConfig(tlsConf))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,330 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	otlpgrpc "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	otlphttp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestOTLPClientProtocol(t *testing.T) {
+	ctx := context.Background()
+
+	grpcClient, err := newOTLPClient(ctx, &OTLPConf{CollectorEndpoint: "localhost:4317"})
+	require.NoError(t, err)
+	require.IsType(t, otlpgrpc.NewClient(), grpcClient)
+
+	httpClient, err := newOTLPClient(ctx, &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: "localhost:4318"})
+	require.NoError(t, err)
+	require.IsType(t, otlphttp.NewClient(), httpClient)
+
+	_, err = newOTLPClient(ctx, &OTLPConf{Protocol: "carrier-pigeon", CollectorEndpoint: "localhost:4318"})
+	require.Error(t, err)
+}
+
+func TestOTLPHTTPEndpoint(t *testing.T) {
+	type request struct {
+		method      string
+		path        string
+		contentType string
+		tenant      string
+	}
+
+	requests := make(chan request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- request{method: r.Method, path: r.URL.Path, contentType: r.Header.Get("Content-Type"), tenant: r.Header.Get("X-Scope-OrgID")}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	exportSpan(t, &OTLPConf{
+		Protocol:          otlpProtocolHTTP,
+		CollectorEndpoint: srv.URL + "/custom/v1/traces",
+		Headers:           map[string]string{"X-Scope-OrgID": "tenant-1"},
+	})
+
+	have := <-requests
+	require.Equal(t, http.MethodPost, have.method)
+	require.Equal(t, "/custom/v1/traces", have.path)
+	require.Equal(t, "application/x-protobuf", have.contentType)
+	require.Equal(t, "tenant-1", have.tenant)
+}
+
+func TestOTLPGRPCHeaders(t *testing.T) {
+	collector, addr := startTraceCollector(t)
+
+	exportSpan(t, &OTLPConf{
+		CollectorEndpoint: addr,
+		Headers:           map[string]string{"X-Scope-OrgID": "tenant-1"},
+	})
+
+	require.Equal(t, []string{"tenant-1"}, <-collector.tenants)
+}
+
+func TestOTLPCompression(t *testing.T) {
+	encodings := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings <- r.Header.Get("Content-Encoding")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	testCases := []struct {
+		compression  string
+		wantEncoding string
+	}{
+		{compression: "", wantEncoding: ""},
+		{compression: otlpCompressionNone, wantEncoding: ""},
+		{compression: otlpCompressionGzip, wantEncoding: "gzip"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.compression, func(t *testing.T) {
+			exportSpan(t, &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: srv.URL, Compression: tc.compression})
+			require.Equal(t, tc.wantEncoding, <-encodings)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := newOTLPClient(context.Background(), &OTLPConf{CollectorEndpoint: "localhost:4317", Compression: "zstd"})
+		require.ErrorContains(t, err, `unknown OTLP compression "zstd"`)
+	})
+}
+
+func TestOTLPEnvFallback(t *testing.T) {
+	type request struct {
+		path   string
+		tenant string
+	}
+
+	requests := make(chan request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- request{path: r.URL.Path, tenant: r.Header.Get("X-Scope-OrgID")}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Run("http_from_env", func(t *testing.T) {
+		t.Setenv(envOTLPEndpoint, srv.URL)
+		t.Setenv(envOTLPProtocol, otlpProtocolHTTP)
+		t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "X-Scope-OrgID=tenant-env")
+
+		exportSpan(t, &OTLPConf{})
+
+		have := <-requests
+		require.Equal(t, "/v1/traces", have.path)
+		require.Equal(t, "tenant-env", have.tenant)
+	})
+
+	t.Run("config_wins", func(t *testing.T) {
+		t.Setenv(envOTLPEndpoint, "http://127.0.0.1:1")
+		t.Setenv(envOTLPProtocol, otlpProtocolGRPC)
+		t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "X-Scope-OrgID=tenant-env")
+
+		exportSpan(t, &OTLPConf{
+			Protocol:          otlpProtocolHTTP,
+			CollectorEndpoint: srv.URL + "/custom/v1/traces",
+			Headers:           map[string]string{"X-Scope-OrgID": "tenant-conf"},
+		})
+
+		have := <-requests
+		require.Equal(t, "/custom/v1/traces", have.path)
+		require.Equal(t, "tenant-conf", have.tenant)
+	})
+
+	t.Run("grpc_from_env", func(t *testing.T) {
+		collector, addr := startTraceCollector(t)
+
+		t.Setenv(envOTLPTracesEndpoint, "http://"+addr)
+		t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "X-Scope-OrgID=tenant-env")
+
+		exportSpan(t, &OTLPConf{})
+		require.Equal(t, []string{"tenant-env"}, <-collector.tenants)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		c := Conf{Exporter: otlpExporter}
+		require.ErrorIs(t, c.Validate(), errOTLPConfigUndefined)
+
+		t.Setenv(envOTLPEndpoint, "http://otel:4318")
+		t.Setenv(envOTLPProtocol, otlpProtocolHTTP)
+		require.NoError(t, c.Validate())
+		require.Equal(t, otlpProtocolHTTP, (&OTLPConf{}).protocol())
+
+		t.Setenv(envOTLPProtocol, "http/json")
+		require.ErrorContains(t, c.Validate(), `invalid tracing.otlp.protocol "http/json"`)
+	})
+}
+
+func TestOTLPGRPCConnection(t *testing.T) {
+	grpcConf := &OTLPGRPCConf{
+		WaitForConnection: true,
+		DialTimeout:       200 * time.Millisecond,
+		Keepalive:         &OTLPKeepaliveConf{Time: 30 * time.Second, Timeout: 5 * time.Second, PermitWithoutStream: true},
+	}
+
+	t.Run("connected", func(t *testing.T) {
+		collector, addr := startTraceCollector(t)
+
+		exportSpan(t, &OTLPConf{CollectorEndpoint: addr, Headers: map[string]string{"X-Scope-OrgID": "tenant-1"}, GRPC: grpcConf})
+		require.Equal(t, []string{"tenant-1"}, <-collector.tenants)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		err := InitFromConf(context.Background(), Conf{
+			Exporter: otlpExporter,
+			OTLP:     &OTLPConf{CollectorEndpoint: closedAddr(t), GRPC: grpcConf},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("unreachable_from_env", func(t *testing.T) {
+		t.Setenv(envOTLPEndpoint, "http://"+closedAddr(t))
+
+		err := InitFromConf(context.Background(), Conf{
+			Exporter: otlpExporter,
+			OTLP:     &OTLPConf{GRPC: grpcConf},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("unreachable_without_waiting", func(t *testing.T) {
+		ctx := context.Background()
+		require.NoError(t, InitFromConf(ctx, Conf{
+			Exporter: otlpExporter,
+			OTLP:     &OTLPConf{CollectorEndpoint: closedAddr(t), GRPC: &OTLPGRPCConf{DialTimeout: 200 * time.Millisecond}},
+		}))
+		t.Cleanup(func() {
+			_ = Shutdown(ctx)
+			otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		})
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		testCases := []struct {
+			grpcConf *OTLPGRPCConf
+			wantErr  error
+		}{
+			{grpcConf: grpcConf},
+			{grpcConf: &OTLPGRPCConf{DialTimeout: -time.Second}, wantErr: errInvalidDialTimeout},
+			{grpcConf: &OTLPGRPCConf{Keepalive: &OTLPKeepaliveConf{}}, wantErr: errInvalidKeepalive},
+			{grpcConf: &OTLPGRPCConf{Keepalive: &OTLPKeepaliveConf{Time: time.Second, Timeout: -time.Second}}, wantErr: errInvalidKeepalive},
+		}
+
+		for _, tc := range testCases {
+			c := Conf{Exporter: otlpExporter, OTLP: &OTLPConf{CollectorEndpoint: "otel:4317", GRPC: tc.grpcConf}}
+			if tc.wantErr == nil {
+				require.NoError(t, c.Validate())
+			} else {
+				require.ErrorIs(t, c.Validate(), tc.wantErr)
+			}
+		}
+	})
+}
+
+func exportSpan(t *testing.T, otlpConf *OTLPConf) {
+	t.Helper()
+
+	ctx := context.Background()
+	client, err := newOTLPClient(ctx, otlpConf)
+	require.NoError(t, err)
+
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSyncer(exporter))
+	_, span := tp.Tracer("test").Start(ctx, "span")
+	span.End()
+	require.NoError(t, tp.Shutdown(ctx))
+}
+
+// closedAddr returns the address of a TCP port that nothing listens on.
+func closedAddr(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	return l.Addr().String()
+}
+
+func startTraceCollector(t *testing.T) (*traceCollector, string) {
+	t.Helper()
+
+	collector := &traceCollector{tenants: make(chan []string, 1)}
+	srv := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(srv, collector)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(srv.Stop)
+
+	return collector, l.Addr().String()
+}
+
+type traceCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+	tenants chan []string
+}
+
+func (tc *traceCollector) Export(ctx context.Context, _ *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tc.tenants <- md.Get("X-Scope-OrgID")
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func TestOTLPHTTPOptions(t *testing.T) {
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	testCases := []struct {
+		name       string
+		endpoint   string
+		tlsConf    *tls.Config
+		wantErr    string
+		wantSecure bool
+	}{
+		{name: "host_port", endpoint: "otel:4318"},
+		{name: "host_port_tls", endpoint: "otel:4318", tlsConf: tlsConf, wantSecure: true},
+		{name: "http_url", endpoint: "http://otel:4318/v1/traces"},
+		{name: "https_url", endpoint: "https://otel:4318", wantSecure: true},
+		{name: "https_url_tls", endpoint: "https://otel:4318", tlsConf: tlsConf, wantSecure: true},
+		{name: "http_url_tls", endpoint: "http://otel:4318", tlsConf: tlsConf, wantErr: "uses the http scheme but TLS is configured"},
+		{name: "unsupported_scheme", endpoint: "ftp://otel:4318", wantErr: "scheme must be http or https"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := otlpHTTPOptions(tc.endpoint, tc.tlsConf)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			e, err := parseOTLPHTTPEndpoint(tc.endpoint, tc.tlsConf)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantSecure, e.secure)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	ocbridge "go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	otlpTracesURLPath  = "/v1/traces"
+	otlpMetricsURLPath = "/v1/metrics"
+)
+
+var meterProvider atomic.Pointer[metricsdk.MeterProvider]
+
+// configureOTLPMetrics periodically exports the metrics recorded by Cerbos to the OTLP collector.
+// The metrics are recorded using OpenCensus, so they are read through the OpenCensus bridge.
+func configureOTLPMetrics(ctx context.Context, otlpConf *OTLPConf, res *resource.Resource) error {
+	exporter, err := newOTLPMetricExporter(ctx, otlpConf)
+	if err != nil {
+		return err
+	}
+
+	reader := metricsdk.NewPeriodicReader(exporter,
+		metricsdk.WithInterval(otlpConf.Metrics.exportInterval()),
+		metricsdk.WithProducer(ocbridge.NewMetricProducer()),
+	)
+
+	meterProvider.Store(metricsdk.NewMeterProvider(metricsdk.WithReader(reader), metricsdk.WithResource(res)))
+	return nil
+}
+
+// newOTLPMetricExporter creates a metric exporter with the same connection settings as the trace exporter.
+func newOTLPMetricExporter(ctx context.Context, otlpConf *OTLPConf) (metricsdk.Exporter, error) {
+	ctx, cancelFn := otlpDialContext(ctx, otlpConf)
+	defer cancelFn()
+
+	compression, tlsConf, err := otlpConnSettings(otlpConf)
+	if err != nil {
+		return nil, err
+	}
+
+	var exporter metricsdk.Exporter
+	switch otlpConf.protocol() {
+	case otlpProtocolGRPC:
+		var opts []otlpmetricgrpc.Option
+		if otlpConf.CollectorEndpoint == "" {
+			switch {
+			case tlsConf != nil:
+				opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(tlsConf)))
+			case otlpConf.forceInsecure():
+				opts = append(opts, otlpmetricgrpc.WithInsecure())
+			}
+
+			if dialOpts := otlpGRPCDialOptions(otlpConf.GRPC); len(dialOpts) > 0 {
+				opts = append(opts, otlpmetricgrpc.WithDialOption(dialOpts...))
+			}
+		} else {
+			conn, err := dialOTLPCollector(ctx, otlpConf, tlsConf)
+			if err != nil {
+				return nil, err
+			}
+
+			opts = append(opts, otlpmetricgrpc.WithGRPCConn(conn))
+		}
+
+		if len(otlpConf.Headers) > 0 {
+			opts = append(opts, otlpmetricgrpc.WithHeaders(otlpConf.Headers))
+		}
+		if compression == otlpCompressionGzip {
+			opts = append(opts, otlpmetricgrpc.WithCompressor(gzip.Name))
+		}
+
+		exporter, err = otlpmetricgrpc.New(ctx, opts...)
+
+	case otlpProtocolHTTP:
+		var opts []otlpmetrichttp.Option
+		if otlpConf.CollectorEndpoint == "" {
+			switch {
+			case tlsConf != nil:
+				opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsConf))
+			case otlpConf.forceInsecure():
+				opts = append(opts, otlpmetrichttp.WithInsecure())
+			}
+		} else {
+			e, err := parseOTLPHTTPEndpoint(otlpConf.CollectorEndpoint, tlsConf)
+			if err != nil {
+				return nil, err
+			}
+
+			opts = append(opts, otlpmetrichttp.WithEndpoint(e.host), otlpmetrichttp.WithURLPath(otlpMetricsPath(e.path)))
+			switch {
+			case !e.secure:
+				opts = append(opts, otlpmetrichttp.WithInsecure())
+			case tlsConf != nil:
+				opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsConf))
+			}
+		}
+
+		if len(otlpConf.Headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(otlpConf.Headers))
+		}
+		if compression == otlpCompressionGzip {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		}
+
+		exporter, err = otlpmetrichttp.New(ctx, opts...)
+
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol %q. Supported protocols are %q and %q", otlpConf.protocol(), otlpProtocolGRPC, otlpProtocolHTTP)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp metric exporter: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// otlpMetricsPath returns the URL path to send metrics to, given the URL path of the collector endpoint used for traces.
+// If the traces path ends with /v1/traces, the metrics are sent to /v1/metrics under the same prefix. Otherwise, the default path is used.
+func otlpMetricsPath(tracesPath string) string {
+	if prefix, ok := strings.CutSuffix(tracesPath, otlpTracesURLPath); ok {
+		return prefix + otlpMetricsURLPath
+	}
+
+	return otlpMetricsURLPath
+}
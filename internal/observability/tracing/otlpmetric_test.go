@@ -0,0 +1,163 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOTLPMetrics(t *testing.T) {
+	requestCount := stats.Int64("cerbos_test_otlp_request_count", "Number of requests", stats.UnitDimensionless)
+	requestCountView := &view.View{Name: requestCount.Name(), Measure: requestCount, Aggregation: view.Count()}
+	require.NoError(t, view.Register(requestCountView))
+	t.Cleanup(func() { view.Unregister(requestCountView) })
+
+	export := func(t *testing.T, otlpConf *OTLPConf) {
+		t.Helper()
+
+		otlpConf.Headers = map[string]string{"X-Scope-OrgID": "tenant-1"}
+		otlpConf.Metrics = &OTLPMetricsConf{Enabled: true, ExportInterval: time.Hour}
+
+		ctx := context.Background()
+		require.NoError(t, InitFromConf(ctx, Conf{Exporter: otlpExporter, OTLP: otlpConf}))
+		t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+		stats.Record(ctx, requestCount.M(1))
+		// Measurements are aggregated asynchronously.
+		require.Eventually(t, func() bool {
+			rows, err := view.RetrieveData(requestCountView.Name)
+			return err == nil && len(rows) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// The final metrics are exported on shutdown.
+		require.NoError(t, Shutdown(ctx))
+	}
+
+	t.Run("grpc", func(t *testing.T) {
+		collector, addr := startMetricsCollector(t)
+
+		export(t, &OTLPConf{CollectorEndpoint: addr})
+
+		have := <-collector.exports
+		require.Equal(t, []string{"tenant-1"}, have.tenants)
+		require.Contains(t, have.metrics, requestCount.Name())
+	})
+
+	t.Run("http", func(t *testing.T) {
+		exports := make(chan metricsExport, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/otlp/v1/metrics" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			req := &collectormetrics.ExportMetricsServiceRequest{}
+			if err := proto.Unmarshal(body, req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			exports <- newMetricsExport(r.Header.Values("X-Scope-OrgID"), req)
+			w.Header().Set("Content-Type", "application/x-protobuf")
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(srv.Close)
+
+		export(t, &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: srv.URL + "/otlp/v1/traces"})
+
+		have := <-exports
+		require.Equal(t, []string{"tenant-1"}, have.tenants)
+		require.Contains(t, have.metrics, requestCount.Name())
+	})
+
+	t.Run("requires_otlp_exporter", func(t *testing.T) {
+		c := Conf{
+			Exporter: stdoutExporter,
+			OTLP:     &OTLPConf{CollectorEndpoint: "otel:4317", Metrics: &OTLPMetricsConf{Enabled: true}},
+		}
+		require.ErrorIs(t, c.Validate(), errOTLPMetricsWithoutExporter)
+	})
+}
+
+func TestOTLPMetricsPath(t *testing.T) {
+	testCases := []struct {
+		tracesPath string
+		want       string
+	}{
+		{tracesPath: "", want: "/v1/metrics"},
+		{tracesPath: "/v1/traces", want: "/v1/metrics"},
+		{tracesPath: "/otlp/v1/traces", want: "/otlp/v1/metrics"},
+		{tracesPath: "/ingest", want: "/v1/metrics"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, otlpMetricsPath(tc.tracesPath), "Unexpected metrics path for %q", tc.tracesPath)
+	}
+}
+
+type metricsExport struct {
+	tenants []string
+	metrics []string
+}
+
+func newMetricsExport(tenants []string, req *collectormetrics.ExportMetricsServiceRequest) metricsExport {
+	e := metricsExport{tenants: tenants}
+	for _, rm := range req.ResourceMetrics {
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				e.metrics = append(e.metrics, m.Name)
+			}
+		}
+	}
+
+	return e
+}
+
+func startMetricsCollector(t *testing.T) (*metricsCollector, string) {
+	t.Helper()
+
+	collector := &metricsCollector{exports: make(chan metricsExport, 1)}
+	srv := grpc.NewServer()
+	collectormetrics.RegisterMetricsServiceServer(srv, collector)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(srv.Stop)
+
+	return collector, l.Addr().String()
+}
+
+type metricsCollector struct {
+	collectormetrics.UnimplementedMetricsServiceServer
+	exports chan metricsExport
+}
+
+func (mc *metricsCollector) Export(ctx context.Context, req *collectormetrics.ExportMetricsServiceRequest) (*collectormetrics.ExportMetricsServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	mc.exports <- newMetricsExport(md.Get("X-Scope-OrgID"), req)
+	return &collectormetrics.ExportMetricsServiceResponse{}, nil
+}
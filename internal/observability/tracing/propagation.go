@@ -0,0 +1,66 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/contrib/propagators/autoprop"
+	otelpropb3 "go.opentelemetry.io/contrib/propagators/b3"
+	otelprop "go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	b3PropagatorName = "b3"
+
+	b3InjectEncodingSingle = "single"
+	b3InjectEncodingMulti  = "multi"
+)
+
+// mkPropagator creates a composite propagator from the named propagators.
+// If no names are given, trace context, baggage and b3 are used unless the OTEL_PROPAGATORS environment variable overrides them.
+// The b3 propagator injects the headers selected by b3Encoding and extracts both encodings.
+func mkPropagator(names []string, b3Encoding string) (otelprop.TextMapPropagator, error) {
+	b3, err := mkB3Propagator(b3Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return autoprop.NewTextMapPropagator(otelprop.TraceContext{}, otelprop.Baggage{}, b3), nil
+	}
+
+	props := make([]otelprop.TextMapPropagator, len(names))
+	for i, name := range names {
+		if name == b3PropagatorName {
+			props[i] = b3
+			continue
+		}
+
+		p, err := autoprop.TextMapPropagator(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tracing.propagators: %w", err)
+		}
+		props[i] = p
+	}
+
+	if len(props) == 1 {
+		return props[0], nil
+	}
+
+	return otelprop.NewCompositeTextMapPropagator(props...), nil
+}
+
+func mkB3Propagator(encoding string) (otelprop.TextMapPropagator, error) {
+	switch encoding {
+	case "":
+		return otelpropb3.New(), nil
+	case b3InjectEncodingSingle:
+		return otelpropb3.New(otelpropb3.WithInjectEncoding(otelpropb3.B3SingleHeader)), nil
+	case b3InjectEncodingMulti:
+		return otelpropb3.New(otelpropb3.WithInjectEncoding(otelpropb3.B3MultipleHeader)), nil
+	default:
+		return nil, fmt.Errorf("invalid tracing.b3InjectEncoding %q: valid values are %s and %s", encoding, b3InjectEncodingSingle, b3InjectEncodingMulti)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	otelprop "go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMkPropagator(t *testing.T) {
+	testCases := []struct {
+		name       string
+		names      []string
+		wantFields []string
+		wantErr    bool
+	}{
+		{
+			name:       "default",
+			wantFields: []string{"traceparent", "tracestate", "baggage", "x-b3-traceid", "x-b3-spanid", "x-b3-sampled", "x-b3-flags"},
+		},
+		{
+			name:       "tracecontext",
+			names:      []string{"tracecontext"},
+			wantFields: []string{"traceparent", "tracestate"},
+		},
+		{
+			name:       "tracecontext_and_jaeger",
+			names:      []string{"tracecontext", "jaeger"},
+			wantFields: []string{"traceparent", "tracestate", "uber-trace-id"},
+		},
+		{
+			name:    "unknown",
+			names:   []string{"tracecontext", "carrier-pigeon"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("OTEL_PROPAGATORS", "")
+
+			have, err := mkPropagator(tc.names, "")
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.wantFields, have.Fields())
+		})
+	}
+}
+
+func TestMkPropagatorB3Encoding(t *testing.T) {
+	traceID := trace.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	spanID := trace.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+
+	testCases := []struct {
+		encoding    string
+		wantHeaders []string
+		wantErr     bool
+	}{
+		{encoding: "", wantHeaders: []string{"b3"}},
+		{encoding: "single", wantHeaders: []string{"b3"}},
+		{encoding: "multi", wantHeaders: []string{"x-b3-traceid", "x-b3-spanid", "x-b3-sampled"}},
+		{encoding: "both", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.encoding, func(t *testing.T) {
+			have, err := mkPropagator([]string{"b3"}, tc.encoding)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			carrier := otelprop.MapCarrier{}
+			have.Inject(trace.ContextWithSpanContext(context.Background(), sc), carrier)
+			require.ElementsMatch(t, tc.wantHeaders, carrier.Keys())
+
+			for name, headers := range map[string]otelprop.MapCarrier{
+				"single": {"b3": "0102030405060708090a0b0c0d0e0f10-0102030405060708-1"},
+				"multi":  {"x-b3-traceid": "0102030405060708090a0b0c0d0e0f10", "x-b3-spanid": "0102030405060708", "x-b3-sampled": "1"},
+			} {
+				extracted := trace.SpanContextFromContext(have.Extract(context.Background(), headers))
+				require.Equal(t, traceID, extracted.TraceID(), "Failed to extract %s encoding", name)
+				require.Equal(t, spanID, extracted.SpanID(), "Failed to extract %s encoding", name)
+			}
+		})
+	}
+}
+
+func TestTraceStatePropagation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(0.5, false, nil)), tracesdk.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(mkExcludingProvider(provider, defaultExcludeSpanPrefixes))
+	otel.SetTextMapPropagator(otelprop.TraceContext{})
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(otelprop.NewCompositeTextMapPropagator())
+		excludeHTTPPaths = nil
+	})
+
+	const (
+		// The ratio sampler would sample the low trace ID and drop the high one, so the decision of the parent must win for the test to pass.
+		lowTraceID  = "00000000000000000000000000000001"
+		highTraceID = "0000000000000000ffffffffffffffff"
+		parentID    = "00f067aa0ba902b7"
+		traceState  = "vendor=hint,other=1"
+	)
+
+	testCases := []struct {
+		name             string
+		traceparent      string
+		operation        string
+		excludePaths     []string
+		wantSampled      bool
+		wantRecorded     bool
+		wantParentSpanID bool
+	}{
+		{
+			name:         "sampled_parent",
+			traceparent:  "00-" + highTraceID + "-" + parentID + "-01",
+			operation:    "/api",
+			wantSampled:  true,
+			wantRecorded: true,
+		},
+		{
+			name:        "unsampled_parent",
+			traceparent: "00-" + lowTraceID + "-" + parentID + "-00",
+			operation:   "/api",
+		},
+		{
+			name:             "excluded_span",
+			traceparent:      "00-" + highTraceID + "-" + parentID + "-01",
+			operation:        "/api/playground/",
+			wantSampled:      true,
+			wantParentSpanID: true,
+		},
+		{
+			name:             "excluded_span_unsampled_parent",
+			traceparent:      "00-" + lowTraceID + "-" + parentID + "-00",
+			operation:        "/api/playground/",
+			wantParentSpanID: true,
+		},
+		{
+			name:             "excluded_path",
+			traceparent:      "00-" + highTraceID + "-" + parentID + "-01",
+			operation:        "/api",
+			excludePaths:     []string{"/api/playground/"},
+			wantSampled:      true,
+			wantParentSpanID: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			excludeHTTPPaths = tc.excludePaths
+			numSpans := len(recorder.Ended())
+
+			outbound := otelprop.MapCarrier{}
+			handler := HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				otel.GetTextMapPropagator().Inject(r.Context(), outbound)
+				w.WriteHeader(http.StatusOK)
+			}), tc.operation)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/playground/validate", http.NoBody)
+			req.Header.Set("traceparent", tc.traceparent)
+			req.Header.Set("tracestate", traceState)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			inbound := otelprop.TraceContext{}.Extract(context.Background(), otelprop.HeaderCarrier(req.Header))
+			have := trace.SpanContextFromContext(otelprop.TraceContext{}.Extract(context.Background(), outbound))
+			want := trace.SpanContextFromContext(inbound)
+
+			require.Equal(t, traceState, outbound.Get("tracestate"))
+			require.Equal(t, want.TraceID(), have.TraceID())
+			require.Equal(t, want.TraceState(), have.TraceState())
+			require.Equal(t, tc.wantSampled, have.IsSampled())
+			require.Equal(t, tc.wantParentSpanID, have.SpanID() == want.SpanID())
+
+			if tc.wantRecorded {
+				require.Len(t, recorder.Ended(), numSpans+1)
+			} else {
+				require.Len(t, recorder.Ended(), numSpans)
+			}
+		})
+	}
+}
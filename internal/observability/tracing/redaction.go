@@ -0,0 +1,109 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel/attribute"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// mkRedactionProcessor wraps next with a processor that redacts the attributes configured in rc.
+// It returns next unchanged if there's nothing to redact.
+func mkRedactionProcessor(next tracesdk.SpanProcessor, rc *RedactionConf) tracesdk.SpanProcessor {
+	if rc == nil || (len(rc.DropAttributes) == 0 && len(rc.HashAttributes) == 0) {
+		return next
+	}
+
+	rp := redactionProcessor{
+		next: next,
+		drop: make(map[attribute.Key]struct{}, len(rc.DropAttributes)),
+		hash: make(map[attribute.Key]struct{}, len(rc.HashAttributes)),
+	}
+
+	for _, k := range rc.DropAttributes {
+		rp.drop[attribute.Key(k)] = struct{}{}
+	}
+
+	for _, k := range rc.HashAttributes {
+		rp.hash[attribute.Key(k)] = struct{}{}
+	}
+
+	return rp
+}
+
+var _ tracesdk.SpanProcessor = redactionProcessor{}
+
+// redactionProcessor removes or hashes the configured attributes of the finished spans before passing them to the next processor.
+// The attributes are redacted when the span ends rather than when it starts so that the attributes set by SetAttributes are covered as well.
+type redactionProcessor struct {
+	next tracesdk.SpanProcessor
+	drop map[attribute.Key]struct{}
+	hash map[attribute.Key]struct{}
+}
+
+func (rp redactionProcessor) OnStart(parent context.Context, s tracesdk.ReadWriteSpan) {
+	rp.next.OnStart(parent, s)
+}
+
+func (rp redactionProcessor) OnEnd(s tracesdk.ReadOnlySpan) {
+	if attrs, ok := rp.redact(s.Attributes()); ok {
+		s = redactedSpan{ReadOnlySpan: s, attributes: attrs}
+	}
+
+	rp.next.OnEnd(s)
+}
+
+func (rp redactionProcessor) Shutdown(ctx context.Context) error {
+	return rp.next.Shutdown(ctx)
+}
+
+func (rp redactionProcessor) ForceFlush(ctx context.Context) error {
+	return rp.next.ForceFlush(ctx)
+}
+
+// redact returns a copy of attrs with the configured attributes removed or hashed. It returns false if none of the attributes had to be redacted.
+func (rp redactionProcessor) redact(attrs []attribute.KeyValue) ([]attribute.KeyValue, bool) {
+	var redacted []attribute.KeyValue
+	for i, kv := range attrs {
+		_, drop := rp.drop[kv.Key]
+		_, hash := rp.hash[kv.Key]
+		if !drop && !hash {
+			if redacted != nil {
+				redacted = append(redacted, kv)
+			}
+			continue
+		}
+
+		if redacted == nil {
+			redacted = make([]attribute.KeyValue, i, len(attrs))
+			copy(redacted, attrs[:i])
+		}
+
+		if hash {
+			redacted = append(redacted, hashAttribute(kv))
+		}
+	}
+
+	return redacted, redacted != nil
+}
+
+// hashAttribute replaces the value of the attribute with the hex-encoded SHA-256 digest of its string representation.
+func hashAttribute(kv attribute.KeyValue) attribute.KeyValue {
+	sum := sha256.Sum256([]byte(kv.Value.Emit()))
+	return kv.Key.String(hex.EncodeToString(sum[:]))
+}
+
+// redactedSpan overrides the attributes of a finished span.
+type redactedSpan struct {
+	tracesdk.ReadOnlySpan
+	attributes []attribute.KeyValue
+}
+
+func (s redactedSpan) Attributes() []attribute.KeyValue {
+	return s.attributes
+}
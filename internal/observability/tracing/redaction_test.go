@@ -0,0 +1,83 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRedactionProcessor(t *testing.T) {
+	sha256Hex := func(v string) string {
+		sum := sha256.Sum256([]byte(v))
+		return hex.EncodeToString(sum[:])
+	}
+
+	exportSpan := func(t *testing.T, rc *RedactionConf) []attribute.KeyValue {
+		t.Helper()
+
+		exporter := tracetest.NewInMemoryExporter()
+		provider := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(mkRedactionProcessor(tracesdk.NewSimpleSpanProcessor(exporter), rc)))
+		t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+		_, span := provider.Tracer("test").Start(context.Background(), "engine.Evaluate", trace.WithAttributes(RequestID("req-1"), ResourceKind("payment")))
+		// Attributes set after the span has started must be redacted as well.
+		span.SetAttributes(ReqResourceID("alice@example.com"), attribute.Int("enduser.id", 42))
+		span.End()
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		return spans[0].Attributes
+	}
+
+	original := []attribute.KeyValue{
+		RequestID("req-1"),
+		ResourceKind("payment"),
+		ReqResourceID("alice@example.com"),
+		attribute.Int("enduser.id", 42),
+	}
+
+	t.Run("no_config", func(t *testing.T) {
+		next := tracesdk.NewSimpleSpanProcessor(tracetest.NewInMemoryExporter())
+		require.Equal(t, next, mkRedactionProcessor(next, nil))
+		require.Equal(t, next, mkRedactionProcessor(next, &RedactionConf{}))
+
+		require.Equal(t, original, exportSpan(t, nil))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		have := exportSpan(t, &RedactionConf{DropAttributes: []string{string(reqResourceIDKey), "enduser.id"}})
+		require.Equal(t, []attribute.KeyValue{RequestID("req-1"), ResourceKind("payment")}, have)
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		have := exportSpan(t, &RedactionConf{HashAttributes: []string{string(reqResourceIDKey), "enduser.id"}})
+		require.Equal(t, []attribute.KeyValue{
+			RequestID("req-1"),
+			ResourceKind("payment"),
+			ReqResourceID(sha256Hex("alice@example.com")),
+			attribute.String("enduser.id", sha256Hex("42")),
+		}, have)
+
+		// The digest must be stable so that spans with the same value can be correlated.
+		require.Equal(t, have, exportSpan(t, &RedactionConf{HashAttributes: []string{string(reqResourceIDKey), "enduser.id"}}))
+	})
+
+	t.Run("drop_and_hash", func(t *testing.T) {
+		have := exportSpan(t, &RedactionConf{DropAttributes: []string{string(requestIDKey)}, HashAttributes: []string{string(reqResourceIDKey)}})
+		require.Equal(t, []attribute.KeyValue{
+			ResourceKind("payment"),
+			ReqResourceID(sha256Hex("alice@example.com")),
+			attribute.Int("enduser.id", 42),
+		}, have)
+	})
+}
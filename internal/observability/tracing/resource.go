@@ -0,0 +1,27 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+)
+
+// mkResource creates the resource describing this process. The given attributes override the detected ones.
+func mkResource(svcName string, attrs map[string]string) (*resource.Resource, error) {
+	confAttrs := make([]attribute.KeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		confAttrs = append(confAttrs, attribute.String(k, v))
+	}
+
+	return resource.New(context.Background(),
+		resource.WithAttributes(semconv.ServiceNameKey.String(svcName)),
+		resource.WithProcessPID(),
+		resource.WithHost(),
+		resource.WithFromEnv(),
+		resource.WithAttributes(confAttrs...))
+}
@@ -0,0 +1,60 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+)
+
+func TestMkResource(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "team=platform,deployment.environment=staging")
+
+	res, err := mkResource("cerbos", map[string]string{
+		"deployment.environment":    "production",
+		string(semconv.HostNameKey): "pdp-1",
+		"service.version":           "1.2.3",
+	})
+	require.NoError(t, err)
+
+	have := make(map[attribute.Key]string)
+	for _, kv := range res.Attributes() {
+		have[kv.Key] = kv.Value.Emit()
+	}
+
+	require.Equal(t, "cerbos", have[semconv.ServiceNameKey])
+	require.Equal(t, "platform", have["team"])
+	require.Equal(t, "production", have["deployment.environment"])
+	require.Equal(t, "pdp-1", have[semconv.HostNameKey])
+	require.Equal(t, "1.2.3", have["service.version"])
+}
+
+func TestResourceAttributesValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		attrs   map[string]string
+		wantErr bool
+	}{
+		{name: "valid", attrs: map[string]string{"deployment.environment": "production"}},
+		{name: "empty_key", attrs: map[string]string{"": "production"}, wantErr: true},
+		{name: "empty_value", attrs: map[string]string{"deployment.environment": ""}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := Conf{ResourceAttributes: tc.attrs}
+			err := c.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// sampledExporter is an exporter with an optional sample probability that overrides the top-level one.
+// If sync is true, spans are exported as soon as they end instead of being batched.
+type sampledExporter struct {
+	exporter    tracesdk.SpanExporter
+	probability *float64
+	sync        bool
+}
+
+// mkExportProcessors creates a batching (or, for sync exporters, simple) span processor for each exporter and returns them along with the probability to use for head sampling.
+// Head sampling must keep every trace wanted by at least one exporter, so it uses the highest of the exporter probabilities.
+// Exporters with a lower probability get a processor that drops the sampled spans outside their own ratio before they are batched.
+// Because the ratio is applied to the trace ID, each exporter receives whole traces and the traces sent to an exporter with a lower
+// probability are a subset of those sent to an exporter with a higher probability.
+// If startupBuffer is set, each batching processor is preceded by one that holds back the spans finished during the startup grace period.
+// The batching processors are tuned using batch, which can be nil to use the SDK defaults.
+// If keepFailed is set, failed spans are exported by every exporter even if they were not sampled. The sampler must record unsampled spans for this to work.
+func mkExportProcessors(defaultProbability float64, startupBuffer *StartupBufferConf, batch *BatchConf, keepFailed bool, exporters []sampledExporter) (float64, []tracesdk.SpanProcessor) {
+	probabilities := make([]float64, len(exporters))
+	headProbability := 0.0
+	for i, e := range exporters {
+		probabilities[i] = defaultProbability
+		if e.probability != nil {
+			probabilities[i] = *e.probability
+		}
+
+		if probabilities[i] > headProbability {
+			headProbability = probabilities[i]
+		}
+	}
+
+	processors := make([]tracesdk.SpanProcessor, len(exporters))
+	for i, e := range exporters {
+		exporter := e.exporter
+		if startupBuffer != nil {
+			// The startup processor exports the buffered spans while the next processor might be exporting new spans.
+			exporter = &serialExporter{exporter: exporter}
+		}
+
+		if e.sync {
+			processors[i] = tracesdk.NewSimpleSpanProcessor(exporter)
+		} else {
+			processors[i] = tracesdk.NewBatchSpanProcessor(exporter, batchOptions(batch)...)
+		}
+		if startupBuffer != nil {
+			processors[i] = newStartupProcessor(exporter, processors[i], startupBuffer)
+		}
+		if keepFailed {
+			processors[i] = failedSpanProcessor{next: processors[i]}
+		}
+		if probabilities[i] < headProbability {
+			processors[i] = ratioProcessor{next: processors[i], sampler: tracesdk.TraceIDRatioBased(probabilities[i]), keepFailed: keepFailed}
+		}
+	}
+
+	return headProbability, processors
+}
+
+// batchOptions returns the batch span processor options for the fields of bc that are set. The SDK defaults apply to the rest.
+func batchOptions(bc *BatchConf) []tracesdk.BatchSpanProcessorOption {
+	if bc == nil {
+		return nil
+	}
+
+	var opts []tracesdk.BatchSpanProcessorOption
+	if bc.MaxQueueSize > 0 {
+		opts = append(opts, tracesdk.WithMaxQueueSize(int(bc.MaxQueueSize)))
+	}
+	if bc.MaxExportBatchSize > 0 {
+		opts = append(opts, tracesdk.WithMaxExportBatchSize(int(bc.MaxExportBatchSize)))
+	}
+	if bc.BatchTimeout > 0 {
+		opts = append(opts, tracesdk.WithBatchTimeout(bc.BatchTimeout))
+	}
+	if bc.ExportTimeout > 0 {
+		opts = append(opts, tracesdk.WithExportTimeout(bc.ExportTimeout))
+	}
+
+	return opts
+}
+
+var _ tracesdk.SpanProcessor = ratioProcessor{}
+
+// ratioProcessor only forwards the finished spans that are accepted by its sampler to the next processor.
+// If keepFailed is set, failed spans are forwarded regardless of the sampler.
+type ratioProcessor struct {
+	next       tracesdk.SpanProcessor
+	sampler    tracesdk.Sampler
+	keepFailed bool
+}
+
+func (rp ratioProcessor) OnStart(parent context.Context, s tracesdk.ReadWriteSpan) {
+	rp.next.OnStart(parent, s)
+}
+
+func (rp ratioProcessor) OnEnd(s tracesdk.ReadOnlySpan) {
+	if rp.keepFailed && isFailed(s) {
+		rp.next.OnEnd(s)
+		return
+	}
+
+	result := rp.sampler.ShouldSample(tracesdk.SamplingParameters{TraceID: s.SpanContext().TraceID(), Name: s.Name()})
+	if result.Decision == tracesdk.RecordAndSample {
+		rp.next.OnEnd(s)
+	}
+}
+
+func (rp ratioProcessor) Shutdown(ctx context.Context) error {
+	return rp.next.Shutdown(ctx)
+}
+
+func (rp ratioProcessor) ForceFlush(ctx context.Context) error {
+	return rp.next.ForceFlush(ctx)
+}
+
+var _ tracesdk.SpanProcessor = failedSpanProcessor{}
+
+// failedSpanProcessor flags the failed spans that were recorded but not sampled as sampled so that the next processor exports them.
+// The sampling decision is made when a span starts, before it is known whether the operation fails, so this is the only point
+// where failed spans can be rescued. Only the failed spans are exported, so the rest of their trace is missing from the exporter.
+type failedSpanProcessor struct {
+	next tracesdk.SpanProcessor
+}
+
+func (fp failedSpanProcessor) OnStart(parent context.Context, s tracesdk.ReadWriteSpan) {
+	fp.next.OnStart(parent, s)
+}
+
+func (fp failedSpanProcessor) OnEnd(s tracesdk.ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() && isFailed(s) {
+		s = sampledSpan{ReadOnlySpan: s, spanContext: s.SpanContext().WithTraceFlags(s.SpanContext().TraceFlags().WithSampled(true))}
+	}
+
+	fp.next.OnEnd(s)
+}
+
+func (fp failedSpanProcessor) Shutdown(ctx context.Context) error {
+	return fp.next.Shutdown(ctx)
+}
+
+func (fp failedSpanProcessor) ForceFlush(ctx context.Context) error {
+	return fp.next.ForceFlush(ctx)
+}
+
+// sampledSpan overrides the span context of a finished span.
+type sampledSpan struct {
+	tracesdk.ReadOnlySpan
+	spanContext trace.SpanContext
+}
+
+func (s sampledSpan) SpanContext() trace.SpanContext {
+	return s.spanContext
+}
+
+// isFailed returns true if the span has an error status.
+// Recorded errors alone don't count because MarkFailed records the errors caused by the request as well, such as the
+// not found errors, but only sets the error status for the server errors.
+func isFailed(s tracesdk.ReadOnlySpan) bool {
+	return s.Status().Code == codes.Error
+}
@@ -0,0 +1,298 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cerbos/cerbos/internal/config"
+)
+
+func TestPerExporterSampling(t *testing.T) {
+	const numRequests = 500
+
+	local := tracetest.NewInMemoryExporter()
+	hosted := tracetest.NewInMemoryExporter()
+	hostedProbability := 0.1
+
+	headProbability, processors := mkExportProcessors(1.0, nil, nil, false, []sampledExporter{
+		{exporter: local},
+		{exporter: hosted, probability: &hostedProbability},
+	})
+	require.InDelta(t, 1.0, headProbability, 0)
+
+	opts := []tracesdk.TracerProviderOption{tracesdk.WithSampler(mkSampler(headProbability, false, nil))}
+	for _, p := range processors {
+		opts = append(opts, tracesdk.WithSpanProcessor(p))
+	}
+
+	provider := tracesdk.NewTracerProvider(opts...)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	tracer := provider.Tracer("test")
+
+	for i := 0; i < numRequests; i++ {
+		ctx, span := tracer.Start(context.Background(), "cerbos.svc.v1.CerbosService/CheckResources")
+		_, child := tracer.Start(ctx, "cerbos.engine.Check")
+		child.End()
+		span.End()
+	}
+
+	require.NoError(t, provider.ForceFlush(context.Background()))
+
+	localSpans := local.GetSpans()
+	hostedSpans := hosted.GetSpans()
+	require.Equal(t, 2*numRequests, len(localSpans))
+	require.Greater(t, len(hostedSpans), 0)
+	require.InDelta(t, hostedProbability, float64(len(hostedSpans))/float64(len(localSpans)), 0.05)
+
+	// The hosted exporter must receive whole traces that are also sent to the local exporter.
+	localTraces := make(map[string]int)
+	for _, s := range localSpans {
+		localTraces[s.SpanContext.TraceID().String()]++
+	}
+
+	hostedTraces := make(map[string]int)
+	for _, s := range hostedSpans {
+		traceID := s.SpanContext.TraceID().String()
+		require.Contains(t, localTraces, traceID)
+		hostedTraces[traceID]++
+	}
+
+	for traceID, count := range hostedTraces {
+		require.Equal(t, localTraces[traceID], count, "Trace %s is incomplete", traceID)
+	}
+}
+
+func TestHeadProbability(t *testing.T) {
+	low, high := 0.01, 0.5
+
+	testCases := []struct {
+		name       string
+		exporters  []sampledExporter
+		defaultVal float64
+		want       float64
+	}{
+		{name: "default", defaultVal: 0.2, exporters: []sampledExporter{{}}, want: 0.2},
+		{name: "override_lower", defaultVal: 0.2, exporters: []sampledExporter{{probability: &low}}, want: low},
+		{name: "override_higher", defaultVal: 0.2, exporters: []sampledExporter{{probability: &high}}, want: high},
+		{name: "highest_wins", defaultVal: 0.2, exporters: []sampledExporter{{probability: &low}, {probability: &high}, {}}, want: high},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, processors := mkExportProcessors(tc.defaultVal, nil, nil, false, tc.exporters)
+			require.InDelta(t, tc.want, got, 0)
+			require.Len(t, processors, len(tc.exporters))
+		})
+	}
+}
+
+func TestMkSampler(t *testing.T) {
+	// The ratio sampler compares the lower 8 bytes of the trace ID against the threshold.
+	lowTraceID := trace.TraceID{15: 0x01}
+	highTraceID := trace.TraceID{8: 0xff, 9: 0xff, 10: 0xff, 11: 0xff, 12: 0xff, 13: 0xff, 14: 0xff, 15: 0xff}
+
+	testCases := []struct {
+		probability float64
+		wantLow     tracesdk.SamplingDecision
+		wantHigh    tracesdk.SamplingDecision
+	}{
+		{probability: 0, wantLow: tracesdk.Drop, wantHigh: tracesdk.Drop},
+		{probability: 0.25, wantLow: tracesdk.RecordAndSample, wantHigh: tracesdk.Drop},
+		{probability: 0.5, wantLow: tracesdk.RecordAndSample, wantHigh: tracesdk.Drop},
+		{probability: 1, wantLow: tracesdk.RecordAndSample, wantHigh: tracesdk.RecordAndSample},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%v", tc.probability), func(t *testing.T) {
+			s := mkSampler(tc.probability, false, nil)
+			require.Equal(t, tc.wantLow, s.ShouldSample(tracesdk.SamplingParameters{TraceID: lowTraceID, Name: "span"}).Decision)
+			require.Equal(t, tc.wantHigh, s.ShouldSample(tracesdk.SamplingParameters{TraceID: highTraceID, Name: "span"}).Decision)
+		})
+	}
+
+	t.Run("invalid_probability", func(t *testing.T) {
+		for _, p := range []float64{-0.1, 1.5} {
+			require.ErrorIs(t, InitFromConf(context.Background(), Conf{SampleProbability: p}), errInvalidSampleProbability)
+		}
+	})
+}
+
+func TestResourceKindSampleProbabilities(t *testing.T) {
+	traceID := trace.TraceID{15: 0x01}
+	params := func(attrs ...attribute.KeyValue) tracesdk.SamplingParameters {
+		return tracesdk.SamplingParameters{TraceID: traceID, Name: "engine.Evaluate", Attributes: attrs}
+	}
+	kindProbabilities := map[string]float64{"payment": 1.0, "document": 0.0}
+
+	t.Run("matched_kind", func(t *testing.T) {
+		s := mkSampler(0.0, false, kindProbabilities)
+		require.Equal(t, tracesdk.RecordAndSample, s.ShouldSample(params(ResourceKind("payment"))).Decision)
+
+		s = mkSampler(1.0, false, kindProbabilities)
+		require.Equal(t, tracesdk.Drop, s.ShouldSample(params(RequestID("1"), ResourceKind("document"))).Decision)
+	})
+
+	t.Run("matched_kind_unsampled_parent", func(t *testing.T) {
+		parent := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  trace.SpanID{7: 0x01},
+		}))
+
+		s := mkSampler(0.5, false, kindProbabilities)
+		p := params(ResourceKind("payment"))
+		p.ParentContext = parent
+		require.Equal(t, tracesdk.RecordAndSample, s.ShouldSample(p).Decision)
+
+		p = params(ResourceKind("album"))
+		p.ParentContext = parent
+		require.Equal(t, tracesdk.Drop, s.ShouldSample(p).Decision)
+	})
+
+	t.Run("unmatched_kind", func(t *testing.T) {
+		s := mkSampler(0.0, false, kindProbabilities)
+		require.Equal(t, tracesdk.Drop, s.ShouldSample(params(ResourceKind("album"))).Decision)
+		require.Equal(t, tracesdk.Drop, s.ShouldSample(params()).Decision)
+
+		s = mkSampler(1.0, false, kindProbabilities)
+		require.Equal(t, tracesdk.RecordAndSample, s.ShouldSample(params(ResourceKind("album"))).Decision)
+	})
+
+	t.Run("no_config", func(t *testing.T) {
+		s := mkSampler(0.0, false, nil)
+		require.Equal(t, tracesdk.Drop, s.ShouldSample(params(ResourceKind("payment"))).Decision)
+
+		s = mkSampler(1.0, false, nil)
+		require.Equal(t, tracesdk.RecordAndSample, s.ShouldSample(params(ResourceKind("document"))).Decision)
+	})
+
+	t.Run("invalid_probability", func(t *testing.T) {
+		err := InitFromConf(context.Background(), Conf{ResourceKindSampleProbabilities: map[string]float64{"payment": 1.5}})
+		require.ErrorIs(t, err, errInvalidSampleProbability)
+	})
+}
+
+func TestBatchOptions(t *testing.T) {
+	apply := func(opts []tracesdk.BatchSpanProcessorOption) tracesdk.BatchSpanProcessorOptions {
+		var have tracesdk.BatchSpanProcessorOptions
+		for _, opt := range opts {
+			opt(&have)
+		}
+		return have
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		require.Empty(t, batchOptions(nil))
+		require.Empty(t, batchOptions(&BatchConf{}))
+	})
+
+	t.Run("from_config", func(t *testing.T) {
+		w, err := config.WrapperFromReader(strings.NewReader(`
+tracing:
+  batch:
+    maxQueueSize: 8192
+    maxExportBatchSize: 1024
+    batchTimeout: 2s
+    exportTimeout: 1m
+`), nil)
+		require.NoError(t, err)
+
+		var c Conf
+		require.NoError(t, w.GetSection(&c))
+
+		have := apply(batchOptions(c.Batch))
+		require.Equal(t, 8192, have.MaxQueueSize)
+		require.Equal(t, 1024, have.MaxExportBatchSize)
+		require.Equal(t, 2*time.Second, have.BatchTimeout)
+		require.Equal(t, time.Minute, have.ExportTimeout)
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		have := apply(batchOptions(&BatchConf{MaxQueueSize: 4096}))
+		require.Equal(t, tracesdk.BatchSpanProcessorOptions{MaxQueueSize: 4096}, have)
+	})
+
+	t.Run("negative_timeout", func(t *testing.T) {
+		c := Conf{Batch: &BatchConf{BatchTimeout: -time.Second}}
+		require.ErrorIs(t, c.Validate(), errInvalidBatchTimeout)
+	})
+}
+
+func TestKeepFailedSpans(t *testing.T) {
+	zero, one := 0.0, 1.0
+	testCases := []struct {
+		name      string
+		exporters func(*tracetest.InMemoryExporter) []sampledExporter
+	}{
+		{
+			name: "zero_probability",
+			exporters: func(e *tracetest.InMemoryExporter) []sampledExporter {
+				return []sampledExporter{{exporter: e}}
+			},
+		},
+		{
+			name: "zero_exporter_probability",
+			exporters: func(e *tracetest.InMemoryExporter) []sampledExporter {
+				return []sampledExporter{{exporter: e, probability: &zero}, {exporter: tracetest.NewNoopExporter(), probability: &one}}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			_, processors := mkExportProcessors(0.0, nil, nil, true, tc.exporters(exporter))
+
+			// Use a zero head probability regardless of the exporters so that none of the traces are sampled.
+			opts := []tracesdk.TracerProviderOption{tracesdk.WithSampler(mkSampler(0.0, true, nil))}
+			for _, p := range processors {
+				opts = append(opts, tracesdk.WithSpanProcessor(p))
+			}
+
+			provider := tracesdk.NewTracerProvider(opts...)
+			t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+			tracer := provider.Tracer("test")
+
+			ctx, span := tracer.Start(context.Background(), "cerbos.svc.v1.CerbosService/CheckResources")
+			_, ok := tracer.Start(ctx, "cerbos.engine.Check")
+			ok.End()
+			_, failed := tracer.Start(ctx, "cerbos.storage.GetPolicy")
+			MarkFailed(failed, http.StatusInternalServerError, errors.New("storage unavailable"))
+			failed.End()
+			_, notFound := tracer.Start(ctx, "cerbos.storage.LoadPolicy")
+			MarkFailed(notFound, http.StatusNotFound, errors.New("policy not found"))
+			notFound.End()
+			_, errStatus := tracer.Start(ctx, "cerbos.storage.ListPolicies")
+			errStatus.SetStatus(codes.Error, "boom")
+			errStatus.End()
+			span.End()
+
+			require.False(t, span.SpanContext().IsSampled())
+			require.NoError(t, provider.ForceFlush(context.Background()))
+
+			spans := exporter.GetSpans()
+			names := make([]string, len(spans))
+			for i, s := range spans {
+				require.True(t, s.SpanContext.IsSampled())
+				names[i] = s.Name
+			}
+			require.ElementsMatch(t, []string{"cerbos.storage.GetPolicy", "cerbos.storage.ListPolicies"}, names)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+var _ tracesdk.SpanProcessor = spanMetricsProcessor{}
+
+// spanMetricsProcessor records request count, error count and duration metrics for each finished span.
+type spanMetricsProcessor struct{}
+
+func (spanMetricsProcessor) OnStart(context.Context, tracesdk.ReadWriteSpan) {}
+
+func (spanMetricsProcessor) OnEnd(s tracesdk.ReadOnlySpan) {
+	durationMs := float64(s.EndTime().Sub(s.StartTime())) / float64(time.Millisecond)
+	measurements := []stats.Measurement{metrics.SpanCount.M(1), metrics.SpanDuration.M(durationMs)}
+	if s.Status().Code == codes.Error {
+		measurements = append(measurements, metrics.SpanErrorCount.M(1))
+	}
+
+	_ = stats.RecordWithTags(context.Background(),
+		[]tag.Mutator{tag.Upsert(metrics.KeySpanName, s.Name())},
+		measurements...,
+	)
+}
+
+func (spanMetricsProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (spanMetricsProcessor) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+func TestSpanMetricsProcessor(t *testing.T) {
+	spanViews := []*view.View{metrics.SpanCountView, metrics.SpanDurationView, metrics.SpanErrorCountView}
+	require.NoError(t, view.Register(spanViews...))
+	t.Cleanup(func() { view.Unregister(spanViews...) })
+
+	// Spans that are not sampled must be counted too.
+	provider := tracesdk.NewTracerProvider(
+		tracesdk.WithSampler(mkSampler(0, true, nil)),
+		tracesdk.WithSpanProcessor(spanMetricsProcessor{}),
+	)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	tracer := provider.Tracer("test")
+
+	_, okSpan := tracer.Start(context.Background(), "cerbos.svc.v1.CerbosService/CheckResources")
+	require.False(t, okSpan.SpanContext().IsSampled())
+	okSpan.End()
+
+	_, failedSpan := tracer.Start(context.Background(), "cerbos.svc.v1.CerbosService/CheckResources")
+	MarkFailed(failedSpan, http.StatusInternalServerError, errors.New("boom"))
+	failedSpan.End()
+
+	_, otherSpan := tracer.Start(context.Background(), "cerbos.svc.v1.CerbosService/PlanResources")
+	MarkFailed(otherSpan, http.StatusBadRequest, errors.New("bad request"))
+	otherSpan.End()
+
+	checkResources := tag.Tag{Key: metrics.KeySpanName, Value: "cerbos.svc.v1.CerbosService/CheckResources"}
+	planResources := tag.Tag{Key: metrics.KeySpanName, Value: "cerbos.svc.v1.CerbosService/PlanResources"}
+
+	require.Equal(t, int64(2), countFor(t, metrics.SpanCountView, checkResources))
+	require.Equal(t, int64(1), countFor(t, metrics.SpanErrorCountView, checkResources))
+	require.Equal(t, int64(1), countFor(t, metrics.SpanCountView, planResources))
+	// Client errors do not mark server spans as failed.
+	require.Zero(t, countFor(t, metrics.SpanErrorCountView, planResources))
+
+	rows, err := view.RetrieveData(metrics.SpanDurationView.Name)
+	require.NoError(t, err)
+	for _, row := range rows {
+		if row.Tags[0] == checkResources {
+			require.Equal(t, int64(2), row.Data.(*view.DistributionData).Count)
+		}
+	}
+}
+
+func TestSamplerRecordUnsampled(t *testing.T) {
+	params := tracesdk.SamplingParameters{Name: "cerbos.svc.v1.CerbosService/CheckResources"}
+
+	require.Equal(t, tracesdk.Drop, mkSampler(0, false, nil).ShouldSample(params).Decision)
+	require.Equal(t, tracesdk.RecordOnly, mkSampler(0, true, nil).ShouldSample(params).Decision)
+	require.Equal(t, tracesdk.RecordAndSample, mkSampler(1, true, nil).ShouldSample(params).Decision)
+}
+
+func countFor(t *testing.T, v *view.View, want tag.Tag) int64 {
+	t.Helper()
+
+	rows, err := view.RetrieveData(v.Name)
+	require.NoError(t, err)
+
+	for _, row := range rows {
+		if len(row.Tags) == 1 && row.Tags[0] == want {
+			return row.Data.(*view.CountData).Value
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,161 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultStartupQueueSize = 16384
+	startupExportBatchSize  = 512
+	startupExportTimeout    = 30 * time.Second
+)
+
+var _ tracesdk.SpanProcessor = (*startupProcessor)(nil)
+
+// startupProcessor holds on to the spans finished during the startup grace period instead of exporting them straight away.
+// When the grace period ends, the buffered spans are exported and all subsequent spans are handed over to the next processor.
+// The next processor exports to the same exporter, so the exporter must be a serialExporter to avoid concurrent exports.
+type startupProcessor struct {
+	exporter tracesdk.SpanExporter
+	next     tracesdk.SpanProcessor
+	timer    *time.Timer
+	buffer   []tracesdk.ReadOnlySpan
+	maxSize  int
+	dropped  int
+	released bool
+	mu       sync.RWMutex
+}
+
+func newStartupProcessor(exporter tracesdk.SpanExporter, next tracesdk.SpanProcessor, conf *StartupBufferConf) *startupProcessor {
+	sp := &startupProcessor{
+		exporter: exporter,
+		next:     next,
+		maxSize:  int(conf.MaxQueueSize),
+	}
+
+	if sp.maxSize == 0 {
+		sp.maxSize = defaultStartupQueueSize
+	}
+
+	sp.timer = time.AfterFunc(conf.GracePeriod, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), startupExportTimeout)
+		defer cancel()
+
+		if err := sp.release(ctx); err != nil {
+			otel.Handle(err)
+		}
+	})
+
+	return sp
+}
+
+func (sp *startupProcessor) OnStart(parent context.Context, s tracesdk.ReadWriteSpan) {
+	sp.next.OnStart(parent, s)
+}
+
+func (sp *startupProcessor) OnEnd(s tracesdk.ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() {
+		return
+	}
+
+	sp.mu.RLock()
+	if sp.released {
+		sp.mu.RUnlock()
+		sp.next.OnEnd(s)
+		return
+	}
+	sp.mu.RUnlock()
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	// The grace period might have ended while waiting for the lock.
+	if sp.released {
+		sp.next.OnEnd(s)
+		return
+	}
+
+	if len(sp.buffer) >= sp.maxSize {
+		sp.dropped++
+		return
+	}
+
+	sp.buffer = append(sp.buffer, s)
+}
+
+// release ends the grace period and exports the buffered spans.
+func (sp *startupProcessor) release(ctx context.Context) error {
+	sp.mu.Lock()
+	if sp.released {
+		sp.mu.Unlock()
+		return nil
+	}
+
+	sp.timer.Stop()
+	sp.released = true
+	buffer, dropped := sp.buffer, sp.dropped
+	sp.buffer = nil
+	sp.mu.Unlock()
+
+	if dropped > 0 {
+		zap.L().Named("otel").Warn("Dropped spans because the startup buffer was full", zap.Int("dropped", dropped), zap.Int("max_queue_size", sp.maxSize))
+	}
+
+	for len(buffer) > 0 {
+		n := min(len(buffer), startupExportBatchSize)
+		if err := sp.exporter.ExportSpans(ctx, buffer[:n]); err != nil {
+			return err
+		}
+		buffer = buffer[n:]
+	}
+
+	return nil
+}
+
+func (sp *startupProcessor) Shutdown(ctx context.Context) error {
+	if err := sp.release(ctx); err != nil {
+		otel.Handle(err)
+	}
+
+	return sp.next.Shutdown(ctx)
+}
+
+// ForceFlush ends the grace period early so that all the finished spans are exported.
+func (sp *startupProcessor) ForceFlush(ctx context.Context) error {
+	if err := sp.release(ctx); err != nil {
+		return err
+	}
+
+	return sp.next.ForceFlush(ctx)
+}
+
+var _ tracesdk.SpanExporter = (*serialExporter)(nil)
+
+// serialExporter serialises the calls to an exporter shared by more than one processor because exporters must not be called concurrently.
+type serialExporter struct {
+	exporter tracesdk.SpanExporter
+	mu       sync.Mutex
+}
+
+func (se *serialExporter) ExportSpans(ctx context.Context, spans []tracesdk.ReadOnlySpan) error {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+
+	return se.exporter.ExportSpans(ctx, spans)
+}
+
+func (se *serialExporter) Shutdown(ctx context.Context) error {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+
+	return se.exporter.Shutdown(ctx)
+}
@@ -0,0 +1,151 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartupProcessor(t *testing.T) {
+	mkProvider := func(t *testing.T, conf *StartupBufferConf) (*tracesdk.TracerProvider, *startupProcessor, *tracetest.InMemoryExporter) {
+		t.Helper()
+
+		exporter := tracetest.NewInMemoryExporter()
+		_, processors := mkExportProcessors(1.0, conf, nil, false, []sampledExporter{{exporter: exporter}})
+		require.Len(t, processors, 1)
+
+		sp, ok := processors[0].(*startupProcessor)
+		require.True(t, ok)
+
+		provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(1.0, false, nil)), tracesdk.WithSpanProcessor(sp))
+		t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+		return provider, sp, exporter
+	}
+
+	startSpans := func(provider *tracesdk.TracerProvider, prefix string, n int) {
+		tracer := provider.Tracer("test")
+		for i := 0; i < n; i++ {
+			_, span := tracer.Start(context.Background(), fmt.Sprintf("%s.%d", prefix, i))
+			span.End()
+		}
+	}
+
+	t.Run("retained_across_transition", func(t *testing.T) {
+		const numStartupSpans = 5000
+
+		provider, sp, exporter := mkProvider(t, &StartupBufferConf{GracePeriod: time.Hour})
+
+		// More spans than the default batch processor queue can hold.
+		startSpans(provider, "startup", numStartupSpans)
+		require.Empty(t, exporter.GetSpans(), "Spans must not be exported during the grace period")
+
+		require.NoError(t, sp.release(context.Background()))
+		require.Len(t, exporter.GetSpans(), numStartupSpans)
+
+		startSpans(provider, "steady", 10)
+		require.NoError(t, provider.ForceFlush(context.Background()))
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, numStartupSpans+10)
+		for i := 0; i < numStartupSpans; i++ {
+			require.Equal(t, fmt.Sprintf("startup.%d", i), spans[i].Name)
+		}
+	})
+
+	t.Run("grace_period_elapsed", func(t *testing.T) {
+		provider, _, exporter := mkProvider(t, &StartupBufferConf{GracePeriod: 10 * time.Millisecond})
+
+		startSpans(provider, "startup", 10)
+		require.Eventually(t, func() bool { return len(exporter.GetSpans()) == 10 }, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("queue_full", func(t *testing.T) {
+		provider, sp, exporter := mkProvider(t, &StartupBufferConf{GracePeriod: time.Hour, MaxQueueSize: 10})
+
+		startSpans(provider, "startup", 15)
+		require.NoError(t, sp.release(context.Background()))
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 10)
+		require.Equal(t, "startup.9", spans[9].Name)
+	})
+
+	t.Run("no_concurrent_exports", func(t *testing.T) {
+		exporter := &overlapDetectingExporter{}
+		_, processors := mkExportProcessors(1.0, &StartupBufferConf{GracePeriod: time.Hour}, nil, false, []sampledExporter{{exporter: exporter, sync: true}})
+		provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(1.0, false, nil)), tracesdk.WithSpanProcessor(processors[0]))
+		t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+		sp, ok := processors[0].(*startupProcessor)
+		require.True(t, ok)
+
+		startSpans(provider, "startup", 2000)
+
+		done := make(chan error, 1)
+		go func() { done <- sp.release(context.Background()) }()
+
+		require.Eventually(t, func() bool {
+			sp.mu.RLock()
+			defer sp.mu.RUnlock()
+			return sp.released
+		}, 5*time.Second, time.Millisecond)
+
+		// The spans finished after the release are exported by the simple processor while the buffer is being exported.
+		startSpans(provider, "steady", 50)
+		require.NoError(t, <-done)
+
+		require.False(t, exporter.overlapped.Load(), "Exporter must not be called concurrently")
+		require.Equal(t, int64(2050), exporter.exported.Load())
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		exporter := retainingExporter{tracetest.NewInMemoryExporter()}
+		_, processors := mkExportProcessors(1.0, &StartupBufferConf{GracePeriod: time.Hour}, nil, false, []sampledExporter{{exporter: exporter}})
+		provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(mkSampler(1.0, false, nil)), tracesdk.WithSpanProcessor(processors[0]))
+
+		startSpans(provider, "startup", 10)
+		require.NoError(t, provider.Shutdown(context.Background()))
+		require.Len(t, exporter.GetSpans(), 10)
+	})
+}
+
+// overlapDetectingExporter records whether ExportSpans was ever called while another call was in progress.
+type overlapDetectingExporter struct {
+	inFlight   atomic.Int32
+	exported   atomic.Int64
+	overlapped atomic.Bool
+}
+
+func (e *overlapDetectingExporter) ExportSpans(_ context.Context, spans []tracesdk.ReadOnlySpan) error {
+	if e.inFlight.Add(1) > 1 {
+		e.overlapped.Store(true)
+	}
+	defer e.inFlight.Add(-1)
+
+	time.Sleep(time.Millisecond)
+	e.exported.Add(int64(len(spans)))
+	return nil
+}
+
+func (e *overlapDetectingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// retainingExporter keeps the exported spans after shutdown.
+type retainingExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (retainingExporter) Shutdown(context.Context) error {
+	return nil
+}
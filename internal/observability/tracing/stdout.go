@@ -0,0 +1,29 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+)
+
+// stdout is where the stdout exporter writes spans to. Tests replace it to capture the output.
+var stdout io.Writer = os.Stdout
+
+func mkStdoutExporter() (sampledExporter, error) {
+	opts := []stdouttrace.Option{stdouttrace.WithWriter(stdout)}
+	if conf.Stdout != nil && conf.Stdout.PrettyPrint {
+		opts = append(opts, stdouttrace.WithPrettyPrint())
+	}
+
+	exporter, err := stdouttrace.New(opts...)
+	if err != nil {
+		return sampledExporter{}, fmt.Errorf("failed to create stdout exporter: %w", err)
+	}
+
+	return sampledExporter{exporter: exporter, sync: true}, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStdoutExporter(t *testing.T) {
+	testCases := []struct {
+		name        string
+		prettyPrint bool
+	}{
+		{name: "compact", prettyPrint: false},
+		{name: "pretty", prettyPrint: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			stdout = out
+			t.Cleanup(func() { stdout = os.Stdout })
+
+			ctx := context.Background()
+			require.NoError(t, InitFromConf(ctx, Conf{
+				Exporter:          stdoutExporter,
+				SampleProbability: 1.0,
+				Stdout:            &StdoutConf{PrettyPrint: tc.prettyPrint},
+			}))
+			t.Cleanup(func() {
+				require.NoError(t, Shutdown(ctx))
+				otel.SetTracerProvider(trace.NewNoopTracerProvider())
+			})
+
+			tracer := otel.Tracer("test")
+			_, span := tracer.Start(ctx, "cerbos.svc.v1.CerbosService/CheckResources")
+			span.End()
+
+			// The span must be written as soon as it ends.
+			var have struct{ Name string }
+			require.NoError(t, json.Unmarshal(out.Bytes(), &have))
+			require.Equal(t, "cerbos.svc.v1.CerbosService/CheckResources", have.Name)
+			require.Equal(t, tc.prettyPrint, bytes.Contains(out.Bytes(), []byte("\n\t")))
+
+			out.Reset()
+			_, span = tracer.Start(ctx, "cerbos.svc.v1.CerbosPlaygroundService.PlaygroundProxy")
+			span.End()
+			require.Zero(t, out.Len(), "Playground spans should be dropped")
+		})
+	}
+}
+
+func TestMultipleExporters(t *testing.T) {
+	zipkinExports := make(chan []string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var spans []struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&spans); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		names := make([]string, len(spans))
+		for i, s := range spans {
+			names[i] = s.Name
+		}
+
+		zipkinExports <- names
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	out := new(bytes.Buffer)
+	stdout = out
+	t.Cleanup(func() { stdout = os.Stdout })
+
+	ctx := context.Background()
+	require.NoError(t, InitFromConf(ctx, Conf{
+		Exporters:         []string{stdoutExporter, zipkinExporter},
+		SampleProbability: 1.0,
+		Zipkin:            &ZipkinConf{CollectorEndpoint: srv.URL + "/api/v2/spans"},
+	}))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	_, span := otel.Tracer("test").Start(ctx, "cerbos.svc.v1.CerbosService/CheckResources")
+	span.End()
+	require.NoError(t, Shutdown(ctx))
+
+	var have struct{ Name string }
+	require.NoError(t, json.Unmarshal(out.Bytes(), &have))
+	require.Equal(t, "cerbos.svc.v1.CerbosService/CheckResources", have.Name)
+	require.Equal(t, []string{"cerbos.svc.v1.cerbosservice/checkresources"}, <-zipkinExports)
+}
@@ -0,0 +1,81 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var errPinnedCertMismatch = errors.New("collector certificate does not match the pinned fingerprint")
+
+func newOTLPTLSConfig(conf *OTLPTLSConf) (*tls.Config, error) {
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: conf.ServerName}
+
+	if conf.CAPath != "" {
+		caCert, err := os.ReadFile(conf.CAPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("failed to append CA certificate")
+		}
+		tlsConf.RootCAs = caCertPool
+	}
+
+	if conf.CertPath != "" && conf.KeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertPath, conf.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.PinnedCertSHA256 != "" {
+		fingerprint, err := parseFingerprint(conf.PinnedCertSHA256)
+		if err != nil {
+			return nil, err
+		}
+
+		// The pinned fingerprint replaces the chain verification, which would otherwise reject self-signed certificates.
+		tlsConf.InsecureSkipVerify = true //nolint:gosec
+		tlsConf.VerifyPeerCertificate = verifyPinnedCert(fingerprint)
+	}
+
+	return tlsConf, nil
+}
+
+func verifyPinnedCert(fingerprint []byte) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errPinnedCertMismatch
+		}
+
+		got := sha256.Sum256(rawCerts[0])
+		if !bytes.Equal(got[:], fingerprint) {
+			return fmt.Errorf("%w: got %s", errPinnedCertMismatch, hex.EncodeToString(got[:]))
+		}
+
+		return nil
+	}
+}
+
+// parseFingerprint decodes a hex-encoded SHA-256 fingerprint. Colon separators, as printed by openssl, are allowed.
+func parseFingerprint(s string) ([]byte, error) {
+	fingerprint, err := hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+	if err != nil || len(fingerprint) != sha256.Size {
+		return nil, fmt.Errorf("invalid tracing.otlp.tls.pinnedCertSHA256 %q: %w", s, errInvalidPinnedCert)
+	}
+
+	return fingerprint, nil
+}
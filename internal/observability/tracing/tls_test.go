@@ -0,0 +1,232 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinnedCert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	fingerprint := sha256.Sum256(srv.Certificate().Raw)
+
+	dial := func(t *testing.T, pinned string) error {
+		t.Helper()
+
+		tlsConf, err := newOTLPTLSConfig(&OTLPTLSConf{PinnedCertSHA256: pinned})
+		require.NoError(t, err)
+
+		conn, err := tls.Dial("tcp", srvURL.Host, tlsConf)
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err
+	}
+
+	t.Run("matching", func(t *testing.T) {
+		require.NoError(t, dial(t, hex.EncodeToString(fingerprint[:])))
+	})
+
+	t.Run("matching_with_colons", func(t *testing.T) {
+		parts := make([]string, len(fingerprint))
+		for i, b := range fingerprint {
+			parts[i] = strings.ToUpper(hex.EncodeToString([]byte{b}))
+		}
+
+		require.NoError(t, dial(t, strings.Join(parts, ":")))
+	})
+
+	t.Run("mismatched", func(t *testing.T) {
+		other := sha256.Sum256([]byte("not the collector certificate"))
+		require.ErrorIs(t, dial(t, hex.EncodeToString(other[:])), errPinnedCertMismatch)
+	})
+
+	t.Run("not_pinned", func(t *testing.T) {
+		// The test server certificate is not signed by a trusted CA.
+		require.Error(t, dial(t, ""))
+	})
+}
+
+func TestPinnedCertValidation(t *testing.T) {
+	conf := Conf{
+		Exporter: otlpExporter,
+		OTLP: &OTLPConf{
+			CollectorEndpoint: "otel:4317",
+			TLS:               &OTLPTLSConf{PinnedCertSHA256: "abcd"},
+		},
+	}
+
+	require.ErrorIs(t, conf.Validate(), errInvalidPinnedCert)
+
+	conf.OTLP.TLS.PinnedCertSHA256 = strings.Repeat("ab", sha256.Size)
+	require.NoError(t, conf.Validate())
+}
+
+func TestClientCert(t *testing.T) {
+	// The test server certificate doubles as the CA and the client certificate.
+	// It's not issued for client authentication, so the server checks the presented certificate itself.
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+		MinVersion: tls.VersionTLS12,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], srv.Certificate().Raw) {
+				return errors.New("unexpected client certificate")
+			}
+			return nil
+		},
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	caPath, certPath, keyPath := writeCertFiles(t, srv.TLS.Certificates[0])
+
+	dial := func(t *testing.T, conf *OTLPTLSConf) error {
+		t.Helper()
+
+		tlsConf, err := newOTLPTLSConfig(conf)
+		require.NoError(t, err)
+
+		conn, err := tls.Dial("tcp", srvURL.Host, tlsConf)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		// TLS 1.3 servers report a rejected client certificate after the handshake, so read to surface the error.
+		_, err = conn.Write([]byte("GET / HTTP/1.0\r\n\r\n"))
+		if err == nil {
+			_, err = conn.Read(make([]byte, 1))
+		}
+		return err
+	}
+
+	t.Run("with_client_cert", func(t *testing.T) {
+		require.NoError(t, dial(t, &OTLPTLSConf{CAPath: caPath, CertPath: certPath, KeyPath: keyPath}))
+	})
+
+	t.Run("without_client_cert", func(t *testing.T) {
+		require.Error(t, dial(t, &OTLPTLSConf{CAPath: caPath}))
+	})
+
+	t.Run("server_name_override", func(t *testing.T) {
+		// The test server certificate is issued for example.com in addition to the loopback address.
+		require.NoError(t, dial(t, &OTLPTLSConf{CAPath: caPath, CertPath: certPath, KeyPath: keyPath, ServerName: "example.com"}))
+		require.Error(t, dial(t, &OTLPTLSConf{CAPath: caPath, CertPath: certPath, KeyPath: keyPath, ServerName: "otel.example.org"}))
+	})
+
+	t.Run("missing_client_cert_file", func(t *testing.T) {
+		_, err := newOTLPTLSConfig(&OTLPTLSConf{CertPath: filepath.Join(t.TempDir(), "missing.crt"), KeyPath: keyPath})
+		require.Error(t, err)
+	})
+}
+
+func TestClientCertValidation(t *testing.T) {
+	conf := Conf{
+		Exporter: otlpExporter,
+		OTLP: &OTLPConf{
+			CollectorEndpoint: "otel:4317",
+			TLS:               &OTLPTLSConf{CertPath: "/path/to/tls.crt"},
+		},
+	}
+
+	require.ErrorIs(t, conf.Validate(), errIncompleteClientCert)
+
+	conf.OTLP.TLS.KeyPath = "/path/to/tls.key"
+	require.NoError(t, conf.Validate())
+}
+
+func TestOTLPTLSEnabled(t *testing.T) {
+	insecure, secure := true, false
+
+	testCases := []struct {
+		name     string
+		conf     *OTLPConf
+		wantTLS  bool
+		wantNoop bool
+	}{
+		{
+			name:     "no_tls_section",
+			conf:     &OTLPConf{CollectorEndpoint: "otel:4317"},
+			wantNoop: true,
+		},
+		{
+			name:     "insecure_by_default",
+			conf:     &OTLPConf{CollectorEndpoint: "otel:4317", TLS: &OTLPTLSConf{ServerName: "otel.example.com"}},
+			wantNoop: true,
+		},
+		{
+			name: "insecure_explicitly",
+			conf: &OTLPConf{CollectorEndpoint: "otel:4317", TLS: &OTLPTLSConf{ServerName: "otel.example.com", Insecure: &insecure}},
+		},
+		{
+			name:    "secure",
+			conf:    &OTLPConf{CollectorEndpoint: "otel:4317", TLS: &OTLPTLSConf{ServerName: "otel.example.com", Insecure: &secure}},
+			wantTLS: true,
+		},
+		{
+			name:    "https_url",
+			conf:    &OTLPConf{Protocol: otlpProtocolHTTP, CollectorEndpoint: "https://otel:4318", TLS: &OTLPTLSConf{ServerName: "otel.example.com"}},
+			wantTLS: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, tlsConf, err := otlpConnSettings(tc.conf)
+			require.NoError(t, err)
+			if tc.wantTLS {
+				require.NotNil(t, tlsConf)
+				require.Equal(t, "otel.example.com", tlsConf.ServerName)
+			} else {
+				require.Nil(t, tlsConf)
+			}
+			require.Equal(t, !tc.wantTLS && !tc.wantNoop, tc.conf.forceInsecure())
+		})
+	}
+}
+
+func writeCertFiles(t *testing.T, cert tls.Certificate) (caPath, certPath, keyPath string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+
+	caPath = filepath.Join(dir, "ca.crt")
+	certPath = filepath.Join(dir, "tls.crt")
+	keyPath = filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(caPath, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(certPath, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyPath, keyPEM, 0o600))
+
+	return caPath, certPath, keyPath
+}
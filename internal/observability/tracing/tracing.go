@@ -5,36 +5,47 @@ package tracing
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"net"
 	"net/http"
 	"strings"
+	"sync/atomic"
 
+	"go.opencensus.io/stats"
 	octrace "go.opencensus.io/trace"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
-	"go.opentelemetry.io/contrib/propagators/autoprop"
-	otelpropb3 "go.opentelemetry.io/contrib/propagators/b3"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	ocbridge "go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/jaeger" //nolint:staticcheck
-	otlp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
-	otlphttp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	otelprop "go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
 	"go.opentelemetry.io/otel/semconv/v1.18.0/httpconv"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	grpccodes "google.golang.org/grpc/codes"
 
 	"github.com/cerbos/cerbos/internal/config"
-	"github.com/cerbos/cerbos/internal/util"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
 )
 
-var conf Conf
+const (
+	traceIDHeader      = "X-Trace-Id"
+	traceSampledHeader = "X-Trace-Sampled"
+
+	traceIDLogField = "trace_id"
+	spanIDLogField  = "span_id"
+)
+
+var (
+	conf                 Conf
+	stampResponseHeaders bool
+	excludeHTTPPaths     []string
+	traceProvider        atomic.Pointer[tracesdk.TracerProvider]
+)
 
 func Init(ctx context.Context) error {
 	if err := config.GetSection(&conf); err != nil {
@@ -44,144 +55,282 @@ func Init(ctx context.Context) error {
 	return InitFromConf(ctx, conf)
 }
 
-func InitFromConf(ctx context.Context, conf Conf) error {
-	switch conf.Exporter {
-	case jaegerExporter:
-		return configureJaeger(ctx)
-	case otlpExporter:
-		return configureOTLP(ctx)
-	case "":
+func InitFromConf(ctx context.Context, c Conf) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	conf = c
+	stampResponseHeaders = conf.ResponseHeaders
+	debugTraceEnabled = conf.DebugTraceHeader
+	excludeHTTPPaths = conf.ExcludeHTTPPaths
+
+	names := conf.exporters()
+	if len(names) == 0 {
 		otel.SetTracerProvider(trace.NewNoopTracerProvider())
 		return nil
-	default:
-		return fmt.Errorf("unknown exporter %q", conf.Exporter)
 	}
-}
 
-func configureJaeger(ctx context.Context) error {
-	var endpoint jaeger.EndpointOption
-	if conf.Jaeger.AgentEndpoint != "" {
-		agentHost, agentPort, err := net.SplitHostPort(conf.Jaeger.AgentEndpoint)
+	if conf.VerifyConnectivity {
+		for _, name := range names {
+			if err := verifyConnectivity(ctx, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	exporters := make([]sampledExporter, 0, len(names))
+	for _, name := range names {
+		exporter, err := mkExporter(ctx, name)
 		if err != nil {
-			return fmt.Errorf("failed to parse agent endpoint %q: %w", conf.Jaeger.AgentEndpoint, err)
+			for _, e := range exporters {
+				_ = e.exporter.Shutdown(ctx)
+			}
+			return err
 		}
 
-		endpoint = jaeger.WithAgentEndpoint(jaeger.WithAgentHost(agentHost), jaeger.WithAgentPort(agentPort))
-	} else {
-		endpoint = jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(conf.Jaeger.CollectorEndpoint))
+		exporters = append(exporters, exporter)
 	}
 
-	exporter, err := jaeger.New(endpoint)
+	res, err := mkResource(conf.serviceName(), conf.ResourceAttributes)
 	if err != nil {
-		return fmt.Errorf("failed to create Jaeger exporter: %w", err)
+		return fmt.Errorf("failed to initialize otel resource: %w", err)
 	}
 
-	svcName := conf.ServiceName
-	if svcName == nil {
-		svcName = &conf.Jaeger.ServiceName
+	if err := configureOtel(res, exporters...); err != nil {
+		return err
 	}
 
-	return configureOtel(ctx, svcName, exporter)
+	if conf.otlpMetricsEnabled() {
+		return configureOTLPMetrics(ctx, conf.OTLP, res)
+	}
+
+	return nil
 }
 
-func configureOTLP(ctx context.Context) error {
-	var exporter *otlptrace.Exporter
-	var err error
+func mkExporter(ctx context.Context, name string) (sampledExporter, error) {
+	switch name {
+	case jaegerExporter:
+		return mkJaegerExporter(ctx)
+	case otlpExporter:
+		return mkOTLPExporter(ctx)
+	case zipkinExporter:
+		return mkZipkinExporter()
+	case stdoutExporter:
+		return mkStdoutExporter()
+	default:
+		return sampledExporter{}, fmt.Errorf("unknown exporter %q", name)
+	}
+}
 
-	switch conf.OTLP.Protocol {
-	case "grpc":
-		conn, err := grpc.DialContext(ctx, conf.OTLP.CollectorEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func mkJaegerExporter(ctx context.Context) (sampledExporter, error) {
+	if conf.Jaeger.UseOTLP {
+		otlpConf, err := jaegerOTLPConf(conf.Jaeger)
 		if err != nil {
-			return fmt.Errorf("failed to dial otlp collector: %w", err)
+			return sampledExporter{}, err
 		}
 
-		exporter, err = otlp.New(ctx, otlp.WithGRPCConn(conn))
+		exporter, err := newOTLPExporter(ctx, otlpConf)
 		if err != nil {
-			return fmt.Errorf("failed to create otlp exporter: %w", err)
+			return sampledExporter{}, err
 		}
-	case "http/protobuf":
-		exporter, err = otlphttp.New(ctx, otlphttp.WithEndpoint(conf.OTLP.CollectorEndpoint))
+
+		return sampledExporter{exporter: exporter, probability: conf.Jaeger.SampleProbability}, nil
+	}
+
+	zap.L().Warn("The native Jaeger exporter is deprecated and will be removed in a future release. " +
+		"Set tracing.jaeger.useOTLP to true to send traces to the OTLP receiver of Jaeger instead")
+
+	var endpoint jaeger.EndpointOption
+	if conf.Jaeger.AgentEndpoint != "" {
+		agentHost, agentPort, err := net.SplitHostPort(conf.Jaeger.AgentEndpoint)
 		if err != nil {
-			return fmt.Errorf("failed to create otlp exporter: %w", err)
+			return sampledExporter{}, fmt.Errorf("failed to parse agent endpoint %q: %w", conf.Jaeger.AgentEndpoint, err)
 		}
-	default:
-		return fmt.Errorf("unknown OTLP protocol %q. Supported protocols are 'grpc' and 'http'", conf.OTLP.Protocol)
+
+		endpoint = jaeger.WithAgentEndpoint(jaeger.WithAgentHost(agentHost), jaeger.WithAgentPort(agentPort))
+	} else {
+		endpoint = jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(conf.Jaeger.CollectorEndpoint))
+	}
+
+	exporter, err := jaeger.New(endpoint)
+	if err != nil {
+		return sampledExporter{}, fmt.Errorf("failed to create Jaeger exporter: %w", err)
 	}
 
-	return configureOtel(ctx, conf.ServiceName, exporter)
+	return sampledExporter{exporter: exporter, probability: conf.Jaeger.SampleProbability}, nil
 }
 
-func configureOtel(ctx context.Context, svcName *string, exporter tracesdk.SpanExporter) error {
-	sampler := mkSampler(conf.SampleProbability)
+func mkOTLPExporter(ctx context.Context) (sampledExporter, error) {
+	otlpConf := conf.OTLP
+	if otlpConf == nil {
+		// Everything is configured through the OTEL_EXPORTER_OTLP_* environment variables.
+		otlpConf = &OTLPConf{}
+	}
 
-	if svcName == nil {
-		svcName = &util.AppName
+	exporter, err := newOTLPExporter(ctx, otlpConf)
+	if err != nil {
+		return sampledExporter{}, err
 	}
 
-	res, err := resource.New(context.Background(),
-		resource.WithAttributes(semconv.ServiceNameKey.String(*svcName)),
-		resource.WithProcessPID(),
-		resource.WithHost(),
-		resource.WithFromEnv())
+	return sampledExporter{exporter: exporter, probability: otlpConf.SampleProbability}, nil
+}
+
+func configureOtel(res *resource.Resource, exporters ...sampledExporter) error {
+	headProbability, processors := mkExportProcessors(conf.SampleProbability, conf.StartupBuffer, conf.Batch, conf.KeepFailedSpans, exporters)
+	// Unsampled spans must be recorded for the span metrics to count them and to be able to export them if they fail.
+	sampler := mkSampler(headProbability, conf.SpanMetrics || conf.KeepFailedSpans, conf.ResourceKindSampleProbabilities)
+
+	propagator, err := mkPropagator(conf.Propagators, conf.B3InjectEncoding)
 	if err != nil {
-		return fmt.Errorf("failed to initialize otel resource: %w", err)
+		return err
 	}
 
-	traceProvider := tracesdk.NewTracerProvider(
-		tracesdk.WithBatcher(exporter),
+	providerOpts := []tracesdk.TracerProviderOption{
 		tracesdk.WithSampler(sampler),
 		tracesdk.WithResource(res),
-	)
+	}
 
-	otel.SetErrorHandler(otelErrHandler(func(err error) {
-		// this is a harmless error message that occurs because Otel doesn't recognise
-		// the OpenCensus sampler. We can remove this check when OpenCensus is replaced.
-		if strings.Contains(err.Error(), "unsupported sampler:") {
-			return
+	for _, p := range processors {
+		providerOpts = append(providerOpts, tracesdk.WithSpanProcessor(mkRedactionProcessor(p, conf.Redaction)))
+	}
+
+	if conf.SpanMetrics {
+		providerOpts = append(providerOpts, tracesdk.WithSpanProcessor(spanMetricsProcessor{}))
+	}
+
+	tp := tracesdk.NewTracerProvider(providerOpts...)
+	excludingTP := mkExcludingProvider(tp, conf.excludeSpanPrefixes())
+
+	otel.SetErrorHandler(newOtelErrHandler(zap.L().Named("otel"), conf.ErrorHandler))
+
+	otel.SetTracerProvider(excludingTP)
+	otel.SetTextMapPropagator(propagator)
+	octrace.DefaultTracer = ocbridge.NewTracer(excludingTP.Tracer("cerbos"))
+
+	traceProvider.Store(tp)
+
+	return nil
+}
+
+// Shutdown flushes the pending spans and shuts down the trace provider, waiting at most for the configured shutdown timeout.
+// If the metrics are exported to the OTLP collector, the final metrics are exported as well within the same timeout.
+// It should be called after the server has stopped handling requests so that the spans produced by the in-flight requests are exported.
+func Shutdown(ctx context.Context) error {
+	tp := traceProvider.Swap(nil)
+	mp := meterProvider.Swap(nil)
+	if tp == nil && mp == nil {
+		return nil
+	}
+
+	timeout := conf.shutdownTimeout()
+	ctx, cancelFn := context.WithTimeout(ctx, timeout)
+	defer cancelFn()
+
+	var errs []error
+	if tp != nil {
+		if err := tp.Shutdown(ctx); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				errs = append(errs, fmt.Errorf("timed out after %s waiting for pending spans to be exported: %w", timeout, err))
+			} else {
+				errs = append(errs, fmt.Errorf("failed to cleanly shutdown trace provider: %w", err))
+			}
 		}
+	}
 
-		zap.L().Named("otel").Warn("OpenTelemetry error", zap.Error(err))
-	}))
+	if mp != nil {
+		if err := mp.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to cleanly shutdown meter provider: %w", err))
+		}
+	}
 
-	otel.SetTracerProvider(traceProvider)
-	otel.SetTextMapPropagator(autoprop.NewTextMapPropagator(otelprop.TraceContext{}, otelprop.Baggage{}, otelpropb3.New()))
-	octrace.DefaultTracer = ocbridge.NewTracer(traceProvider.Tracer("cerbos"))
+	return errors.Join(errs...)
+}
 
-	go func() {
-		<-ctx.Done()
-		// TODO (cell) Add hook to make the server wait until the trace provider shuts down cleanly.
+// Provider returns the trace provider created by InitFromConf, which backs the global trace provider.
+// Programs that embed Cerbos can use it to register their own instrumentation and, after asserting that it's a
+// *tracesdk.TracerProvider, to flush the pending spans on their own schedule. Unlike the global trace provider,
+// it doesn't leave out the spans excluded by excludeSpanPrefixes. It returns a noop provider if tracing
+// is disabled or has been shut down.
+func Provider() trace.TracerProvider {
+	if tp := traceProvider.Load(); tp != nil {
+		return tp
+	}
+
+	return trace.NewNoopTracerProvider()
+}
+
+// mkSampler creates the sampler for the trace provider. If recordUnsampled is true, spans that are not sampled are
+// still recorded (but not exported) so that the span metrics processor can observe them.
+// Spans of the requests that asked to be traced using the debug trace header are always sampled.
+func mkSampler(probability float64, recordUnsampled bool, kindProbabilities map[string]float64) tracesdk.Sampler {
+	s := sampler{s: tracesdk.NeverSample(), recordUnsampled: recordUnsampled}
+	if probability > 0.0 {
+		s.s = tracesdk.ParentBased(ratioSampler(probability))
+	}
 
-		if err := traceProvider.Shutdown(context.TODO()); err != nil {
-			zap.L().Warn("Failed to cleanly shutdown trace exporter", zap.Error(err))
+	if len(kindProbabilities) > 0 {
+		s.kindSamplers = make(map[string]tracesdk.Sampler, len(kindProbabilities))
+		for kind, p := range kindProbabilities {
+			// The spans of a kind are sampled at their own ratio even if the parent span was not sampled. Otherwise, the kinds with a
+			// higher probability would never be sampled when the request span is not. A sampled parent still keeps the trace whole.
+			ks := ratioSampler(p)
+			s.kindSamplers[kind] = tracesdk.ParentBased(ks, tracesdk.WithLocalParentNotSampled(ks), tracesdk.WithRemoteParentNotSampled(ks))
 		}
-	}()
+	}
 
-	return nil
+	return s
 }
 
-func mkSampler(probability float64) tracesdk.Sampler {
-	if probability == 0.0 {
+func ratioSampler(probability float64) tracesdk.Sampler {
+	switch {
+	case probability <= 0.0:
 		return tracesdk.NeverSample()
+	case probability >= 1.0:
+		return tracesdk.AlwaysSample()
+	default:
+		return tracesdk.TraceIDRatioBased(probability)
 	}
-
-	return sampler{s: tracesdk.ParentBased(tracesdk.TraceIDRatioBased(conf.SampleProbability))}
 }
 
 type sampler struct {
-	s tracesdk.Sampler
+	s               tracesdk.Sampler
+	kindSamplers    map[string]tracesdk.Sampler
+	recordUnsampled bool
 }
 
 func (s sampler) ShouldSample(params tracesdk.SamplingParameters) tracesdk.SamplingResult {
-	switch {
-	case strings.HasPrefix(params.Name, "grpc."):
-		return tracesdk.SamplingResult{Decision: tracesdk.Drop}
-	case strings.HasPrefix(params.Name, "cerbos.svc.v1.CerbosPlaygroundService."):
-		return tracesdk.SamplingResult{Decision: tracesdk.Drop}
-	case strings.HasPrefix(params.Name, "/api/playground/"):
-		return tracesdk.SamplingResult{Decision: tracesdk.Drop}
-	default:
-		return s.s.ShouldSample(params)
+	if isDebugTrace(params.ParentContext) {
+		return tracesdk.SamplingResult{
+			Decision:   tracesdk.RecordAndSample,
+			Tracestate: trace.SpanContextFromContext(params.ParentContext).TraceState(),
+		}
+	}
+
+	result := s.kindSampler(params.Attributes).ShouldSample(params)
+	if s.recordUnsampled && result.Decision == tracesdk.Drop {
+		result.Decision = tracesdk.RecordOnly
 	}
+	return result
+}
+
+// kindSampler returns the sampler configured for the resource kind in attrs or the default sampler if there isn't one.
+// Only the attributes passed to StartSpan are available at this point, so the resource kind must be set when the span is started.
+func (s sampler) kindSampler(attrs []attribute.KeyValue) tracesdk.Sampler {
+	if len(s.kindSamplers) == 0 {
+		return s.s
+	}
+
+	for _, attr := range attrs {
+		if attr.Key == resourceKindKey {
+			if ks, ok := s.kindSamplers[attr.Value.AsString()]; ok {
+				return ks
+			}
+			break
+		}
+	}
+
+	return s.s
 }
 
 func (s sampler) Description() string {
@@ -189,13 +338,66 @@ func (s sampler) Description() string {
 }
 
 func HTTPHandler(handler http.Handler, path string) http.Handler {
-	return otelhttp.NewHandler(handler, path)
+	instrumented := handler
+	if stampResponseHeaders {
+		instrumented = responseHeadersHandler(instrumented)
+	}
+
+	instrumented = otelhttp.NewHandler(instrumented, path)
+	if len(excludeHTTPPaths) > 0 {
+		instrumented = excludePathsHandler(instrumented, handler, excludeHTTPPaths)
+	}
+
+	if debugTraceEnabled {
+		instrumented = debugTraceHandler(instrumented)
+	}
+
+	return instrumented
 }
 
-func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
-	return otel.Tracer("cerbos.dev/cerbos").Start(ctx, name)
+// excludePathsHandler sends the requests for paths starting with any of the prefixes to the uninstrumented handler.
+// No span is started for those requests but the trace context is still extracted from the request headers so that it's propagated
+// to any downstream services.
+func excludePathsHandler(instrumented, uninstrumented http.Handler, prefixes []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				ctx := otel.GetTextMapPropagator().Extract(r.Context(), otelprop.HeaderCarrier(r.Header))
+				uninstrumented.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+		}
+
+		instrumented.ServeHTTP(w, r)
+	})
 }
 
+// responseHeadersHandler stamps the sampling decision of the span started by otelhttp onto the response headers.
+func responseHeadersHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sc := trace.SpanContextFromContext(r.Context())
+		if sc.IsSampled() {
+			w.Header().Set(traceIDHeader, sc.TraceID().String())
+			w.Header().Set(traceSampledHeader, "true")
+		} else {
+			w.Header().Set(traceSampledHeader, "false")
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// StartSpan starts a new span with the given attributes. Only the attributes set here are available to the sampler, so any attribute
+// that affects sampling (such as the resource kind) must be passed here instead of being set on the span afterwards.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if len(attrs) == 0 {
+		return otel.Tracer("cerbos.dev/cerbos").Start(ctx, name)
+	}
+
+	return otel.Tracer("cerbos.dev/cerbos").Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// MarkFailed records the error on the span and sets the span status from the HTTP status code.
 func MarkFailed(span trace.Span, code int, err error) {
 	if err != nil {
 		span.RecordError(err)
@@ -205,8 +407,67 @@ func MarkFailed(span trace.Span, code int, err error) {
 	span.SetStatus(c, desc)
 }
 
+// MarkFailedGRPC records the error on the span and sets the span status from the gRPC status code.
+func MarkFailedGRPC(span trace.Span, code grpccodes.Code, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	c, desc := grpcServerStatus(code)
+	span.SetStatus(c, desc)
+}
+
+// grpcServerStatus returns the span status for the gRPC status code returned by a server.
+// Like the HTTP client errors, the codes caused by the request are not considered to be server errors.
+func grpcServerStatus(code grpccodes.Code) (codes.Code, string) {
+	switch code {
+	case grpccodes.OK:
+		return codes.Ok, ""
+	case grpccodes.Unknown, grpccodes.DeadlineExceeded, grpccodes.Unimplemented, grpccodes.Internal, grpccodes.Unavailable, grpccodes.DataLoss:
+		return codes.Error, ""
+	case grpccodes.Canceled, grpccodes.InvalidArgument, grpccodes.NotFound, grpccodes.AlreadyExists, grpccodes.PermissionDenied,
+		grpccodes.ResourceExhausted, grpccodes.FailedPrecondition, grpccodes.Aborted, grpccodes.OutOfRange, grpccodes.Unauthenticated:
+		return codes.Unset, ""
+	default:
+		return codes.Error, fmt.Sprintf("Invalid gRPC status code %d", code)
+	}
+}
+
+// LogFields returns the trace_id and span_id log fields identifying the span in the context so that log entries can be correlated with traces.
+// It returns nil without allocating if there is no recording span in the context, which is always the case when tracing is disabled.
+func LogFields(ctx context.Context) []zap.Field {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return nil
+	}
+
+	sc := span.SpanContext()
+	return []zap.Field{zap.String(traceIDLogField, sc.TraceID().String()), zap.String(spanIDLogField, sc.SpanID().String())}
+}
+
 type otelErrHandler func(err error)
 
+func newOtelErrHandler(log *zap.Logger, conf ErrorHandlerConf) otelErrHandler {
+	// The level has already been validated when the configuration was loaded.
+	level, _ := conf.level()
+
+	return func(err error) {
+		// this is a harmless error message that occurs because Otel doesn't recognise
+		// the OpenCensus sampler. We can remove this check when OpenCensus is replaced.
+		if strings.Contains(err.Error(), "unsupported sampler:") {
+			return
+		}
+
+		if conf.Metric {
+			stats.Record(context.Background(), metrics.TracingErrorCount.M(1))
+		}
+
+		if ce := log.Check(level, "OpenTelemetry error"); ce != nil {
+			ce.Write(zap.Error(err))
+		}
+	}
+}
+
 func (o otelErrHandler) Handle(err error) {
 	o(err)
 }
@@ -5,10 +5,24 @@ package tracing_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
-	"github.com/cerbos/cerbos/internal/observability/tracing"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	grpccodes "google.golang.org/grpc/codes"
+
+	"github.com/cerbos/cerbos/internal/observability/tracing"
 )
 
 func TestTracingInit(t *testing.T) {
@@ -25,3 +39,301 @@ func TestTracingInit(t *testing.T) {
 
 	require.NoError(t, tracing.InitFromConf(ctx, conf))
 }
+
+func TestShutdown(t *testing.T) {
+	exports := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		exports <- struct{}{}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	conf := tracing.Conf{
+		Exporter:          "otlp",
+		SampleProbability: 1.0,
+		ShutdownTimeout:   5 * time.Second,
+		OTLP: &tracing.OTLPConf{
+			Protocol:          "http/protobuf",
+			CollectorEndpoint: srv.URL,
+		},
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	require.NoError(t, tracing.InitFromConf(ctx, conf))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	_, span := otel.Tracer("test").Start(ctx, "span")
+	span.End()
+	cancelFn()
+
+	require.NoError(t, tracing.Shutdown(context.Background()))
+	select {
+	case <-exports:
+	default:
+		require.Fail(t, "Pending spans were not exported on shutdown")
+	}
+
+	require.NoError(t, tracing.Shutdown(context.Background()), "Repeated shutdown should be a no-op")
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		// An empty exporter disables tracing implicitly and none disables it explicitly.
+		for _, exporter := range []string{"", "none"} {
+			require.NoError(t, tracing.InitFromConf(ctx, tracing.Conf{Exporter: exporter, SampleProbability: 1.0}))
+
+			provider := tracing.Provider()
+			require.NotNil(t, provider)
+
+			_, span := provider.Tracer("embedder").Start(ctx, "embedder.Operation")
+			require.False(t, span.IsRecording())
+			span.End()
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		require.NoError(t, tracing.InitFromConf(ctx, tracing.Conf{Exporter: "jaeger", SampleProbability: 1.0, Jaeger: &tracing.JaegerConf{AgentEndpoint: "localhost:6900"}}))
+		t.Cleanup(func() {
+			otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		})
+
+		provider := tracing.Provider()
+		sdkProvider, ok := provider.(*tracesdk.TracerProvider)
+		require.True(t, ok)
+
+		_, span := provider.Tracer("embedder").Start(ctx, "embedder.Operation")
+		require.True(t, span.IsRecording())
+		span.End()
+		require.NoError(t, sdkProvider.ForceFlush(ctx))
+
+		require.NoError(t, tracing.Shutdown(ctx))
+		_, span = tracing.Provider().Tracer("embedder").Start(ctx, "embedder.Operation")
+		defer span.End()
+		require.False(t, span.IsRecording())
+	})
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-unblock
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(unblock) })
+
+	conf := tracing.Conf{
+		Exporter:          "otlp",
+		SampleProbability: 1.0,
+		ShutdownTimeout:   100 * time.Millisecond,
+		OTLP: &tracing.OTLPConf{
+			Protocol:          "http/protobuf",
+			CollectorEndpoint: srv.URL,
+		},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, tracing.InitFromConf(ctx, conf))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	_, span := otel.Tracer("test").Start(ctx, "span")
+	span.End()
+
+	start := time.Now()
+	err := tracing.Shutdown(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestResponseHeaders(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	t.Cleanup(cancelFn)
+
+	require.NoError(t, tracing.InitFromConf(ctx, tracing.Conf{ResponseHeaders: true}))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	doRequest := func(t *testing.T) *http.Response {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		tracing.HTTPHandler(handler, "/api").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/check", http.NoBody))
+
+		return rec.Result()
+	}
+
+	t.Run("sampled", func(t *testing.T) {
+		otel.SetTracerProvider(tracesdk.NewTracerProvider(tracesdk.WithSampler(tracesdk.AlwaysSample())))
+
+		resp := doRequest(t)
+		require.Equal(t, "true", resp.Header.Get("X-Trace-Sampled"))
+
+		traceID, err := trace.TraceIDFromHex(resp.Header.Get("X-Trace-Id"))
+		require.NoError(t, err)
+		require.True(t, traceID.IsValid())
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		otel.SetTracerProvider(tracesdk.NewTracerProvider(tracesdk.WithSampler(tracesdk.NeverSample())))
+
+		resp := doRequest(t)
+		require.Equal(t, "false", resp.Header.Get("X-Trace-Sampled"))
+		require.Empty(t, resp.Header.Values("X-Trace-Id"))
+	})
+}
+
+func TestLogFields(t *testing.T) {
+	t.Run("recording_span", func(t *testing.T) {
+		provider := tracesdk.NewTracerProvider()
+		t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+		ctx, span := provider.Tracer("test").Start(context.Background(), "test")
+		defer span.End()
+
+		have := tracing.LogFields(ctx)
+		require.Equal(t, []zap.Field{
+			zap.String("trace_id", span.SpanContext().TraceID().String()),
+			zap.String("span_id", span.SpanContext().SpanID().String()),
+		}, have)
+	})
+
+	t.Run("no_span", func(t *testing.T) {
+		require.Nil(t, tracing.LogFields(context.Background()))
+	})
+
+	t.Run("noop_provider", func(t *testing.T) {
+		ctx, span := trace.NewNoopTracerProvider().Tracer("test").Start(context.Background(), "test")
+		defer span.End()
+
+		var have []zap.Field
+		allocs := testing.AllocsPerRun(100, func() {
+			have = tracing.LogFields(ctx)
+		})
+		require.Zero(t, allocs)
+		require.Nil(t, have)
+	})
+}
+
+func TestMarkFailed(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(recorder))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	tracer := provider.Tracer("test")
+
+	status := func(t *testing.T, markFn func(trace.Span)) tracesdk.Status {
+		t.Helper()
+
+		_, span := tracer.Start(context.Background(), t.Name())
+		markFn(span)
+		span.End()
+
+		spans := recorder.Ended()
+		s := spans[len(spans)-1]
+		require.Equal(t, t.Name(), s.Name())
+		require.Len(t, s.Events(), 1)
+
+		return s.Status()
+	}
+
+	err := errors.New("failed")
+
+	t.Run("http", func(t *testing.T) {
+		testCases := []struct {
+			code int
+			want codes.Code
+		}{
+			{code: http.StatusBadRequest, want: codes.Unset},
+			{code: http.StatusNotFound, want: codes.Unset},
+			{code: http.StatusInternalServerError, want: codes.Error},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(strconv.Itoa(tc.code), func(t *testing.T) {
+				have := status(t, func(span trace.Span) { tracing.MarkFailed(span, tc.code, err) })
+				require.Equal(t, tc.want, have.Code)
+			})
+		}
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		testCases := []struct {
+			code grpccodes.Code
+			want codes.Code
+		}{
+			{code: grpccodes.OK, want: codes.Ok},
+			{code: grpccodes.NotFound, want: codes.Unset},
+			{code: grpccodes.InvalidArgument, want: codes.Unset},
+			{code: grpccodes.Internal, want: codes.Error},
+			{code: grpccodes.Unavailable, want: codes.Error},
+			{code: grpccodes.Code(99), want: codes.Error},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.code.String(), func(t *testing.T) {
+				have := status(t, func(span trace.Span) { tracing.MarkFailedGRPC(span, tc.code, err) })
+				require.Equal(t, tc.want, have.Code)
+			})
+		}
+	})
+}
+
+func TestExcludeHTTPPaths(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	t.Cleanup(cancelFn)
+
+	require.NoError(t, tracing.InitFromConf(ctx, tracing.Conf{ExcludeHTTPPaths: []string{"/_cerbos/"}}))
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := tracesdk.NewTracerProvider(tracesdk.WithSampler(tracesdk.AlwaysSample()), tracesdk.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(prevPropagator)
+		require.NoError(t, tracing.InitFromConf(context.Background(), tracing.Conf{}))
+	})
+
+	var downstream propagation.MapCarrier
+	handler := tracing.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downstream = propagation.MapCarrier{}
+		otel.GetTextMapPropagator().Inject(r.Context(), downstream)
+		w.WriteHeader(http.StatusOK)
+	}), "/api")
+
+	const traceParent = "00-0102030405060708090a0b0c0d0e0f10-0102030405060708-01"
+
+	doRequest := func(path string) {
+		req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+		req.Header.Set("traceparent", traceParent)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	t.Run("excluded", func(t *testing.T) {
+		doRequest("/_cerbos/health")
+		require.Empty(t, recorder.Ended())
+		// The incoming trace context must be passed on unchanged.
+		require.Equal(t, traceParent, downstream.Get("traceparent"))
+	})
+
+	t.Run("included", func(t *testing.T) {
+		doRequest("/api/check")
+		require.Len(t, recorder.Ended(), 1)
+		require.Equal(t, "0102030405060708090a0b0c0d0e0f10", recorder.Ended()[0].SpanContext().TraceID().String())
+		require.NotEqual(t, traceParent, downstream.Get("traceparent"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		c := tracing.Conf{ExcludeHTTPPaths: []string{"_cerbos"}}
+		require.ErrorContains(t, c.Validate(), `invalid tracing.excludeHTTPPaths entry "_cerbos"`)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/exporters/zipkin"
+)
+
+func mkZipkinExporter() (sampledExporter, error) {
+	var opts []zipkin.Option
+	if len(conf.Zipkin.Headers) > 0 {
+		opts = append(opts, zipkin.WithClient(&http.Client{
+			Transport: headerTransport{headers: conf.Zipkin.Headers, next: http.DefaultTransport},
+		}))
+	}
+
+	exporter, err := zipkin.New(conf.Zipkin.CollectorEndpoint, opts...)
+	if err != nil {
+		return sampledExporter{}, fmt.Errorf("failed to create Zipkin exporter: %w", err)
+	}
+
+	return sampledExporter{exporter: exporter, probability: conf.Zipkin.SampleProbability}, nil
+}
+
+// headerTransport adds static headers to every request.
+type headerTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (ht headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range ht.headers {
+		req.Header.Set(k, v)
+	}
+
+	return ht.next.RoundTrip(req)
+}
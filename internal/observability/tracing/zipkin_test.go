@@ -0,0 +1,71 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cerbos/cerbos/internal/observability/tracing"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+func TestZipkinExporter(t *testing.T) {
+	type export struct {
+		tenant       string
+		serviceNames []string
+	}
+
+	exports := make(chan export, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var spans []struct {
+			LocalEndpoint struct {
+				ServiceName string `json:"serviceName"`
+			} `json:"localEndpoint"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&spans); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		e := export{tenant: r.Header.Get("X-Scope-OrgID")}
+		for _, s := range spans {
+			e.serviceNames = append(e.serviceNames, s.LocalEndpoint.ServiceName)
+		}
+
+		exports <- e
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	conf := tracing.Conf{
+		Exporter:          "zipkin",
+		SampleProbability: 1.0,
+		Zipkin: &tracing.ZipkinConf{
+			CollectorEndpoint: srv.URL + "/api/v2/spans",
+			Headers:           map[string]string{"X-Scope-OrgID": "tenant-1"},
+		},
+	}
+	require.NoError(t, conf.Validate())
+
+	ctx := context.Background()
+	require.NoError(t, tracing.InitFromConf(ctx, conf))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	_, span := otel.Tracer("test").Start(ctx, "span")
+	span.End()
+	require.NoError(t, tracing.Shutdown(ctx))
+
+	have := <-exports
+	require.Equal(t, "tenant-1", have.tenant)
+	require.Equal(t, []string{util.AppName}, have.serviceNames)
+}
@@ -3,12 +3,17 @@
 
 package schema
 
-import "github.com/cerbos/cerbos/internal/config"
+import (
+	"fmt"
+
+	"github.com/cerbos/cerbos/internal/config"
+)
 
 const (
-	confKey            = "schema"
-	defaultEnforcement = EnforcementNone
-	defaultCacheSize   = 1024
+	confKey                     = "schema"
+	defaultEnforcement          = EnforcementNone
+	defaultCacheSize            = 1024
+	defaultAdditionalProperties = AdditionalPropertiesReject
 )
 
 // Conf is optional configuration for schema validation.
@@ -17,6 +22,8 @@ type Conf struct {
 	Enforcement Enforcement `yaml:"enforcement" conf:",example=reject"`
 	// CacheSize defines the number of schemas to cache in memory.
 	CacheSize uint `yaml:"cacheSize" conf:",example=1024"`
+	// AdditionalProperties defines how attributes that are not allowed by schemas with additionalProperties set to false are handled. Possible values are reject, warn, allow. Declared attributes are always validated.
+	AdditionalProperties AdditionalPropertiesMode `yaml:"additionalProperties" conf:",example=reject"`
 }
 
 func (c *Conf) Key() string {
@@ -26,6 +33,16 @@ func (c *Conf) Key() string {
 func (c *Conf) SetDefaults() {
 	c.Enforcement = defaultEnforcement
 	c.CacheSize = defaultCacheSize
+	c.AdditionalProperties = defaultAdditionalProperties
+}
+
+func (c *Conf) Validate() error {
+	switch c.AdditionalProperties {
+	case AdditionalPropertiesReject, AdditionalPropertiesWarn, AdditionalPropertiesAllow:
+		return nil
+	default:
+		return fmt.Errorf("schema.additionalProperties must be one of [%s, %s, %s]", AdditionalPropertiesReject, AdditionalPropertiesWarn, AdditionalPropertiesAllow)
+	}
 }
 
 // Enforcement level for schema validation.
@@ -37,6 +54,15 @@ const (
 	EnforcementReject Enforcement = "reject" // In case schema is not validated, reject.
 )
 
+// AdditionalPropertiesMode determines how attributes that are not declared in a schema are handled.
+type AdditionalPropertiesMode string
+
+const (
+	AdditionalPropertiesReject AdditionalPropertiesMode = "reject" // Report undeclared attributes as validation errors.
+	AdditionalPropertiesWarn   AdditionalPropertiesMode = "warn"   // Ignore undeclared attributes but log a warning.
+	AdditionalPropertiesAllow  AdditionalPropertiesMode = "allow"  // Ignore undeclared attributes.
+)
+
 func NewConf(enforcement Enforcement) *Conf {
 	c := &Conf{}
 	c.SetDefaults()
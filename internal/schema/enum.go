@@ -0,0 +1,83 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+
+	jsonschema "github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// EnumAttributes maps the dotted path of an attribute (e.g. "status" or "owner.team") to the values allowed by the enum defined for it in the schema.
+type EnumAttributes map[string][]any
+
+// Allows returns false if the attribute has an enum defined and the value is not one of its members.
+// Numbers are compared by value regardless of their type.
+func (ea EnumAttributes) Allows(attr string, value any) bool {
+	allowed, ok := ea[attr]
+	if !ok {
+		return true
+	}
+
+	value = normalizeEnumValue(value)
+	for _, a := range allowed {
+		if a == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ea EnumAttributes) collect(prefix string, s *jsonschema.Schema, seen map[*jsonschema.Schema]struct{}) {
+	if s == nil {
+		return
+	}
+
+	if _, ok := seen[s]; ok {
+		return
+	}
+
+	seen[s] = struct{}{}
+	defer delete(seen, s)
+
+	if prefix != "" && len(s.Enum) > 0 {
+		values := make([]any, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = normalizeEnumValue(v)
+		}
+		ea[prefix] = values
+	}
+
+	ea.collect(prefix, s.Ref, seen)
+	for _, sub := range s.AllOf {
+		ea.collect(prefix, sub, seen)
+	}
+
+	for name, prop := range s.Properties {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		ea.collect(path, prop, seen)
+	}
+}
+
+func normalizeEnumValue(v any) any {
+	switch n := v.(type) {
+	case json.Number:
+		if f, err := n.Float64(); err == nil {
+			return f
+		}
+		return n.String()
+	case int64:
+		return float64(n)
+	case uint64:
+		return float64(n)
+	case int:
+		return float64(n)
+	default:
+		return v
+	}
+}
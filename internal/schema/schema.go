@@ -49,6 +49,7 @@ type Manager interface {
 	ValidateCheckInput(context.Context, *policyv1.Schemas, *enginev1.CheckInput) (*ValidationResult, error)
 	ValidatePlanResourcesInput(context.Context, *policyv1.Schemas, *enginev1.PlanResourcesInput) (*ValidationResult, error)
 	CheckSchema(context.Context, string) error
+	EnumAttributes(context.Context, string) (EnumAttributes, error)
 }
 
 type Loader interface {
@@ -73,6 +74,10 @@ func (NopManager) CheckSchema(_ context.Context, _ string) error {
 	return nil
 }
 
+func (NopManager) EnumAttributes(_ context.Context, _ string) (EnumAttributes, error) {
+	return nil, nil
+}
+
 type manager struct {
 	conf   *Conf
 	log    *zap.Logger
@@ -113,6 +118,18 @@ func (m *manager) CheckSchema(ctx context.Context, url string) error {
 	return err
 }
 
+func (m *manager) EnumAttributes(ctx context.Context, url string) (EnumAttributes, error) {
+	s, err := m.loadSchema(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	enums := make(EnumAttributes)
+	enums.collect("", s, make(map[*jsonschema.Schema]struct{}))
+
+	return enums, nil
+}
+
 func (m *manager) ValidateCheckInput(ctx context.Context, schemas *policyv1.Schemas, input *enginev1.CheckInput) (*ValidationResult, error) {
 	log := logging.FromContext(ctx).With(zap.Any("input", input))
 	return m.validate(ctx, log, schemas, input.Principal.Attr, input.Resource.Attr, input.Actions, nil)
@@ -135,7 +152,8 @@ func (m *manager) validate(ctx context.Context, log *zap.Logger, schemas *policy
 	ctx, span := tracing.StartSpan(ctx, "schema.Validate")
 	defer span.End()
 
-	if err := m.validateAttr(ctx, ErrSourcePrincipal, schemas.PrincipalSchema, principalAttr, actions, nil); err != nil {
+	principalErrorFilter := m.additionalPropertiesFilter(log, ErrSourcePrincipal, schemas.PrincipalSchema, nil)
+	if err := m.validateAttr(ctx, ErrSourcePrincipal, schemas.PrincipalSchema, principalAttr, actions, principalErrorFilter); err != nil {
 		var principalErrs ValidationErrorList
 		if ok := errors.As(err, &principalErrs); !ok {
 			return result, fmt.Errorf("failed to validate the principal: %w", err)
@@ -143,6 +161,7 @@ func (m *manager) validate(ctx context.Context, log *zap.Logger, schemas *policy
 		result.add(principalErrs...)
 	}
 
+	resourceErrorFilter = m.additionalPropertiesFilter(log, ErrSourceResource, schemas.ResourceSchema, resourceErrorFilter)
 	if err := m.validateAttr(ctx, ErrSourceResource, schemas.ResourceSchema, resourceAttr, actions, resourceErrorFilter); err != nil {
 		var resourceErrs ValidationErrorList
 		if ok := errors.As(err, &resourceErrs); !ok {
@@ -158,6 +177,35 @@ func (m *manager) validate(ctx context.Context, log *zap.Logger, schemas *policy
 	return result, nil
 }
 
+// additionalPropertiesFilter wraps the given filter to drop errors about attributes that are not allowed by the schema
+// if the configuration or the schema reference permits them.
+func (m *manager) additionalPropertiesFilter(log *zap.Logger, src ErrSource, schemaRef *policyv1.Schemas_Schema, next validationErrorFilter) validationErrorFilter {
+	mode := m.conf.AdditionalProperties
+	if schemaRef.GetAllowAdditionalProperties() {
+		mode = AdditionalPropertiesAllow
+	}
+
+	if mode != AdditionalPropertiesWarn && mode != AdditionalPropertiesAllow {
+		return next
+	}
+
+	return func(err *jsonschema.ValidationError) bool {
+		if !strings.HasSuffix(err.KeywordLocation, "/additionalProperties") {
+			return next == nil || next(err)
+		}
+
+		if mode == AdditionalPropertiesWarn {
+			log.Warn("Ignoring attributes not declared in schema",
+				zap.String("schema", schemaRef.GetRef()),
+				zap.String("source", string(src)),
+				zap.String("path", err.InstanceLocation),
+				zap.String("error", err.Message))
+		}
+
+		return false
+	}
+}
+
 func (m *manager) validateAttr(ctx context.Context, src ErrSource, schemaRef *policyv1.Schemas_Schema, attr map[string]*structpb.Value, actions []string, errorFilter validationErrorFilter) error {
 	if schemaRef == nil || schemaRef.Ref == "" {
 		return nil
@@ -255,6 +303,21 @@ func (m *manager) loadSchemaFromStore(ctx context.Context, schemaURL string) (*j
 	return compiler.Compile(schemaURL)
 }
 
+// IDFromURL returns the store identifier of the schema referenced by the given URL.
+// An empty string is returned if the URL does not refer to a schema in the Cerbos store.
+func IDFromURL(schemaURL string) string {
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return ""
+	}
+
+	if u.Scheme != "" && u.Scheme != URLScheme {
+		return ""
+	}
+
+	return strings.TrimPrefix(u.Path, "/")
+}
+
 func (m *manager) SubscriberID() string {
 	return "schema.manager"
 }
@@ -14,10 +14,17 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
 	privatev1 "github.com/cerbos/cerbos/api/genpb/cerbos/private/v1"
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
+	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
@@ -143,6 +150,75 @@ func TestValidate(t *testing.T) {
 	})
 }
 
+func TestAdditionalProperties(t *testing.T) {
+	schemas := &policyv1.Schemas{ResourceSchema: &policyv1.Schemas_Schema{Ref: "cerbos:///strict_document.json"}}
+	input := &enginev1.CheckInput{
+		Actions:   []string{"view"},
+		Principal: &enginev1.Principal{Id: "john", Roles: []string{"user"}},
+		Resource: &enginev1.Resource{
+			Kind: "document",
+			Id:   "doc1",
+			Attr: map[string]*structpb.Value{
+				"owner":      structpb.NewStringValue("john"),
+				"status":     structpb.NewStringValue("archived"),
+				"department": structpb.NewStringValue("marketing"),
+			},
+		},
+	}
+
+	statusErr := &schemav1.ValidationError{Path: "/status", Message: `value must be one of "draft", "published"`, Source: schemav1.ValidationError_SOURCE_RESOURCE}
+	extraErr := &schemav1.ValidationError{Path: "", Message: "additionalProperties 'department' not allowed", Source: schemav1.ValidationError_SOURCE_RESOURCE}
+
+	testCases := []struct {
+		mode     schema.AdditionalPropertiesMode
+		wantErrs []*schemav1.ValidationError
+		wantWarn bool
+	}{
+		{mode: schema.AdditionalPropertiesReject, wantErrs: []*schemav1.ValidationError{statusErr, extraErr}},
+		{mode: schema.AdditionalPropertiesWarn, wantErrs: []*schemav1.ValidationError{statusErr}, wantWarn: true},
+		{mode: schema.AdditionalPropertiesAllow, wantErrs: []*schemav1.ValidationError{statusErr}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.mode), func(t *testing.T) {
+			conf := schema.NewConf(schema.EnforcementReject)
+			conf.AdditionalProperties = tc.mode
+			require.NoError(t, conf.Validate())
+
+			mgr := schema.NewFromConf(context.Background(), mkStore(t), conf)
+
+			core, logs := observer.New(zapcore.WarnLevel)
+			ctx := logging.ToContext(context.Background(), zap.New(core))
+
+			have, err := mgr.ValidateCheckInput(ctx, schemas, input)
+			require.NoError(t, err)
+			require.True(t, have.Reject)
+
+			wantErrs := &privatev1.ValidationErrContainer{Errors: tc.wantErrs}
+			haveErrs := &privatev1.ValidationErrContainer{Errors: have.Errors.SchemaErrors()}
+			require.Empty(t, cmp.Diff(wantErrs, haveErrs,
+				protocmp.Transform(),
+				protocmp.SortRepeated(cmpValidationError),
+			))
+
+			warnings := logs.FilterMessage("Ignoring attributes not declared in schema")
+			if tc.wantWarn {
+				require.Equal(t, 1, warnings.Len())
+				require.Equal(t, extraErr.Message, warnings.All()[0].ContextMap()["error"])
+			} else {
+				require.Zero(t, warnings.Len())
+			}
+		})
+	}
+
+	t.Run("invalid_mode", func(t *testing.T) {
+		conf := schema.NewConf(schema.EnforcementReject)
+		conf.AdditionalProperties = "ignore"
+		require.Error(t, conf.Validate())
+	})
+}
+
 func validate(mgr schema.Manager, tc *privatev1.SchemaTestCase) (*schema.ValidationResult, error) {
 	switch tc.Input.(type) {
 	case *privatev1.SchemaTestCase_CheckInput:
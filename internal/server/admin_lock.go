@@ -0,0 +1,176 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/namer"
+)
+
+// adminLockTimeout is how long a mutating Admin API call waits for concurrent calls modifying the same items to finish.
+const adminLockTimeout = 5 * time.Second
+
+var errAdminLockContention = status.Error(codes.Aborted, "Another admin operation is modifying the same policies or schemas. Retry the request later.")
+
+// credentialsChecker is implemented by the services that authenticate their callers.
+type credentialsChecker interface {
+	CheckCredentials(context.Context) error
+}
+
+// adminMutexUnaryServerInterceptor serializes the mutating Admin API calls that target the same policies or schemas.
+// Calls that touch disjoint sets of items and read-only calls are not affected.
+// Callers are authenticated before taking the locks so that unauthenticated requests can't hold up legitimate ones.
+func adminMutexUnaryServerInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	locks := newKeyedLocks()
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		keys := adminMutationKeys(req)
+		if len(keys) == 0 {
+			return handler(ctx, req)
+		}
+
+		if cc, ok := info.Server.(credentialsChecker); ok {
+			if err := cc.CheckCredentials(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		lockCtx, cancelFunc := context.WithTimeout(ctx, timeout)
+		release, err := locks.acquire(lockCtx, keys)
+		cancelFunc()
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, status.FromContextError(ctxErr).Err()
+			}
+			return nil, errAdminLockContention
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+func adminMutationKeys(req any) []string {
+	switch r := req.(type) {
+	case *requestv1.AddOrUpdatePolicyRequest:
+		keys := make([]string, len(r.Policies))
+		for i, p := range r.Policies {
+			keys[i] = "policy:" + namer.PolicyKey(p)
+		}
+		return keys
+	case *requestv1.DisablePolicyRequest:
+		return prefixKeys("policy:", r.Id)
+	case *requestv1.EnablePolicyRequest:
+		return prefixKeys("policy:", r.Id)
+	case *requestv1.AddOrUpdateSchemaRequest:
+		keys := make([]string, len(r.Schemas))
+		for i, s := range r.Schemas {
+			keys[i] = "schema:" + s.Id
+		}
+		return keys
+	case *requestv1.DeleteSchemaRequest:
+		return prefixKeys("schema:", r.Id)
+	default:
+		return nil
+	}
+}
+
+func prefixKeys(prefix string, ids []string) []string {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = prefix + id
+	}
+
+	return keys
+}
+
+// keyedLocks is a set of mutexes identified by name. Unused mutexes are removed to keep the map from growing indefinitely.
+type keyedLocks struct {
+	locks map[string]*keyedLock
+	mu    sync.Mutex
+}
+
+type keyedLock struct {
+	sem  chan struct{}
+	refs int
+}
+
+func newKeyedLocks() *keyedLocks {
+	return &keyedLocks{locks: make(map[string]*keyedLock)}
+}
+
+// acquire locks all the given keys or none of them. Keys are locked in sorted order to avoid deadlocks between calls with overlapping keys.
+func (kl *keyedLocks) acquire(ctx context.Context, keys []string) (func(), error) {
+	sorted := make([]string, len(keys))
+	copy(sorted, keys)
+	sort.Strings(sorted)
+
+	var held []string
+	release := func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			kl.unlock(held[i])
+		}
+	}
+
+	for i, key := range sorted {
+		if i > 0 && key == sorted[i-1] {
+			continue
+		}
+
+		if err := kl.lock(ctx, key); err != nil {
+			release()
+			return nil, err
+		}
+		held = append(held, key)
+	}
+
+	return release, nil
+}
+
+func (kl *keyedLocks) lock(ctx context.Context, key string) error {
+	kl.mu.Lock()
+	l, ok := kl.locks[key]
+	if !ok {
+		l = &keyedLock{sem: make(chan struct{}, 1)}
+		kl.locks[key] = l
+	}
+	l.refs++
+	kl.mu.Unlock()
+
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		kl.deref(key, l)
+		return ctx.Err()
+	}
+}
+
+func (kl *keyedLocks) unlock(key string) {
+	kl.mu.Lock()
+	l := kl.locks[key]
+	kl.mu.Unlock()
+
+	<-l.sem
+	kl.deref(key, l)
+}
+
+func (kl *keyedLocks) deref(key string, l *keyedLock) {
+	kl.mu.Lock()
+	defer kl.mu.Unlock()
+
+	l.refs--
+	if l.refs == 0 {
+		delete(kl.locks, key)
+	}
+}
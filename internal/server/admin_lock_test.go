@@ -0,0 +1,199 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestAdminMutexInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cerbos.svc.v1.CerbosAdminService/AddOrUpdatePolicy"}
+
+	mkReq := func(resources ...string) *requestv1.AddOrUpdatePolicyRequest {
+		req := &requestv1.AddOrUpdatePolicyRequest{}
+		for _, r := range resources {
+			req.Policies = append(req.Policies, test.NewResourcePolicyBuilder(r, "default").Build())
+		}
+		return req
+	}
+
+	t.Run("serializes_same_policy", func(t *testing.T) {
+		interceptor := adminMutexUnaryServerInterceptor(adminLockTimeout)
+
+		// Simulate a read-modify-write cycle in the store. Without mutual exclusion, concurrent calls would lose updates.
+		var mu sync.Mutex
+		version := 0
+		handler := func(_ context.Context, _ any) (any, error) {
+			mu.Lock()
+			current := version
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			version = current + 1
+			mu.Unlock()
+			return nil, nil
+		}
+
+		const numCalls = 10
+		g, ctx := errgroup.WithContext(context.Background())
+		for i := 0; i < numCalls; i++ {
+			g.Go(func() error {
+				_, err := interceptor(ctx, mkReq("leave_request"), info, handler)
+				return err
+			})
+		}
+
+		require.NoError(t, g.Wait())
+		require.Equal(t, numCalls, version)
+	})
+
+	t.Run("disjoint_policies_are_concurrent", func(t *testing.T) {
+		interceptor := adminMutexUnaryServerInterceptor(adminLockTimeout)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		handler := func(_ context.Context, _ any) (any, error) {
+			wg.Done()
+			// Both calls must be in the handler at the same time for this to return.
+			wg.Wait()
+			return nil, nil
+		}
+
+		g, ctx := errgroup.WithContext(context.Background())
+		g.Go(func() error {
+			_, err := interceptor(ctx, mkReq("leave_request"), info, handler)
+			return err
+		})
+		g.Go(func() error {
+			_, err := interceptor(ctx, mkReq("purchase_order"), info, handler)
+			return err
+		})
+
+		done := make(chan error, 1)
+		go func() { done <- g.Wait() }()
+
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Calls modifying different policies were serialized")
+		}
+	})
+
+	t.Run("aborts_on_contention", func(t *testing.T) {
+		interceptor := adminMutexUnaryServerInterceptor(50 * time.Millisecond)
+
+		entered := make(chan struct{})
+		unblock := make(chan struct{})
+		blockingHandler := func(_ context.Context, _ any) (any, error) {
+			close(entered)
+			<-unblock
+			return nil, nil
+		}
+
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := interceptor(context.Background(), mkReq("leave_request"), info, blockingHandler)
+			errCh <- err
+		}()
+		<-entered
+
+		called := false
+		_, err := interceptor(context.Background(), mkReq("purchase_order", "leave_request"), info, func(_ context.Context, _ any) (any, error) {
+			called = true
+			return nil, nil
+		})
+		require.Equal(t, codes.Aborted, status.Code(err))
+		require.False(t, called)
+
+		// Reads and calls for other items are not blocked.
+		_, err = interceptor(context.Background(), &requestv1.ListPoliciesRequest{}, info, func(_ context.Context, _ any) (any, error) { return nil, nil })
+		require.NoError(t, err)
+
+		_, err = interceptor(context.Background(), mkReq("purchase_order"), info, func(_ context.Context, _ any) (any, error) { return nil, nil })
+		require.NoError(t, err, "Locks held by an aborted call must be released")
+
+		close(unblock)
+		require.NoError(t, <-errCh)
+
+		_, err = interceptor(context.Background(), mkReq("leave_request"), info, func(_ context.Context, _ any) (any, error) { return nil, nil })
+		require.NoError(t, err)
+	})
+
+	t.Run("authenticates_before_locking", func(t *testing.T) {
+		interceptor := adminMutexUnaryServerInterceptor(time.Minute)
+		authInfo := &grpc.UnaryServerInfo{FullMethod: info.FullMethod, Server: fakeCredentialsChecker{}}
+
+		entered := make(chan struct{})
+		unblock := make(chan struct{})
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := interceptor(context.WithValue(context.Background(), fakeCredentialsKey{}, true), mkReq("leave_request"), authInfo, func(_ context.Context, _ any) (any, error) {
+				close(entered)
+				<-unblock
+				return nil, nil
+			})
+			errCh <- err
+		}()
+		<-entered
+
+		// Unauthenticated calls are turned away without waiting for the lock held by the authenticated call.
+		called := false
+		_, err := interceptor(context.Background(), mkReq("leave_request"), authInfo, func(_ context.Context, _ any) (any, error) {
+			called = true
+			return nil, nil
+		})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.False(t, called)
+
+		close(unblock)
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		require.Equal(t, []string{"policy:resource.leave_request.vdefault"}, adminMutationKeys(mkReq("leave_request")))
+		require.Equal(t, []string{"policy:resource.leave_request.vdefault"}, adminMutationKeys(&requestv1.DisablePolicyRequest{Id: []string{"resource.leave_request.vdefault"}}))
+		require.Equal(t, []string{"schema:leave_request.json"}, adminMutationKeys(&requestv1.DeleteSchemaRequest{Id: []string{"leave_request.json"}}))
+		require.Nil(t, adminMutationKeys(&requestv1.GetPolicyRequest{Id: []string{"resource.leave_request.vdefault"}}))
+		require.Nil(t, adminMutationKeys(&policyv1.Policy{}))
+	})
+}
+
+type fakeCredentialsKey struct{}
+
+type fakeCredentialsChecker struct{}
+
+func (fakeCredentialsChecker) CheckCredentials(ctx context.Context) error {
+	if ok, _ := ctx.Value(fakeCredentialsKey{}).(bool); !ok {
+		return status.Error(codes.Unauthenticated, "credentials required")
+	}
+
+	return nil
+}
+
+func TestKeyedLocksCleanup(t *testing.T) {
+	kl := newKeyedLocks()
+
+	release, err := kl.acquire(context.Background(), []string{"b", "a", "b"})
+	require.NoError(t, err)
+	require.Len(t, kl.locks, 2)
+
+	release()
+	require.Empty(t, kl.locks)
+}
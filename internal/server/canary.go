@@ -0,0 +1,159 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+)
+
+const canaryRequestID = "cerbos-canary"
+
+type checker interface {
+	Check(context.Context, []*enginev1.CheckInput, ...engine.CheckOpt) ([]*enginev1.CheckOutput, error)
+}
+
+// canary periodically evaluates a known check and marks the PDP as unhealthy while the decision deviates from the expected effect.
+type canary struct {
+	checker  checker
+	health   *health.Server
+	log      *zap.Logger
+	input    *enginev1.CheckInput
+	interval time.Duration
+	effect   effectv1.Effect
+}
+
+func newCanary(conf *CanaryConf, checker checker, healthSrv *health.Server) (*canary, error) {
+	effect, err := conf.expectedEffect()
+	if err != nil {
+		return nil, err
+	}
+
+	principalAttr, err := toStructpbMap(conf.Principal.Attr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid canary principal attributes: %w", err)
+	}
+
+	resourceAttr, err := toStructpbMap(conf.Resource.Attr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid canary resource attributes: %w", err)
+	}
+
+	resourceID := conf.Resource.ID
+	if resourceID == "" {
+		resourceID = canaryRequestID
+	}
+
+	return &canary{
+		checker:  checker,
+		health:   healthSrv,
+		log:      zap.L().Named("canary"),
+		interval: conf.interval(),
+		effect:   effect,
+		input: &enginev1.CheckInput{
+			RequestId: canaryRequestID,
+			Actions:   []string{conf.Action},
+			Principal: &enginev1.Principal{
+				Id:            conf.Principal.ID,
+				PolicyVersion: conf.Principal.PolicyVersion,
+				Roles:         conf.Principal.Roles,
+				Attr:          principalAttr,
+			},
+			Resource: &enginev1.Resource{
+				Kind:          conf.Resource.Kind,
+				Id:            resourceID,
+				PolicyVersion: conf.Resource.PolicyVersion,
+				Attr:          resourceAttr,
+			},
+		},
+	}, nil
+}
+
+func (c *canary) run(ctx context.Context) error {
+	c.log.Info(fmt.Sprintf("Running canary check every %s", c.interval))
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// check evaluates the canary input once and updates the health status accordingly.
+func (c *canary) check(ctx context.Context) bool {
+	ctx, cancelFunc := context.WithTimeout(ctx, c.interval)
+	defer cancelFunc()
+
+	outputs, err := c.checker.Check(ctx, []*enginev1.CheckInput{c.input})
+	if err != nil {
+		if ctx.Err() == nil {
+			c.log.Error("Canary check failed", zap.Error(err))
+			c.setHealthy(false)
+		}
+		return false
+	}
+
+	var have effectv1.Effect
+	if len(outputs) == 1 {
+		have = outputs[0].GetActions()[c.input.Actions[0]].GetEffect()
+	}
+
+	if have != c.effect {
+		c.log.Error("Canary check returned an unexpected effect", zap.Stringer("want", c.effect), zap.Stringer("have", have))
+		c.setHealthy(false)
+		return false
+	}
+
+	c.setHealthy(true)
+	return true
+}
+
+func (c *canary) setHealthy(healthy bool) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if !healthy {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+		stats.Record(context.Background(), metrics.CanaryFailureCount.M(1))
+	}
+
+	// The empty service name represents the overall health of the server.
+	c.health.SetServingStatus("", status)
+	c.health.SetServingStatus(svcv1.CerbosService_ServiceDesc.ServiceName, status)
+}
+
+func toStructpbMap(m map[string]any) (map[string]*structpb.Value, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	out := make(map[string]*structpb.Value, len(m))
+	for k, v := range m {
+		sv, err := structpb.NewValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", k, err)
+		}
+		out[k] = sv
+	}
+
+	return out, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/engine"
+)
+
+func TestCanary(t *testing.T) {
+	conf := &CanaryConf{
+		Principal: CanaryPrincipalConf{ID: "canary", Roles: []string{"user"}, Attr: map[string]any{"department": "engineering"}},
+		Resource:  CanaryResourceConf{Kind: "document", Attr: map[string]any{"owner": "canary"}},
+		Action:    "view",
+		Effect:    "EFFECT_ALLOW",
+	}
+
+	fc := &fakeChecker{effect: effectv1.Effect_EFFECT_ALLOW}
+	healthSrv := health.NewServer()
+
+	c, err := newCanary(conf, fc, healthSrv)
+	require.NoError(t, err)
+	require.Equal(t, defaultCanaryInterval, c.interval)
+
+	requireStatus := func(t *testing.T, want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+
+		for _, svc := range []string{"", svcv1.CerbosService_ServiceDesc.ServiceName} {
+			resp, err := healthSrv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: svc})
+			require.NoError(t, err)
+			require.Equal(t, want, resp.Status, "Unexpected status for service %q", svc)
+		}
+	}
+
+	t.Run("expected_effect", func(t *testing.T) {
+		require.True(t, c.check(context.Background()))
+		requireStatus(t, healthpb.HealthCheckResponse_SERVING)
+
+		require.NotNil(t, fc.input)
+		require.Equal(t, []string{"view"}, fc.input.Actions)
+		require.Equal(t, "canary", fc.input.Principal.Id)
+		require.Equal(t, "engineering", fc.input.Principal.Attr["department"].GetStringValue())
+		require.Equal(t, "document", fc.input.Resource.Kind)
+		require.Equal(t, canaryRequestID, fc.input.Resource.Id)
+		require.Equal(t, "canary", fc.input.Resource.Attr["owner"].GetStringValue())
+	})
+
+	t.Run("unexpected_effect", func(t *testing.T) {
+		fc.effect = effectv1.Effect_EFFECT_DENY
+		require.False(t, c.check(context.Background()))
+		requireStatus(t, healthpb.HealthCheckResponse_NOT_SERVING)
+	})
+
+	t.Run("recovery", func(t *testing.T) {
+		fc.effect = effectv1.Effect_EFFECT_ALLOW
+		require.True(t, c.check(context.Background()))
+		requireStatus(t, healthpb.HealthCheckResponse_SERVING)
+	})
+
+	t.Run("check_error", func(t *testing.T) {
+		fc.err = errors.New("boom")
+		require.False(t, c.check(context.Background()))
+		requireStatus(t, healthpb.HealthCheckResponse_NOT_SERVING)
+	})
+}
+
+type fakeChecker struct {
+	err    error
+	input  *enginev1.CheckInput
+	effect effectv1.Effect
+}
+
+func (fc *fakeChecker) Check(_ context.Context, inputs []*enginev1.CheckInput, _ ...engine.CheckOpt) ([]*enginev1.CheckOutput, error) {
+	if fc.err != nil {
+		return nil, fc.err
+	}
+
+	fc.input = inputs[0]
+	actions := make(map[string]*enginev1.CheckOutput_ActionEffect, len(inputs[0].Actions))
+	for _, a := range inputs[0].Actions {
+		actions[a] = &enginev1.CheckOutput_ActionEffect{Effect: fc.effect}
+	}
+
+	return []*enginev1.CheckOutput{{RequestId: inputs[0].RequestId, ResourceId: inputs[0].Resource.Id, Actions: actions}}, nil
+}
@@ -23,7 +23,15 @@ func init() {
 
 // Codec implements the grpc Codec interface to delegate encoding to VT where possible.
 type Codec struct {
-	vtcodec vtgrpc.Codec
+	vtcodec     vtgrpc.Codec
+	utf8Handler *invalidUTF8Handler
+}
+
+// newServerCodec creates the codec used by the server to decode requests. It decodes the messages that contain invalid UTF-8
+// with the invalid bytes replaced and hands them over to the invalidUTF8Handler to decide whether the request can proceed.
+// The VT decoders don't validate UTF-8, so the server codec always decodes with the standard decoder, which does.
+func newServerCodec(utf8Handler *invalidUTF8Handler) Codec {
+	return Codec{vtcodec: vtgrpc.Codec{}, utf8Handler: utf8Handler}
 }
 
 func (c Codec) Name() string {
@@ -43,13 +51,22 @@ func (c Codec) Marshal(v any) ([]byte, error) {
 }
 
 func (c Codec) Unmarshal(data []byte, v any) error {
-	if err := c.vtcodec.Unmarshal(data, v); err == nil {
-		return nil
+	if c.utf8Handler == nil {
+		if err := c.vtcodec.Unmarshal(data, v); err == nil {
+			return nil
+		}
 	}
 
 	vv, ok := v.(proto.Message)
 	if !ok {
 		return fmt.Errorf("failed to unmarshal, message is %T, want proto.Message", v)
 	}
-	return proto.Unmarshal(data, vv)
+
+	err := proto.Unmarshal(data, vv)
+	if err != nil && c.utf8Handler != nil && unmarshalSanitized(data, vv) {
+		c.utf8Handler.markSanitized(vv)
+		return nil
+	}
+
+	return err
 }
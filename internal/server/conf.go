@@ -7,12 +7,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"time"
 
 	"go.uber.org/multierr"
 	"golang.org/x/crypto/bcrypt"
 
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/util"
 )
@@ -21,6 +23,7 @@ const (
 	confKey                         = "server"
 	defaultAdminPassword            = "cerbosAdmin"
 	defaultAdminUsername            = "cerbos"
+	defaultCanaryInterval           = 1 * time.Minute
 	defaultGRPCConnectionTimeout    = 60 * time.Second
 	defaultGRPCListenAddr           = ":3593"
 	defaultGRPCMaxConcurrentStreams = 1024
@@ -32,15 +35,19 @@ const (
 	defaultHTTPReadTimeout          = 30 * time.Second
 	defaultHTTPWriteTimeout         = 30 * time.Second
 	defaultMaxActionsPerResource    = 50
+	defaultMaxAttributeDepth        = 32
 	defaultMaxResourcesPerRequest   = 50
+	defaultPlanCompressionMinSize   = 1024
 	defaultRawAdminPasswordHash     = "$2y$10$VlPwcwpgcGZ5KjTaN1Pzk.vpFiQVG6F2cSWzQa9RtrNo3IacbzsEi" //nolint:gosec
 	defaultUDSFileMode              = "0o766"
+	attributeDepthMax               = 100
 	requestItemsMax                 = 500
 )
 
 var (
 	defaultAdminPasswordHash = base64.StdEncoding.EncodeToString([]byte(defaultRawAdminPasswordHash))
 	errAdminCredsUndefined   = errors.New("admin credentials not defined")
+	metricsPrefixRegex       = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
 )
 
 // Conf is required configuration for the server.
@@ -55,12 +62,18 @@ type Conf struct {
 	GRPCListenAddr string `yaml:"grpcListenAddr" conf:"required,example=\":3593\""`
 	// UDSFileMode sets the file mode of the unix domain sockets created by the server.
 	UDSFileMode string `yaml:"udsFileMode" conf:",example=0o766"`
+	// GRPCUnixSocket configures a Unix domain socket to serve the gRPC API from in addition to GRPCListenAddr. Use it to avoid the TCP overhead for clients running on the same host, such as applications with a Cerbos sidecar.
+	GRPCUnixSocket *GRPCUnixSocketConf `yaml:"grpcUnixSocket"`
 	// CORS defines the CORS configuration for the server.
 	CORS CORSConf `yaml:"cors"`
 	// RequestLimits defines the limits for requests.
 	RequestLimits RequestLimitsConf `yaml:"requestLimits"`
 	// MetricsEnabled defines whether the metrics endpoint is enabled.
 	MetricsEnabled bool `yaml:"metricsEnabled" conf:",example=true"`
+	// MetricsPrefix is prepended to the names of all metrics served from the metrics endpoint. It must be a valid Prometheus metric name component.
+	MetricsPrefix string `yaml:"metricsPrefix" conf:",example=myorg_"`
+	// MetricsListener configures a dedicated listener for the metrics endpoint. When defined, metrics are no longer served from the HTTP API listener.
+	MetricsListener *MetricsListenerConf `yaml:"metricsListener"`
 	// LogRequestPayloads defines whether the request payloads should be logged.
 	LogRequestPayloads bool `yaml:"logRequestPayloads" conf:",example=false"`
 	// PlaygroundEnabled defines whether the playground API is enabled.
@@ -69,6 +82,8 @@ type Conf struct {
 	APIExplorerEnabled bool `yaml:"apiExplorerEnabled" conf:",example=true"`
 	// Advanced server settings.
 	Advanced AdvancedConf `yaml:"advanced"`
+	// Canary configures a check that is periodically evaluated in the background to verify that the PDP produces the expected decision. The health check fails while the decision deviates from the expected effect.
+	Canary *CanaryConf `yaml:"canary"`
 }
 
 // TLSConf holds TLS configuration.
@@ -81,6 +96,20 @@ type TLSConf struct {
 	CACert string `yaml:"caCert" conf:",example=/path/to/CA_certificate"`
 }
 
+type GRPCUnixSocketConf struct {
+	// Path is the path to create the socket at.
+	Path string `yaml:"path" conf:"required,example=/var/run/cerbos/grpc.sock"`
+	// FileMode sets the file mode of the socket. Defaults to the value of udsFileMode.
+	FileMode string `yaml:"fileMode" conf:",example=0o770"`
+}
+
+type MetricsListenerConf struct {
+	// ListenAddr is the address to serve the metrics endpoint on. Connections to this address are not encrypted or authenticated.
+	ListenAddr string `yaml:"listenAddr" conf:"required,example=\":3594\""`
+	// PprofEnabled defines whether the Go runtime profiling endpoints are served from the metrics listener under /debug/pprof/.
+	PprofEnabled bool `yaml:"pprofEnabled" conf:",example=false"`
+}
+
 type CORSConf struct {
 	// AllowedOrigins is the contents of the allowed-origins header.
 	AllowedOrigins []string `yaml:"allowedOrigins" conf:",example=['*']"`
@@ -135,13 +164,32 @@ type RequestLimitsConf struct {
 	MaxActionsPerResource uint `yaml:"maxActionsPerResource" conf:",example=50"`
 	// MaxResourcesPerBatch sets the maximum number of resources that could be sent in a single request.
 	MaxResourcesPerRequest uint `yaml:"maxResourcesPerRequest" conf:",example=50"`
+	// MaxAttributeDepth sets the maximum nesting depth of principal and resource attributes. A map of scalar values has a depth of 1 and each nested map or list adds a level. Must be between 1 and 100. Defaults to 32.
+	MaxAttributeDepth uint `yaml:"maxAttributeDepth" conf:",example=32"`
 }
 
 type AdvancedConf struct {
+	// ExpressionTraceEnabled allows clients to request the values of the sub-expressions of the deciding conditions to be recorded in decision log explanations by sending the cerbos-trace-expressions header with the value true. Has no effect unless audit.decisionLogExplanations is enabled. Intended for development only.
+	ExpressionTraceEnabled bool `yaml:"expressionTraceEnabled" conf:",example=false"`
 	// HTTP server settings.
 	HTTP AdvancedHTTPConf `yaml:"http"`
 	// GRPC server settings.
 	GRPC AdvancedGRPCConf `yaml:"grpc"`
+	// PlanCompression defines the compression settings for PlanResources responses.
+	PlanCompression PlanCompressionConf `yaml:"planCompression"`
+	// RequestTimeOverrideEnabled allows clients to set the time used for evaluating conditions in CheckResources requests by sending an RFC3339 timestamp in the cerbos-request-time header. Intended for reproducing past decisions. Do not enable in production unless all clients are trusted.
+	RequestTimeOverrideEnabled bool `yaml:"requestTimeOverrideEnabled" conf:",example=false"`
+	// InvalidUTF8 sets how request strings that are not valid UTF-8 are handled. Valid values are "reject" (default) to fail the request with an InvalidArgument error naming the offending field or attribute and "replace" to substitute the invalid bytes with the Unicode replacement character.
+	InvalidUTF8 string `yaml:"invalidUTF8" conf:",example=reject"`
+	// PolicyOverlay allows CheckResources and PlanResources requests to include policies that are evaluated on top of the stored policies for that request only. Requests with an overlay must be authenticated with the admin API credentials.
+	PolicyOverlay PolicyOverlayConf `yaml:"policyOverlay"`
+}
+
+type PolicyOverlayConf struct {
+	// Enabled defines whether CheckResources and PlanResources requests can include policy overlays.
+	Enabled bool `yaml:"enabled" conf:",example=false"`
+	// CacheSize is the number of compiled policy overlays to keep in memory. Defaults to 64.
+	CacheSize uint `yaml:"cacheSize" conf:",example=64"`
 }
 
 type AdvancedHTTPConf struct {
@@ -166,6 +214,93 @@ type AdvancedGRPCConf struct {
 	MaxConcurrentStreams uint32 `yaml:"maxConcurrentStreams" conf:",example=1024"`
 }
 
+type PlanCompressionConf struct {
+	// Disabled disables gzip compression of PlanResources responses.
+	Disabled bool `yaml:"disabled" conf:",example=false"`
+	// MinSizeBytes sets the minimum size of a PlanResources response to be eligible for compression. Smaller responses are sent uncompressed to avoid the overhead.
+	MinSizeBytes uint `yaml:"minSizeBytes" conf:",example=1024"`
+}
+
+type CanaryConf struct {
+	// Principal is the principal to use for the canary check.
+	Principal CanaryPrincipalConf `yaml:"principal"`
+	// Resource is the resource to use for the canary check.
+	Resource CanaryResourceConf `yaml:"resource"`
+	// Action is the action to check.
+	Action string `yaml:"action" conf:"required,example=view"`
+	// Effect is the expected effect of the canary check. Must be either EFFECT_ALLOW or EFFECT_DENY.
+	Effect string `yaml:"effect" conf:"required,example=EFFECT_ALLOW"`
+	// Interval is the time between canary checks. Defaults to 1 minute.
+	Interval time.Duration `yaml:"interval" conf:",example=60s"`
+}
+
+type CanaryPrincipalConf struct {
+	// Attr holds the principal attributes.
+	Attr map[string]any `yaml:"attr" conf:",example={\"department\": \"engineering\"}"`
+	// ID is the principal ID.
+	ID string `yaml:"id" conf:"required,example=canary"`
+	// PolicyVersion is the policy version to use for the principal.
+	PolicyVersion string `yaml:"policyVersion" conf:",example=default"`
+	// Roles are the principal roles.
+	Roles []string `yaml:"roles" conf:"required,example=['user']"`
+}
+
+type CanaryResourceConf struct {
+	// Attr holds the resource attributes.
+	Attr map[string]any `yaml:"attr" conf:",example={\"owner\": \"canary\"}"`
+	// ID is the resource ID.
+	ID string `yaml:"id" conf:",example=canary"`
+	// Kind is the resource kind.
+	Kind string `yaml:"kind" conf:"required,example=document"`
+	// PolicyVersion is the policy version to use for the resource.
+	PolicyVersion string `yaml:"policyVersion" conf:",example=default"`
+}
+
+func (cc *CanaryConf) validate() (errs error) {
+	if cc.Principal.ID == "" {
+		errs = multierr.Append(errs, errors.New("canary.principal.id must be defined"))
+	}
+
+	if len(cc.Principal.Roles) == 0 {
+		errs = multierr.Append(errs, errors.New("canary.principal.roles must contain at least one role"))
+	}
+
+	if cc.Resource.Kind == "" {
+		errs = multierr.Append(errs, errors.New("canary.resource.kind must be defined"))
+	}
+
+	if cc.Action == "" {
+		errs = multierr.Append(errs, errors.New("canary.action must be defined"))
+	}
+
+	if _, err := cc.expectedEffect(); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	if cc.Interval < 0 {
+		errs = multierr.Append(errs, errors.New("canary.interval must not be negative"))
+	}
+
+	return errs
+}
+
+func (cc *CanaryConf) expectedEffect() (effectv1.Effect, error) {
+	switch effect := effectv1.Effect(effectv1.Effect_value[cc.Effect]); effect {
+	case effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY:
+		return effect, nil
+	default:
+		return effect, fmt.Errorf("invalid canary.effect %q: must be either %s or %s", cc.Effect, effectv1.Effect_EFFECT_ALLOW, effectv1.Effect_EFFECT_DENY)
+	}
+}
+
+func (cc *CanaryConf) interval() time.Duration {
+	if cc.Interval == 0 {
+		return defaultCanaryInterval
+	}
+
+	return cc.Interval
+}
+
 func (c *Conf) Key() string {
 	return confKey
 }
@@ -179,6 +314,7 @@ func (c *Conf) SetDefaults() {
 	c.RequestLimits = RequestLimitsConf{
 		MaxActionsPerResource:  defaultMaxActionsPerResource,
 		MaxResourcesPerRequest: defaultMaxResourcesPerRequest,
+		MaxAttributeDepth:      defaultMaxAttributeDepth,
 	}
 
 	if c.AdminAPI.AdminCredentials == nil {
@@ -201,6 +337,10 @@ func (c *Conf) SetDefaults() {
 			MaxConnectionAge:     defaultGRPCMaxConnectionAge,
 			ConnectionTimeout:    defaultGRPCConnectionTimeout,
 		},
+		PlanCompression: PlanCompressionConf{
+			MinSizeBytes: defaultPlanCompressionMinSize,
+		},
+		InvalidUTF8: invalidUTF8Reject,
 	}
 }
 
@@ -227,9 +367,71 @@ func (c *Conf) Validate() (errs error) {
 		errs = multierr.Append(errs, fmt.Errorf("maxResourcesPerRequest must be between 1 and %d", requestItemsMax))
 	}
 
+	if c.RequestLimits.MaxAttributeDepth < 1 || c.RequestLimits.MaxAttributeDepth > attributeDepthMax {
+		errs = multierr.Append(errs, fmt.Errorf("maxAttributeDepth must be between 1 and %d", attributeDepthMax))
+	}
+
+	switch c.Advanced.InvalidUTF8 {
+	case invalidUTF8Reject, invalidUTF8Replace:
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("invalid advanced.invalidUTF8 %q: valid values are %s and %s", c.Advanced.InvalidUTF8, invalidUTF8Reject, invalidUTF8Replace))
+	}
+
+	if c.MetricsPrefix != "" && !metricsPrefixRegex.MatchString(c.MetricsPrefix) {
+		errs = multierr.Append(errs, fmt.Errorf("invalid metricsPrefix '%s': must match %s", c.MetricsPrefix, metricsPrefixRegex))
+	}
+
+	if c.GRPCUnixSocket != nil {
+		errs = multierr.Append(errs, c.GRPCUnixSocket.validate(c))
+	}
+
+	if c.MetricsListener != nil {
+		errs = multierr.Append(errs, c.MetricsListener.validate(c))
+	}
+
+	if c.Canary != nil {
+		errs = multierr.Append(errs, c.Canary.validate())
+	}
+
+	return errs
+}
+
+func (usc *GRPCUnixSocketConf) validate(c *Conf) (errs error) {
+	if usc.Path == "" {
+		errs = multierr.Append(errs, errors.New("grpcUnixSocket.path must not be empty"))
+	}
+
+	if listenAddr := usc.listenAddr(); listenAddr == c.HTTPListenAddr || listenAddr == c.GRPCListenAddr {
+		errs = multierr.Append(errs, fmt.Errorf("grpcUnixSocket.path '%s' must be different from httpListenAddr and grpcListenAddr", usc.Path))
+	}
+
+	if usc.FileMode != "" {
+		if mode, err := strconv.ParseInt(usc.FileMode, 0, 32); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid grpcUnixSocket.fileMode %q: %w", usc.FileMode, err))
+		} else if mode <= 0 {
+			errs = multierr.Append(errs, fmt.Errorf("invalid grpcUnixSocket.fileMode %q", usc.FileMode))
+		}
+	}
+
 	return errs
 }
 
+func (usc *GRPCUnixSocketConf) listenAddr() string {
+	return "unix:" + usc.Path
+}
+
+func (mlc *MetricsListenerConf) validate(c *Conf) error {
+	if _, _, err := util.ParseListenAddress(mlc.ListenAddr); err != nil {
+		return fmt.Errorf("invalid metricsListener.listenAddr '%s': %w", mlc.ListenAddr, err)
+	}
+
+	if mlc.ListenAddr == c.HTTPListenAddr || mlc.ListenAddr == c.GRPCListenAddr {
+		return fmt.Errorf("metricsListener.listenAddr '%s' must be different from httpListenAddr and grpcListenAddr", mlc.ListenAddr)
+	}
+
+	return nil
+}
+
 func GetConf() (*Conf, error) {
 	conf := &Conf{}
 	err := config.GetSection(conf)
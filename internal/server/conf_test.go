@@ -49,6 +49,71 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid metricsListener",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr":  ":6666",
+					"grpcListenAddr":  ":6667",
+					"metricsListener": map[string]any{"listenAddr": ":6668"},
+				},
+			},
+		},
+		{
+			name: "metricsListener sharing the HTTP address",
+			conf: map[string]any{
+				"server": map[string]any{
+					"httpListenAddr":  ":6666",
+					"grpcListenAddr":  ":6667",
+					"metricsListener": map[string]any{"listenAddr": ":6666"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid grpcUnixSocket",
+			conf: map[string]any{
+				"server": map[string]any{
+					"grpcUnixSocket": map[string]any{"path": "/var/run/cerbos/grpc.sock", "fileMode": "0o770"},
+				},
+			},
+		},
+		{
+			name: "grpcUnixSocket sharing the gRPC address",
+			conf: map[string]any{
+				"server": map[string]any{
+					"grpcListenAddr": "unix:/var/run/cerbos/grpc.sock",
+					"grpcUnixSocket": map[string]any{"path": "/var/run/cerbos/grpc.sock"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid grpcUnixSocket fileMode",
+			conf: map[string]any{
+				"server": map[string]any{
+					"grpcUnixSocket": map[string]any{"path": "/var/run/cerbos/grpc.sock", "fileMode": "rwx"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid metricsPrefix",
+			conf: map[string]any{
+				"server": map[string]any{
+					"metricsPrefix": "myorg_",
+				},
+			},
+		},
+		{
+			name: "invalid metricsPrefix",
+			conf: map[string]any{
+				"server": map[string]any{
+					"metricsPrefix": "my-org:",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "unencodedAdminPasswordHash",
 			conf: map[string]any{
@@ -120,6 +185,64 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid canary",
+			conf: map[string]any{
+				"server": map[string]any{
+					"canary": map[string]any{
+						"principal": map[string]any{"id": "canary", "roles": []any{"user"}},
+						"resource":  map[string]any{"kind": "document"},
+						"action":    "view",
+						"effect":    "EFFECT_ALLOW",
+					},
+				},
+			},
+		},
+		{
+			name: "canary without principal roles",
+			conf: map[string]any{
+				"server": map[string]any{
+					"canary": map[string]any{
+						"principal": map[string]any{"id": "canary"},
+						"resource":  map[string]any{"kind": "document"},
+						"action":    "view",
+						"effect":    "EFFECT_ALLOW",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "canary with invalid effect",
+			conf: map[string]any{
+				"server": map[string]any{
+					"canary": map[string]any{
+						"principal": map[string]any{"id": "canary", "roles": []any{"user"}},
+						"resource":  map[string]any{"kind": "document"},
+						"action":    "view",
+						"effect":    "EFFECT_NO_MATCH",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid UTF-8 replace",
+			conf: map[string]any{
+				"server": map[string]any{
+					"advanced": map[string]any{"invalidUTF8": "replace"},
+				},
+			},
+		},
+		{
+			name: "invalid UTF-8 unknown mode",
+			conf: map[string]any{
+				"server": map[string]any{
+					"advanced": map[string]any{"invalidUTF8": "ignore"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
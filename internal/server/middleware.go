@@ -10,18 +10,22 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/klauspost/compress/gzhttp"
 	"github.com/rs/cors"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
+	"github.com/cerbos/cerbos/internal/engine"
 	cerboslogging "github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/svc"
 	"github.com/cerbos/cerbos/internal/util"
@@ -29,7 +33,9 @@ import (
 
 const (
 	adminSvcDisabled      = "Admin service is disabled by the configuration"
+	expressionTraceHeader = "cerbos-trace-expressions"
 	playgroundSvcDisabled = "Playground service is disabled by the configuration"
+	requestTimeHeader     = "cerbos-request-time"
 	unknownSvc            = "Unknown service"
 )
 
@@ -230,7 +236,105 @@ func handleRoutingError(ctx context.Context, mux *runtime.ServeMux, marshaler ru
 	runtime.DefaultRoutingErrorHandler(ctx, mux, marshaler, w, r, httpStatus)
 }
 
+// planCompressionUnaryServerInterceptor limits response compression to PlanResources responses of at least minSize bytes.
+// Registering the gzip compressor enables it for every method of the server, so the responses of other methods and smaller
+// PlanResources responses are sent uncompressed even if the client requests compression. Larger PlanResources responses
+// are compressed using the compressor requested by the client (if any).
+func planCompressionUnaryServerInterceptor(conf PlanCompressionConf) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		if conf.Disabled || info.FullMethod != svcv1.CerbosService_PlanResources_FullMethodName {
+			_ = grpc.SetSendCompressor(ctx, encoding.Identity)
+			return resp, nil
+		}
+
+		if msg, ok := resp.(proto.Message); ok && uint(proto.Size(msg)) < conf.MinSizeBytes {
+			_ = grpc.SetSendCompressor(ctx, encoding.Identity)
+		}
+
+		return resp, nil
+	}
+}
+
+// uncompressedStreamServerInterceptor sends the responses of streaming methods uncompressed because response compression
+// is only meant for large query plans. See planCompressionUnaryServerInterceptor.
+func uncompressedStreamServerInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	_ = grpc.SetSendCompressor(ss.Context(), encoding.Identity)
+	return handler(srv, ss)
+}
+
+// planCompressionHandler gzips PlanResources responses larger than minSize if the client accepts gzip encoding.
+func planCompressionHandler(minSize uint, h http.Handler) (http.Handler, error) {
+	wrapper, err := gzhttp.NewWrapper(gzhttp.MinSize(int(minSize)))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper(h), nil
+}
+
 func cerbosVersionUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	_ = grpc.SetHeader(ctx, metadata.Pairs("cerbos-version", util.Version))
 	return handler(ctx, req)
 }
+
+// requestTimeUnaryServerInterceptor pins the time used for evaluating conditions to the RFC3339 timestamp sent in the request time header.
+func requestTimeUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	values := md.Get(requestTimeHeader)
+	if len(values) == 0 {
+		return handler(ctx, req)
+	}
+
+	now, err := time.Parse(time.RFC3339Nano, values[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s header: expected an RFC3339 timestamp", requestTimeHeader)
+	}
+
+	return handler(engine.ContextWithRequestTime(ctx, now), req)
+}
+
+// expressionTraceUnaryServerInterceptor requests sub-expression traces for the deciding conditions if the expression trace header is set to true.
+func expressionTraceUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	values := md.Get(expressionTraceHeader)
+	if len(values) == 0 {
+		return handler(ctx, req)
+	}
+
+	enabled, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s header: expected a boolean", expressionTraceHeader)
+	}
+
+	if !enabled {
+		return handler(ctx, req)
+	}
+
+	return handler(engine.ContextWithExpressionTrace(ctx), req)
+}
+
+// forwardHeaders returns a matcher that forwards the given headers from HTTP requests in addition to the headers forwarded by default.
+func forwardHeaders(headers ...string) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		for _, h := range headers {
+			if strings.EqualFold(key, h) {
+				return h, true
+			}
+		}
+
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cerbos/cerbos/internal/engine"
+)
+
+func TestRequestTimeInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cerbos.svc.v1.CerbosService/CheckResources"}
+
+	call := func(t *testing.T, ctx context.Context) (time.Time, bool, error) {
+		t.Helper()
+
+		var pinned time.Time
+		var ok bool
+		_, err := requestTimeUnaryServerInterceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+			pinned, ok = engine.RequestTimeFromContext(ctx)
+			return nil, nil
+		})
+		return pinned, ok, err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestTimeHeader, "2020-01-01T10:00:00+01:00"))
+		pinned, ok, err := call(t, ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, pinned.Equal(time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		_, ok, err := call(t, metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "127.0.0.1")))
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		_, ok, err = call(t, context.Background())
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestTimeHeader, "yesterday"))
+		_, _, err := call(t, ctx)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("http_header_matcher", func(t *testing.T) {
+		matcher := forwardHeaders(requestTimeHeader)
+		key, ok := matcher("Cerbos-Request-Time")
+		require.True(t, ok)
+		require.Equal(t, requestTimeHeader, key)
+
+		_, ok = matcher("X-Custom-Header")
+		require.False(t, ok)
+	})
+}
+
+func TestExpressionTraceInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cerbos.svc.v1.CerbosService/CheckResources"}
+
+	call := func(t *testing.T, ctx context.Context) (bool, error) {
+		t.Helper()
+
+		var enabled bool
+		_, err := expressionTraceUnaryServerInterceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+			enabled = engine.ExpressionTraceFromContext(ctx)
+			return nil, nil
+		})
+		return enabled, err
+	}
+
+	testCases := []struct {
+		ctx  context.Context
+		name string
+		want bool
+	}{
+		{name: "true", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(expressionTraceHeader, "true")), want: true},
+		{name: "false", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(expressionTraceHeader, "false"))},
+		{name: "absent", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "127.0.0.1"))},
+		{name: "no_metadata", ctx: context.Background()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			enabled, err := call(t, tc.ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, enabled)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := call(t, metadata.NewIncomingContext(context.Background(), metadata.Pairs(expressionTraceHeader, "maybe")))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/pprof"
 	"os"
 	"strconv"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	reuseport "github.com/kavu/go_reuseport"
 	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/sourcegraph/conc/pool"
 	"go.opencensus.io/plugin/ocgrpc"
 	"go.opencensus.io/plugin/ochttp"
@@ -44,6 +46,8 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/local"
 
+	// Import to register the gzip compressor, which is only used for large PlanResources responses (see planCompressionUnaryServerInterceptor).
+	_ "google.golang.org/grpc/encoding/gzip"
 	// Import the default grpc encoding to ensure that it gets replaced by VT.
 	_ "google.golang.org/grpc/encoding/proto"
 	"google.golang.org/grpc/health"
@@ -58,8 +62,11 @@ import (
 	_ "github.com/cerbos/cerbos/internal/audit/file"
 	// Import to register the kafka audit log backend.
 	_ "github.com/cerbos/cerbos/internal/audit/kafka"
+	// Import to register the syslog audit log backend.
+	_ "github.com/cerbos/cerbos/internal/audit/syslog"
 	"github.com/cerbos/cerbos/internal/auxdata"
 	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/engine"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
@@ -72,6 +79,8 @@ import (
 
 	// Import bundle to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/bundle"
+	// Import composite to register the storage driver.
+	_ "github.com/cerbos/cerbos/internal/storage/composite"
 	// Import mysql to register the storage driver.
 	_ "github.com/cerbos/cerbos/internal/storage/db/mysql"
 	// Import postgres to register the storage driver.
@@ -98,9 +107,14 @@ const (
 	apiEndpoint        = "/api"
 	healthEndpoint     = "/_cerbos/health"
 	metricsEndpoint    = "/_cerbos/metrics"
+	planEndpoint       = "/api/plan/resources"
+	pprofEndpoint      = "/debug/pprof/"
 	playgroundEndpoint = "/api/playground"
 	schemaEndpoint     = "/schema/swagger.json"
 	zpagesEndpoint     = "/_cerbos/debug"
+
+	// storeHealthService is the health check service name that reports whether the policy store is reachable.
+	storeHealthService = "cerbos.store"
 )
 
 var ErrInvalidStore = errors.New("store does not implement either SourceStore or BinaryStore interfaces")
@@ -125,10 +139,11 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 		return fmt.Errorf("failed to create audit log: %w", err)
 	}
 
-	mdExtractor, err := audit.NewMetadataExtractor()
+	auditConf, err := audit.GetConf()
 	if err != nil {
-		return fmt.Errorf("failed to create metadata extractor: %w", err)
+		return fmt.Errorf("failed to read audit configuration: %w", err)
 	}
+	mdExtractor := audit.NewMetadataExtractorFromConf(auditConf)
 
 	// create store
 	store, err := storage.New(ctx)
@@ -142,40 +157,44 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 		return fmt.Errorf("failed to create schema manager: %w", err)
 	}
 
-	var policyLoader engine.PolicyLoader
-	switch st := store.(type) {
-	// Overlay needs to take precedence over BinaryStore in this type switch,
-	// as our overlay store implements BinaryStore also
-	case overlay.Overlay:
-		// create wrapped policy loader
-		pl, err := st.GetOverlayPolicyLoader(ctx, schemaMgr)
+	policyLoader, err := mkPolicyLoader(ctx, store, schemaMgr)
+	if err != nil {
+		return err
+	}
+
+	engineConf, err := engine.GetConf()
+	if err != nil {
+		return fmt.Errorf("failed to read engine configuration: %w", err)
+	}
+
+	// create shadow policy loader
+	var shadowPolicyLoader engine.PolicyLoader
+	if engineConf.Shadow != nil {
+		shadowConf, err := config.WrapperFromMap(map[string]any{storage.ConfKey: engineConf.Shadow.Storage})
 		if err != nil {
-			return fmt.Errorf("failed to create overlay policy loader: %w", err)
+			return fmt.Errorf("failed to read shadow storage configuration: %w", err)
 		}
-		policyLoader = pl
-	case storage.BinaryStore:
-		policyLoader = st
-	case storage.SourceStore:
-		// create compile manager
-		compileMgr, err := compile.NewManager(ctx, st, schemaMgr)
+
+		shadowStore, err := storage.NewFromConf(ctx, shadowConf)
 		if err != nil {
-			return fmt.Errorf("failed to create compile manager: %w", err)
+			return fmt.Errorf("failed to create shadow policy store: %w", err)
+		}
+
+		shadowPolicyLoader, err = mkPolicyLoader(ctx, shadowStore, schemaMgr)
+		if err != nil {
+			return fmt.Errorf("failed to create shadow policy loader: %w", err)
 		}
-		policyLoader = compileMgr
-	default:
-		return ErrInvalidStore
 	}
 
 	// create engine
-	eng, err := engine.New(ctx, engine.Components{
-		PolicyLoader:      policyLoader,
-		SchemaMgr:         schemaMgr,
-		AuditLog:          auditLog,
-		MetadataExtractor: mdExtractor,
+	eng := engine.NewFromConf(ctx, engineConf, engine.Components{
+		PolicyLoader:            policyLoader,
+		SchemaMgr:               schemaMgr,
+		AuditLog:                auditLog,
+		MetadataExtractor:       mdExtractor,
+		ShadowPolicyLoader:      shadowPolicyLoader,
+		DecisionLogExplanations: auditConf.DecisionLogExplanations,
 	})
-	if err != nil {
-		return fmt.Errorf("failed to create engine: %w", err)
-	}
 
 	// initialize aux data
 	auxData, err := auxdata.New(ctx)
@@ -183,23 +202,59 @@ func Start(ctx context.Context, zpagesEnabled bool) error {
 		return fmt.Errorf("failed to initialize auxData handler: %w", err)
 	}
 
+	if engineConf.BreakGlass != nil && !auxData.VerifiesJWT() {
+		return errors.New("engine.breakGlass requires JWT verification to be enabled in auxData configuration")
+	}
+
 	s := NewServer(conf)
 	s.ocExporter = ocExporter
 
 	telemetry.Start(ctx, store)
 	defer telemetry.Stop()
 
-	return s.Start(ctx, Param{AuditLog: auditLog, AuxData: auxData, Engine: eng, Store: store, ZPagesEnabled: zpagesEnabled})
+	return s.Start(ctx, Param{AuditLog: auditLog, AuxData: auxData, Engine: eng, PolicyLoader: policyLoader, Store: store, ZPagesEnabled: zpagesEnabled})
+}
+
+// mkPolicyLoader returns the policy loader appropriate for the type of the store.
+func mkPolicyLoader(ctx context.Context, store storage.Store, schemaMgr internalSchema.Manager) (engine.PolicyLoader, error) {
+	switch st := store.(type) {
+	// Overlay needs to take precedence over BinaryStore in this type switch,
+	// as our overlay store implements BinaryStore also
+	case overlay.Overlay:
+		// create wrapped policy loader
+		pl, err := st.GetOverlayPolicyLoader(ctx, schemaMgr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create overlay policy loader: %w", err)
+		}
+		return pl, nil
+	case storage.BinaryStore:
+		return st, nil
+	case storage.SourceStore:
+		// create compile manager
+		compileMgr, err := compile.NewManager(ctx, st, schemaMgr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create compile manager: %w", err)
+		}
+		return compileMgr, nil
+	default:
+		return nil, ErrInvalidStore
+	}
 }
 
 type Param struct {
 	AuditLog      audit.Log
 	AuxData       *auxdata.AuxData
 	Engine        *engine.Engine
+	PolicyLoader  engine.PolicyLoader
 	Store         storage.Store
 	ZPagesEnabled bool
 }
 
+// storeAvailabilityNotifier is implemented by policy loaders that track whether the store is reachable.
+type storeAvailabilityNotifier interface {
+	OnStoreAvailabilityChange(func(available bool))
+}
+
 type Server struct {
 	conf       *Conf
 	cancelFunc context.CancelFunc
@@ -247,6 +302,14 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 		return err
 	}
 
+	var grpcSocketL net.Listener
+	if s.conf.GRPCUnixSocket != nil {
+		if grpcSocketL, err = s.createUnixSocketListener(s.conf.GRPCUnixSocket); err != nil {
+			log.Error("Failed to create gRPC Unix socket listener", zap.Error(err))
+			return err
+		}
+	}
+
 	// start servers
 	grpcServer, err := s.startGRPCServer(grpcL, param)
 	if err != nil {
@@ -254,12 +317,38 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 		return err
 	}
 
+	if grpcSocketL != nil {
+		s.serveGRPCUnixSocket(grpcServer, grpcSocketL)
+	}
+
 	httpServer, err := s.startHTTPServer(ctx, httpL, grpcServer, param.ZPagesEnabled)
 	if err != nil {
 		log.Error("Failed to start HTTP server", zap.Error(err))
 		return err
 	}
 
+	var metricsServer *http.Server
+	if s.conf.MetricsListener != nil {
+		// The metrics listener is intended to be reachable from internal networks only, so it's deliberately served without TLS.
+		metricsL, err := s.parseAndOpen(s.conf.MetricsListener.ListenAddr)
+		if err != nil {
+			log.Error("Failed to create metrics listener", zap.Error(err))
+			return err
+		}
+
+		metricsServer = s.startMetricsServer(metricsL)
+	}
+
+	if s.conf.Canary != nil {
+		c, err := newCanary(s.conf.Canary, param.Engine, s.health)
+		if err != nil {
+			log.Error("Failed to create canary", zap.Error(err))
+			return err
+		}
+
+		s.pool.Go(c.run)
+	}
+
 	s.pool.Go(func(ctx context.Context) error {
 		<-ctx.Done()
 		log.Info("Shutting down")
@@ -278,6 +367,13 @@ func (s *Server) Start(ctx context.Context, param Param) error {
 			log.Error("Failed to cleanly shutdown HTTP server", zap.Error(err))
 		}
 
+		if metricsServer != nil {
+			log.Debug("Shutting down metrics server")
+			if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+				log.Error("Failed to cleanly shutdown metrics server", zap.Error(err))
+			}
+		}
+
 		return nil
 	})
 
@@ -366,9 +462,27 @@ func (s *Server) createListener(listenAddr string) (net.Listener, error) {
 	return l, nil
 }
 
+func (s *Server) createUnixSocketListener(conf *GRPCUnixSocketConf) (net.Listener, error) {
+	fileMode := s.conf.UDSFileMode
+	if conf.FileMode != "" {
+		fileMode = conf.FileMode
+	}
+
+	l, err := openUnixListener(conf.Path, fileMode, conf.FileMode != "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create listener at '%s': %w", conf.Path, err)
+	}
+
+	if s.tlsConfig != nil {
+		l = tls.NewListener(l, s.tlsConfig)
+	}
+
+	return l, nil
+}
+
 func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, error) {
 	log := zap.L().Named("grpc")
-	server, err := s.mkGRPCServer(log, param.AuditLog)
+	server, err := s.mkGRPCServer(log, param.AuditLog, param.Engine)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gRPC server: %w", err)
 	}
@@ -379,12 +493,44 @@ func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, err
 	reqLimits := svc.RequestLimits{
 		MaxActionsPerResource:  s.conf.RequestLimits.MaxActionsPerResource,
 		MaxResourcesPerRequest: s.conf.RequestLimits.MaxResourcesPerRequest,
+		MaxAttributeDepth:      s.conf.RequestLimits.MaxAttributeDepth,
 	}
 
-	cerbosSvc := svc.NewCerbosService(param.Engine, param.AuxData, reqLimits)
+	var overlays *svc.PolicyOverlays
+	if overlayConf := s.conf.Advanced.PolicyOverlay; overlayConf.Enabled {
+		log.Info("Enabling policy overlays")
+		adminUser, adminPasswdHash, err := s.conf.AdminAPI.AdminCredentials.usernameAndPasswordHash()
+		if err != nil {
+			log.Error("Failed to get admin API credentials for policy overlays", zap.Error(err))
+			return nil, err
+		}
+
+		compileConf, err := compile.GetConf()
+		if err != nil {
+			log.Error("Failed to read compile configuration for policy overlays", zap.Error(err))
+			return nil, err
+		}
+
+		go checkForUnsafeAdminCredentials(log, adminPasswdHash)
+		overlays = svc.NewPolicyOverlays(overlayConf.CacheSize, adminUser, adminPasswdHash, param.Engine.SchemaManager(), compile.OptionsFromConf(compileConf))
+	}
+
+	cerbosSvc := svc.NewCerbosService(param.Engine, param.AuxData, reqLimits, overlays)
 	svcv1.RegisterCerbosServiceServer(server, cerbosSvc)
 	s.health.SetServingStatus(svcv1.CerbosService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 
+	if n, ok := param.PolicyLoader.(storeAvailabilityNotifier); ok {
+		// Losing the store degrades the PDP without stopping it, so it's reported separately from the overall health.
+		s.health.SetServingStatus(storeHealthService, healthpb.HealthCheckResponse_SERVING)
+		n.OnStoreAvailabilityChange(func(available bool) {
+			status := healthpb.HealthCheckResponse_SERVING
+			if !available {
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+			s.health.SetServingStatus(storeHealthService, status)
+		})
+	}
+
 	if s.conf.AdminAPI.Enabled {
 		log.Info("Starting admin service")
 		creds := s.conf.AdminAPI.AdminCredentials
@@ -397,13 +543,19 @@ func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, err
 
 		go checkForUnsafeAdminCredentials(log, adminPasswdHash)
 
-		svcv1.RegisterCerbosAdminServiceServer(server, svc.NewCerbosAdminService(param.Store, param.AuditLog, adminUser, adminPasswdHash))
+		svcv1.RegisterCerbosAdminServiceServer(server, svc.NewCerbosAdminService(param.Store, param.PolicyLoader, param.AuditLog, adminUser, adminPasswdHash))
 		s.health.SetServingStatus(svcv1.CerbosAdminService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 	}
 
 	if s.conf.PlaygroundEnabled {
 		log.Info("Starting playground service")
-		svcv1.RegisterCerbosPlaygroundServiceServer(server, svc.NewCerbosPlaygroundService(reqLimits))
+		compileConf, err := compile.GetConf()
+		if err != nil {
+			log.Error("Failed to read compile configuration for playground", zap.Error(err))
+			return nil, err
+		}
+
+		svcv1.RegisterCerbosPlaygroundServiceServer(server, svc.NewCerbosPlaygroundService(reqLimits, compileConf.CELExtensions))
 		s.health.SetServingStatus(svcv1.CerbosPlaygroundService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 	}
 
@@ -429,6 +581,21 @@ func (s *Server) startGRPCServer(l net.Listener, param Param) (*grpc.Server, err
 	return server, nil
 }
 
+// serveGRPCUnixSocket serves the gRPC API from the additional Unix domain socket.
+func (s *Server) serveGRPCUnixSocket(server *grpc.Server, l net.Listener) {
+	log := zap.L().Named("grpc")
+	s.pool.Go(func(_ context.Context) error {
+		log.Info(fmt.Sprintf("Starting gRPC server at unix:%s", s.conf.GRPCUnixSocket.Path))
+
+		if err := server.Serve(l); err != nil {
+			log.Error("gRPC server failed to serve Unix socket", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
 func checkForUnsafeAdminCredentials(log *zap.Logger, passwordHash []byte) {
 	unsafe, err := adminCredentialsAreUnsafe(passwordHash)
 	if err != nil {
@@ -438,7 +605,7 @@ func checkForUnsafeAdminCredentials(log *zap.Logger, passwordHash []byte) {
 	}
 }
 
-func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log) (*grpc.Server, error) {
+func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log, eng *engine.Engine) (*grpc.Server, error) {
 	telemetryInt := telemetry.Intercept()
 
 	auditInterceptor, err := audit.NewUnaryInterceptor(auditLog, accessLogExclude)
@@ -446,32 +613,57 @@ func (s *Server) mkGRPCServer(log *zap.Logger, auditLog audit.Log) (*grpc.Server
 		return nil, fmt.Errorf("failed to create audit unary interceptor: %w", err)
 	}
 
+	invalidUTF8 := newInvalidUTF8Handler(s.conf.Advanced.InvalidUTF8)
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_recovery.UnaryServerInterceptor(),
+		invalidUTF8.unaryInterceptor,
+		telemetryInt.UnaryServerInterceptor(),
+		tracing.DebugTraceUnaryServerInterceptor,
+		otelgrpc.UnaryServerInterceptor(),
+		svc.ResourceKindUnaryServerInterceptor(eng),
+		grpc_validator.UnaryServerInterceptor(validator.Validator),
+		RequestMetadataUnaryServerInterceptor,
+		auditInterceptor,
+		grpc_logging.UnaryServerInterceptor(RequestLogger(log, "Handled request")),
+		grpc_logging.UnaryServerInterceptor(PayloadLogger(s.conf), grpc_logging.WithLogOnEvents(grpc_logging.PayloadReceived, grpc_logging.PayloadSent)),
+		cerbosVersionUnaryServerInterceptor,
+		planCompressionUnaryServerInterceptor(s.conf.Advanced.PlanCompression),
+	}
+
+	if s.conf.AdminAPI.Enabled {
+		unaryInterceptors = append(unaryInterceptors, adminMutexUnaryServerInterceptor(adminLockTimeout))
+	}
+
+	if s.conf.Advanced.RequestTimeOverrideEnabled {
+		unaryInterceptors = append(unaryInterceptors, requestTimeUnaryServerInterceptor)
+	}
+
+	if s.conf.Advanced.ExpressionTraceEnabled {
+		unaryInterceptors = append(unaryInterceptors, expressionTraceUnaryServerInterceptor)
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.ChainStreamInterceptor(
 			grpc_recovery.StreamServerInterceptor(),
+			invalidUTF8.streamInterceptor,
 			telemetryInt.StreamServerInterceptor(),
+			tracing.DebugTraceStreamServerInterceptor,
 			otelgrpc.StreamServerInterceptor(),
 			grpc_validator.StreamServerInterceptor(validator.Validator),
 			grpc_logging.StreamServerInterceptor(RequestLogger(log, "Handled request")),
 			grpc_logging.StreamServerInterceptor(PayloadLogger(s.conf), grpc_logging.WithLogOnEvents(grpc_logging.PayloadReceived, grpc_logging.PayloadSent)),
+			uncompressedStreamServerInterceptor,
 		),
-		grpc.ChainUnaryInterceptor(
-			grpc_recovery.UnaryServerInterceptor(),
-			telemetryInt.UnaryServerInterceptor(),
-			otelgrpc.UnaryServerInterceptor(),
-			grpc_validator.UnaryServerInterceptor(validator.Validator),
-			RequestMetadataUnaryServerInterceptor,
-			auditInterceptor,
-			grpc_logging.UnaryServerInterceptor(RequestLogger(log, "Handled request")),
-			grpc_logging.UnaryServerInterceptor(PayloadLogger(s.conf), grpc_logging.WithLogOnEvents(grpc_logging.PayloadReceived, grpc_logging.PayloadSent)),
-			cerbosVersionUnaryServerInterceptor,
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.StatsHandler(invalidUTF8),
 		grpc.KeepaliveParams(keepalive.ServerParameters{MaxConnectionAge: s.conf.Advanced.GRPC.MaxConnectionAge}),
 		grpc.MaxConcurrentStreams(s.conf.Advanced.GRPC.MaxConcurrentStreams),
 		grpc.ConnectionTimeout(s.conf.Advanced.GRPC.ConnectionTimeout),
 		grpc.MaxRecvMsgSize(int(s.conf.Advanced.GRPC.MaxRecvMsgSizeBytes)),
 		grpc.UnknownServiceHandler(handleUnknownServices),
+		grpc.ForceServerCodec(newServerCodec(invalidUTF8)),
 	}
 
 	return grpc.NewServer(opts...), nil
@@ -485,18 +677,47 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 		return nil, err
 	}
 
-	gwmux := runtime.NewServeMux(
+	var prettyMarshaler, defaultMarshaler runtime.Marshaler = &runtime.JSONPb{
+		MarshalOptions:   protojson.MarshalOptions{Indent: "  "},
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: false},
+	}, &runtime.JSONPb{
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: false},
+	}
+
+	if s.conf.Advanced.InvalidUTF8 == invalidUTF8Replace {
+		prettyMarshaler = utf8SanitizingMarshaler{Marshaler: prettyMarshaler}
+		defaultMarshaler = utf8SanitizingMarshaler{Marshaler: defaultMarshaler}
+	} else {
+		prettyMarshaler = utf8RejectingMarshaler{Marshaler: prettyMarshaler}
+		defaultMarshaler = utf8RejectingMarshaler{Marshaler: defaultMarshaler}
+	}
+
+	gwmuxOpts := []runtime.ServeMuxOption{
 		runtime.WithForwardResponseOption(customHTTPResponseCode),
-		runtime.WithMarshalerOption("application/json+pretty", &runtime.JSONPb{
-			MarshalOptions:   protojson.MarshalOptions{Indent: "  "},
-			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: false},
-		}),
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: false},
-		}),
+		runtime.WithMarshalerOption("application/json+pretty", prettyMarshaler),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, defaultMarshaler),
 		runtime.WithRoutingErrorHandler(handleRoutingError),
 		runtime.WithHealthEndpointAt(healthpb.NewHealthClient(grpcConn), healthEndpoint),
-	)
+	}
+
+	var forwardedHeaders []string
+	if s.conf.Advanced.RequestTimeOverrideEnabled {
+		forwardedHeaders = append(forwardedHeaders, requestTimeHeader)
+	}
+
+	if s.conf.Advanced.ExpressionTraceEnabled {
+		forwardedHeaders = append(forwardedHeaders, expressionTraceHeader)
+	}
+
+	if tracing.DebugTraceEnabled() {
+		forwardedHeaders = append(forwardedHeaders, tracing.DebugTraceHeader)
+	}
+
+	if len(forwardedHeaders) > 0 {
+		gwmuxOpts = append(gwmuxOpts, runtime.WithIncomingHeaderMatcher(forwardHeaders(forwardedHeaders...)))
+	}
+
+	gwmux := runtime.NewServeMux(gwmuxOpts...)
 
 	if err := svcv1.RegisterCerbosServiceHandler(ctx, gwmux, grpcConn); err != nil {
 		log.Errorw("Failed to register Cerbos HTTP service", "error", err)
@@ -522,12 +743,20 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 		return r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc")
 	}).Handler(tracing.HTTPHandler(grpcSrv, "grpc"))
 
+	if !s.conf.Advanced.PlanCompression.Disabled {
+		planHandler, err := planCompressionHandler(s.conf.Advanced.PlanCompression.MinSizeBytes, prettyJSON(gwmux))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create plan compression handler: %w", err)
+		}
+		cerbosMux.Path(planEndpoint).Handler(tracing.HTTPHandler(planHandler, apiEndpoint))
+	}
+
 	cerbosMux.PathPrefix(adminEndpoint).Handler(tracing.HTTPHandler(prettyJSON(gwmux), adminEndpoint))
 	cerbosMux.PathPrefix(apiEndpoint).Handler(tracing.HTTPHandler(prettyJSON(gwmux), apiEndpoint))
 	cerbosMux.Path(healthEndpoint).Handler(prettyJSON(gwmux))
 	cerbosMux.Path(schemaEndpoint).HandlerFunc(schema.ServeSvcSwagger)
 
-	if s.conf.MetricsEnabled && s.ocExporter != nil {
+	if s.conf.MetricsEnabled && s.ocExporter != nil && s.conf.MetricsListener == nil {
 		cerbosMux.Path(metricsEndpoint).Handler(s.ocExporter)
 	}
 
@@ -568,6 +797,50 @@ func (s *Server) startHTTPServer(ctx context.Context, l net.Listener, grpcSrv *g
 	return h, nil
 }
 
+func (s *Server) startMetricsServer(l net.Listener) *http.Server {
+	log := zap.S().Named("metrics")
+
+	h := &http.Server{
+		ErrorLog:          zap.NewStdLog(zap.L().Named("metrics.error")),
+		Handler:           s.mkMetricsHandler(),
+		ReadHeaderTimeout: s.conf.Advanced.HTTP.ReadHeaderTimeout,
+		ReadTimeout:       s.conf.Advanced.HTTP.ReadTimeout,
+		WriteTimeout:      s.conf.Advanced.HTTP.WriteTimeout,
+		IdleTimeout:       s.conf.Advanced.HTTP.IdleTimeout,
+	}
+
+	s.pool.Go(func(ctx context.Context) error {
+		log.Infof("Starting metrics server at %s", s.conf.MetricsListener.ListenAddr)
+		err := h.Serve(l)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorw("Metrics server failed", "error", err)
+			return err
+		}
+
+		log.Info("Metrics server stopped")
+		return nil
+	})
+
+	return h
+}
+
+func (s *Server) mkMetricsHandler() http.Handler {
+	hm := http.NewServeMux()
+	if s.conf.MetricsEnabled && s.ocExporter != nil {
+		hm.Handle(metricsEndpoint, s.ocExporter)
+	}
+
+	if s.conf.MetricsListener.PprofEnabled {
+		hm.HandleFunc(pprofEndpoint, pprof.Index)
+		hm.HandleFunc(pprofEndpoint+"cmdline", pprof.Cmdline)
+		hm.HandleFunc(pprofEndpoint+"profile", pprof.Profile)
+		hm.HandleFunc(pprofEndpoint+"symbol", pprof.Symbol)
+		hm.HandleFunc(pprofEndpoint+"trace", pprof.Trace)
+	}
+
+	return hm
+}
+
 func defaultGRPCDialOpts() []grpc.DialOption {
 	// see https://github.com/grpc/grpc/blob/master/doc/connection-backoff.md
 	return []grpc.DialOption{
@@ -603,30 +876,36 @@ func (s *Server) parseAndOpen(listenAddr string) (net.Listener, error) {
 		return nil, err
 	}
 
-	//nolint:nestif
 	if network == "unix" {
-		if err := os.RemoveAll(addr); err != nil {
-			return nil, err
-		}
+		return openUnixListener(addr, s.conf.UDSFileMode, s.conf.UDSFileMode != defaultUDSFileMode)
+	}
 
-		listener, err := net.Listen(network, addr)
-		if err != nil {
-			return nil, err
-		}
+	return reuseport.NewReusablePortListener(network, addr)
+}
 
-		if s.conf.UDSFileMode != defaultUDSFileMode {
-			fileMode := toUDSFileMode(s.conf.UDSFileMode)
-			if err := os.Chmod(addr, fileMode); err != nil {
-				return nil, fmt.Errorf("failed to change file mode of %q to %O: %w", addr, fileMode, err)
-			}
-		}
+// openUnixListener creates a Unix domain socket at path, replacing any existing file.
+// The file mode of the socket is only changed if chmod is true so that the umask applies by default.
+func openUnixListener(path, fileModeStr string, chmod bool) (net.Listener, error) {
+	if err := os.RemoveAll(path); err != nil {
+		return nil, err
+	}
 
-		//nolint:forcetypeassert
-		listener.(*net.UnixListener).SetUnlinkOnClose(true)
-		return listener, nil
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
 	}
 
-	return reuseport.NewReusablePortListener(network, addr)
+	if chmod {
+		fileMode := toUDSFileMode(fileModeStr)
+		if err := os.Chmod(path, fileMode); err != nil {
+			_ = listener.Close()
+			return nil, fmt.Errorf("failed to change file mode of %q to %O: %w", path, fileMode, err)
+		}
+	}
+
+	//nolint:forcetypeassert
+	listener.(*net.UnixListener).SetUnlinkOnClose(true)
+	return listener, nil
 }
 
 //nolint:gomnd
@@ -662,7 +941,21 @@ func initOCPromExporter(conf *Conf) (*prometheus.Exporter, error) {
 		registry = nil
 	}
 
-	exporter, err := prometheus.NewExporter(prometheus.Options{Registry: registry})
+	opts := prometheus.Options{Registry: registry}
+	if conf.MetricsPrefix != "" {
+		// The runtime collectors in the default registry are not prefixed, so a separate registry is required to apply the prefix to every metric.
+		opts.Registry = prom.NewRegistry()
+		opts.Registerer = prom.WrapRegistererWithPrefix(conf.MetricsPrefix, opts.Registry)
+		if err := opts.Registerer.Register(collectors.NewGoCollector()); err != nil {
+			return nil, fmt.Errorf("failed to register Go runtime metrics: %w", err)
+		}
+
+		if err := opts.Registerer.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})); err != nil {
+			return nil, fmt.Errorf("failed to register process metrics: %w", err)
+		}
+	}
+
+	exporter, err := prometheus.NewExporter(opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Prometheus exporter: %w", err)
 	}
@@ -5,25 +5,43 @@ package server
 
 import (
 	"bytes"
+	stdgzip "compress/gzip"
 	"context"
 	"crypto/rand"
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/local"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 
 	"github.com/cerbos/cerbos/internal/audit"
 	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/cache"
 	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/engine"
 	"github.com/cerbos/cerbos/internal/observability/logging"
@@ -50,27 +68,7 @@ func TestServer(t *testing.T) {
 	logging.InitLogging(context.Background(), "ERROR")
 
 	t.Run("store=disk", func(t *testing.T) {
-		tpg := func(t *testing.T) testParam {
-			t.Helper()
-			ctx, cancelFunc := context.WithCancel(context.Background())
-			t.Cleanup(cancelFunc)
-
-			dir := test.PathToDir(t, "store")
-			store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
-			require.NoError(t, err)
-
-			schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementReject))
-			policyLoader := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
-
-			tp := testParam{
-				store:        store,
-				policyLoader: policyLoader,
-				schemaMgr:    schemaMgr,
-			}
-			return tp
-		}
-
-		t.Run("api", apiTests(tpg))
+		t.Run("api", apiTests(diskStoreParams))
 	})
 
 	t.Run("store=bundle_local", func(t *testing.T) {
@@ -177,6 +175,29 @@ func apiTests(tpg testParamGen) func(*testing.T) {
 
 				t.Run("grpc", tr.RunGRPCTests(conf.GRPCListenAddr, grpc.WithTransportCredentials(local.NewCredentials())))
 			})
+
+			t.Run("tcp_with_grpc_unix_socket", func(t *testing.T) {
+				tempDir := createTempDirForUDS(t)
+
+				conf := defaultConf()
+				conf.HTTPListenAddr = getFreeListenAddr(t)
+				conf.GRPCListenAddr = getFreeListenAddr(t)
+				conf.GRPCUnixSocket = &GRPCUnixSocketConf{Path: filepath.Join(tempDir, "grpc.sock"), FileMode: "0o700"}
+
+				startServer(t, conf, tpg)
+
+				require.Eventually(t, func() bool {
+					info, err := os.Stat(conf.GRPCUnixSocket.Path)
+					return err == nil && info.Mode()&os.ModeSocket != 0
+				}, 5*time.Second, 50*time.Millisecond)
+
+				info, err := os.Stat(conf.GRPCUnixSocket.Path)
+				require.NoError(t, err)
+				require.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+				t.Run("grpc", tr.RunGRPCTests(conf.GRPCListenAddr, grpc.WithTransportCredentials(local.NewCredentials())))
+				t.Run("grpc_unix_socket", tr.RunGRPCTests(conf.GRPCUnixSocket.listenAddr(), grpc.WithTransportCredentials(local.NewCredentials())))
+			})
 		})
 	}
 }
@@ -227,6 +248,324 @@ func generateRandomString(length int) (string, error) {
 	return hash, nil
 }
 
+func TestPlanCompression(t *testing.T) {
+	logging.InitLogging(context.Background(), "ERROR")
+
+	input := &requestv1.PlanResourcesRequest{
+		RequestId: "test",
+		Action:    "approve",
+		Principal: &enginev1.Principal{
+			Id:            "maggie",
+			PolicyVersion: "20210210",
+			Roles:         []string{"manager"},
+			Attr: map[string]*structpb.Value{
+				"department":          structpb.NewStringValue("marketing"),
+				"geography":           structpb.NewStringValue("GB"),
+				"managed_geographies": structpb.NewStringValue("GB"),
+				"team":                structpb.NewStringValue("design"),
+			},
+		},
+		Resource: &enginev1.PlanResourcesInput_Resource{
+			Kind:          "leave_request",
+			PolicyVersion: "20210210",
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		minSizeBytes   uint
+		disabled       bool
+		wantCompressed bool
+	}{
+		{name: "above_min_size", minSizeBytes: 1, wantCompressed: true},
+		{name: "below_min_size", minSizeBytes: 1 << 20, wantCompressed: false},
+		{name: "disabled", minSizeBytes: 1, disabled: true, wantCompressed: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conf := defaultConf()
+			conf.HTTPListenAddr = getFreeListenAddr(t)
+			conf.GRPCListenAddr = getFreeListenAddr(t)
+			conf.Advanced.PlanCompression.MinSizeBytes = tc.minSizeBytes
+			conf.Advanced.PlanCompression.Disabled = tc.disabled
+
+			startServer(t, conf, diskStoreParams)
+
+			t.Run("grpc", func(t *testing.T) {
+				sh := &compressionStatsHandler{}
+				grpcConn, err := grpc.Dial(conf.GRPCListenAddr, grpc.WithTransportCredentials(local.NewCredentials()), grpc.WithStatsHandler(sh))
+				require.NoError(t, err, "Failed to dial gRPC server")
+				t.Cleanup(func() { _ = grpcConn.Close() })
+
+				require.Eventually(t, grpcHealthCheckPasses(grpcConn, healthPollInterval), requestTimeout, healthPollInterval, "Server did not come up on time")
+
+				ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+				defer cancelFunc()
+
+				client := svcv1.NewCerbosServiceClient(grpcConn)
+				_, err = client.PlanResources(ctx, input, grpc.UseCompressor(gzip.Name))
+				require.NoError(t, err)
+
+				if tc.wantCompressed {
+					require.Equal(t, gzip.Name, sh.compression())
+				} else {
+					require.NotEqual(t, gzip.Name, sh.compression())
+				}
+
+				// Responses of the other methods are never compressed.
+				_, err = client.CheckResources(ctx, &requestv1.CheckResourcesRequest{
+					RequestId: "test",
+					Principal: input.Principal,
+					Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+						{
+							Actions:  []string{"approve"},
+							Resource: &enginev1.Resource{Kind: "leave_request", Id: "XX125", PolicyVersion: "20210210"},
+						},
+					},
+				}, grpc.UseCompressor(gzip.Name))
+				require.NoError(t, err)
+				require.NotEqual(t, gzip.Name, sh.compression())
+			})
+
+			t.Run("http", func(t *testing.T) {
+				transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+				transport.DisableCompression = true
+				c := &http.Client{Transport: transport}
+
+				hostAddr := fmt.Sprintf("http://%s", conf.HTTPListenAddr)
+				require.Eventually(t, httpHealthCheckPasses(c, fmt.Sprintf("%s/_cerbos/health", hostAddr), healthPollInterval), requestTimeout, healthPollInterval, "Server did not come up on time")
+
+				reqBytes, err := protojson.Marshal(input)
+				require.NoError(t, err, "Failed to marshal request")
+
+				ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+				defer cancelFunc()
+
+				req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s%s", hostAddr, planEndpoint), bytes.NewReader(reqBytes))
+				require.NoError(t, err, "Failed to create request")
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("Accept-Encoding", "gzip")
+
+				resp, err := c.Do(req)
+				require.NoError(t, err, "HTTP request failed")
+				defer resp.Body.Close()
+
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+
+				var body io.Reader = resp.Body
+				if tc.wantCompressed {
+					require.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+					gz, err := stdgzip.NewReader(resp.Body)
+					require.NoError(t, err, "Failed to create gzip reader")
+					body = gz
+				} else {
+					require.Empty(t, resp.Header.Get("Content-Encoding"))
+				}
+
+				respBytes, err := io.ReadAll(body)
+				require.NoError(t, err, "Failed to read response")
+
+				have := &responsev1.PlanResourcesResponse{}
+				require.NoError(t, protojson.Unmarshal(respBytes, have), "Failed to unmarshal response")
+				require.Equal(t, enginev1.PlanResourcesFilter_KIND_CONDITIONAL, have.Filter.Kind)
+			})
+		})
+	}
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	logging.InitLogging(context.Background(), "ERROR")
+
+	body := []byte(`{"requestId":"test","principal":{"id":"john","roles":["employee"],"attr":{"name":"john` + "\xff" + `"}},` +
+		`"resources":[{"actions":["view:public"],"resource":{"kind":"leave_request","id":"XX125","policyVersion":"20210210"}}]}`)
+
+	testCases := []struct {
+		mode       string
+		wantStatus int
+		wantMsg    string
+	}{
+		{mode: invalidUTF8Reject, wantStatus: http.StatusBadRequest, wantMsg: "field principal.attr.name contains invalid UTF-8"},
+		{mode: invalidUTF8Replace, wantStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.mode, func(t *testing.T) {
+			conf := defaultConf()
+			conf.HTTPListenAddr = getFreeListenAddr(t)
+			conf.GRPCListenAddr = getFreeListenAddr(t)
+			conf.Advanced.InvalidUTF8 = tc.mode
+
+			startServer(t, conf, diskStoreParams)
+
+			c := &http.Client{}
+			hostAddr := fmt.Sprintf("http://%s", conf.HTTPListenAddr)
+			require.Eventually(t, httpHealthCheckPasses(c, fmt.Sprintf("%s/_cerbos/health", hostAddr), healthPollInterval), requestTimeout, healthPollInterval, "Server did not come up on time")
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+			defer cancelFunc()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/check/resources", hostAddr), bytes.NewReader(body))
+			require.NoError(t, err, "Failed to create request")
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := c.Do(req)
+			require.NoError(t, err, "HTTP request failed")
+			defer resp.Body.Close()
+
+			respBytes, err := io.ReadAll(resp.Body)
+			require.NoError(t, err, "Failed to read response")
+			require.Equal(t, tc.wantStatus, resp.StatusCode, string(respBytes))
+
+			if tc.wantMsg != "" {
+				var have struct {
+					Message string `json:"message"`
+				}
+				require.NoError(t, json.Unmarshal(respBytes, &have), "Failed to unmarshal response")
+				require.Equal(t, tc.wantMsg, have.Message)
+			}
+		})
+	}
+}
+
+func TestMetricsPrefix(t *testing.T) {
+	exporter, err := initOCPromExporter(&Conf{MetricsEnabled: true, MetricsPrefix: "myorg_"})
+	require.NoError(t, err, "Failed to create Prometheus exporter")
+
+	// Accessing a cache records a Cerbos metric.
+	c := cache.New[string, string]("metrics_prefix_test", 1)
+	_, _ = c.Get("key")
+
+	scrape := func() string {
+		rec := httptest.NewRecorder()
+		exporter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsEndpoint, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	// Measurements are aggregated asynchronously.
+	var body string
+	require.Eventually(t, func() bool {
+		body = scrape()
+		return strings.Contains(body, `myorg_cerbos_dev_cache_access_count{kind="metrics_prefix_test"`)
+	}, requestTimeout, healthPollInterval)
+	require.Contains(t, body, "myorg_go_goroutines")
+
+	for _, line := range strings.Split(body, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		require.True(t, strings.HasPrefix(line, "myorg_"), "Metric is not prefixed: %s", line)
+	}
+}
+
+func TestMetricsListener(t *testing.T) {
+	logging.InitLogging(context.Background(), "ERROR")
+
+	exporter, err := initOCPromExporter(&Conf{MetricsEnabled: true})
+	require.NoError(t, err, "Failed to create Prometheus exporter")
+
+	withExporter := func(s *Server) { s.ocExporter = exporter }
+
+	get := func(t *testing.T, url string) int {
+		t.Helper()
+
+		ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancelFunc()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		require.NoError(t, err, "Failed to create request")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err, "HTTP request failed")
+		defer resp.Body.Close()
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode
+	}
+
+	t.Run("dedicated", func(t *testing.T) {
+		conf := defaultConf()
+		conf.HTTPListenAddr = getFreeListenAddr(t)
+		conf.GRPCListenAddr = getFreeListenAddr(t)
+		conf.MetricsListener = &MetricsListenerConf{ListenAddr: getFreeListenAddr(t), PprofEnabled: true}
+
+		startServer(t, conf, diskStoreParams, withExporter)
+
+		apiAddr := fmt.Sprintf("http://%s", conf.HTTPListenAddr)
+		metricsAddr := fmt.Sprintf("http://%s", conf.MetricsListener.ListenAddr)
+		require.Eventually(t, httpHealthCheckPasses(http.DefaultClient, apiAddr+healthEndpoint, healthPollInterval), requestTimeout, healthPollInterval, "Server did not come up on time")
+
+		require.Equal(t, http.StatusOK, get(t, metricsAddr+metricsEndpoint))
+		require.Equal(t, http.StatusOK, get(t, metricsAddr+pprofEndpoint))
+		require.NotEqual(t, http.StatusOK, get(t, apiAddr+metricsEndpoint), "Metrics must not be served from the API listener")
+		require.NotEqual(t, http.StatusOK, get(t, metricsAddr+healthEndpoint), "API must not be served from the metrics listener")
+	})
+
+	t.Run("pprof_disabled", func(t *testing.T) {
+		conf := defaultConf()
+		conf.HTTPListenAddr = getFreeListenAddr(t)
+		conf.GRPCListenAddr = getFreeListenAddr(t)
+		conf.MetricsListener = &MetricsListenerConf{ListenAddr: getFreeListenAddr(t)}
+
+		startServer(t, conf, diskStoreParams, withExporter)
+
+		apiAddr := fmt.Sprintf("http://%s", conf.HTTPListenAddr)
+		metricsAddr := fmt.Sprintf("http://%s", conf.MetricsListener.ListenAddr)
+		require.Eventually(t, httpHealthCheckPasses(http.DefaultClient, apiAddr+healthEndpoint, healthPollInterval), requestTimeout, healthPollInterval, "Server did not come up on time")
+
+		require.Equal(t, http.StatusOK, get(t, metricsAddr+metricsEndpoint))
+		require.Equal(t, http.StatusNotFound, get(t, metricsAddr+pprofEndpoint))
+	})
+
+	t.Run("not_configured", func(t *testing.T) {
+		conf := defaultConf()
+		conf.HTTPListenAddr = getFreeListenAddr(t)
+		conf.GRPCListenAddr = getFreeListenAddr(t)
+
+		startServer(t, conf, diskStoreParams, withExporter)
+
+		apiAddr := fmt.Sprintf("http://%s", conf.HTTPListenAddr)
+		require.Eventually(t, httpHealthCheckPasses(http.DefaultClient, apiAddr+healthEndpoint, healthPollInterval), requestTimeout, healthPollInterval, "Server did not come up on time")
+
+		require.Equal(t, http.StatusOK, get(t, apiAddr+metricsEndpoint))
+	})
+}
+
+// compressionStatsHandler records the compression algorithm advertised in the response headers.
+type compressionStatsHandler struct {
+	mu         sync.Mutex
+	compressor string
+}
+
+func (h *compressionStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (h *compressionStatsHandler) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if in, ok := s.(*stats.InHeader); ok && in.Client {
+		h.mu.Lock()
+		h.compressor = in.Compression
+		h.mu.Unlock()
+	}
+}
+
+func (h *compressionStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *compressionStatsHandler) HandleConn(context.Context, stats.ConnStats) {}
+
+func (h *compressionStatsHandler) compression() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.compressor
+}
+
 func TestAdminService(t *testing.T) {
 	tpg := func(t *testing.T) testParam {
 		t.Helper()
@@ -274,6 +613,25 @@ func TestAdminService(t *testing.T) {
 	t.Run("http", tr.RunHTTPTests(fmt.Sprintf("https://%s", conf.HTTPListenAddr), creds))
 }
 
+func diskStoreParams(t *testing.T) testParam {
+	t.Helper()
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	dir := test.PathToDir(t, "store")
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementReject))
+	policyLoader := compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+
+	return testParam{
+		store:        store,
+		policyLoader: policyLoader,
+		schemaMgr:    schemaMgr,
+	}
+}
+
 func getFreeListenAddr(t *testing.T) string {
 	t.Helper()
 
@@ -286,7 +644,7 @@ func getFreeListenAddr(t *testing.T) string {
 	return addr
 }
 
-func startServer(t *testing.T, conf *Conf, tpg testParamGen) {
+func startServer(t *testing.T, conf *Conf, tpg testParamGen, serverOpts ...func(*Server)) {
 	t.Helper()
 
 	tp := tpg(t)
@@ -315,6 +673,10 @@ func startServer(t *testing.T, conf *Conf, tpg testParamGen) {
 	param := Param{AuditLog: auditLog, AuxData: auxData, Store: tp.store, Engine: eng}
 
 	s := NewServer(conf)
+	for _, opt := range serverOpts {
+		opt(s)
+	}
+
 	go func() {
 		if err := s.Start(ctx, param); err != nil {
 			panic(err)
@@ -173,6 +173,10 @@ func (tr *TestRunner) executeGRPCTestCase(grpcConn *grpc.ClientConn, tc *private
 			playgroundClient := svcv1.NewCerbosPlaygroundServiceClient(grpcConn)
 			want = call.PlaygroundEvaluate.WantResponse
 			have, err = playgroundClient.PlaygroundEvaluate(ctx, call.PlaygroundEvaluate.Input)
+		case *privatev1.ServerTestCase_PlaygroundEvaluateExpression:
+			playgroundClient := svcv1.NewCerbosPlaygroundServiceClient(grpcConn)
+			want = call.PlaygroundEvaluateExpression.WantResponse
+			have, err = playgroundClient.PlaygroundEvaluateExpression(ctx, call.PlaygroundEvaluateExpression.Input)
 		case *privatev1.ServerTestCase_PlaygroundProxy:
 			playgroundClient := svcv1.NewCerbosPlaygroundServiceClient(grpcConn)
 			want = call.PlaygroundProxy.WantResponse
@@ -271,6 +275,11 @@ func (tr *TestRunner) executeHTTPTestCase(c *http.Client, hostAddr string, creds
 			input = call.PlaygroundEvaluate.Input
 			want = call.PlaygroundEvaluate.WantResponse
 			have = &responsev1.PlaygroundEvaluateResponse{}
+		case *privatev1.ServerTestCase_PlaygroundEvaluateExpression:
+			addr = fmt.Sprintf("%s/api/playground/evaluate_expression", hostAddr)
+			input = call.PlaygroundEvaluateExpression.Input
+			want = call.PlaygroundEvaluateExpression.WantResponse
+			have = &responsev1.PlaygroundEvaluateExpressionResponse{}
 		case *privatev1.ServerTestCase_PlaygroundProxy:
 			addr = fmt.Sprintf("%s/api/playground/proxy", hostAddr)
 			input = call.PlaygroundProxy.Input
@@ -344,6 +353,7 @@ func (tr *TestRunner) checkCORS(c *http.Client, hostAddr string) func(*testing.T
 		"/api/playground/validate",
 		"/api/playground/test",
 		"/api/playground/evaluate",
+		"/api/playground/evaluate_expression",
 		"/api/playground/proxy",
 		"/admin/policy",
 		"/admin/schema",
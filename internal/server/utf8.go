@@ -0,0 +1,478 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	invalidUTF8Reject  = "reject"
+	invalidUTF8Replace = "replace"
+)
+
+var (
+	utf8Replacement = []byte(string(utf8.RuneError))
+
+	structFullName    = (&structpb.Struct{}).ProtoReflect().Descriptor().FullName()
+	listValueFullName = (&structpb.ListValue{}).ProtoReflect().Descriptor().FullName()
+	valueFullName     = (&structpb.Value{}).ProtoReflect().Descriptor().FullName()
+)
+
+// invalidUTF8Handler deals with request messages that contain strings that are not valid UTF-8.
+// Errors returned by the codec are always reported to the client with the Internal status code, so the codec decodes a
+// sanitized copy of such messages (see unmarshalSanitized) and the handler decides whether the request can proceed.
+// As a stats handler, it inspects the encoded payload of the request messages that the codec had to sanitize and records
+// the outcome in the context of the RPC, which the interceptors check before the request is handled.
+type invalidUTF8Handler struct {
+	// sanitized holds the messages decoded by the codec with invalid UTF-8 replaced that haven't been inspected yet.
+	sanitized sync.Map
+	replace   bool
+}
+
+func newInvalidUTF8Handler(mode string) *invalidUTF8Handler {
+	return &invalidUTF8Handler{replace: mode == invalidUTF8Replace}
+}
+
+type utf8CheckCtxKey struct{}
+
+// utf8Check holds the outcome of inspecting the last message received by the RPC.
+type utf8Check struct {
+	err error
+}
+
+// markSanitized records that the codec replaced invalid UTF-8 while decoding msg.
+func (h *invalidUTF8Handler) markSanitized(msg proto.Message) {
+	h.sanitized.Store(msg, struct{}{})
+}
+
+func (h *invalidUTF8Handler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, utf8CheckCtxKey{}, &utf8Check{})
+}
+
+func (h *invalidUTF8Handler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	in, ok := rs.(*stats.InPayload)
+	if !ok {
+		return
+	}
+
+	// Messages that were decoded without sanitizing are valid UTF-8, so there's nothing to inspect.
+	if _, sanitized := h.sanitized.LoadAndDelete(in.Payload); !sanitized {
+		return
+	}
+
+	check, ok := ctx.Value(utf8CheckCtxKey{}).(*utf8Check)
+	if !ok {
+		return
+	}
+
+	msg, ok := in.Payload.(proto.Message)
+	if !ok {
+		return
+	}
+
+	check.err = h.inspect(in.Data, msg.ProtoReflect().Descriptor())
+}
+
+func (h *invalidUTF8Handler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *invalidUTF8Handler) HandleConn(context.Context, stats.ConnStats) {}
+
+// inspect returns an error if the encoded message must be rejected. Invalid UTF-8 is only acceptable if it can be replaced
+// without making the keys of a map collide, because one of the colliding entries would be silently discarded otherwise.
+func (h *invalidUTF8Handler) inspect(data []byte, md protoreflect.MessageDescriptor) error {
+	w := &utf8Walker{checkKeys: h.replace}
+	if _, err := w.walk(data, md, ""); err != nil {
+		// The codec has already reported the malformed message.
+		return nil //nolint:nilerr
+	}
+
+	switch {
+	case w.invalidField != "" && !h.replace:
+		return status.Error(codes.InvalidArgument, invalidUTF8FieldMsg(w.invalidField))
+	case w.collidingField != "":
+		return status.Errorf(codes.InvalidArgument, "field %s is ambiguous because another key becomes the same after replacing invalid UTF-8", w.collidingField)
+	default:
+		return nil
+	}
+}
+
+// takeUTF8Error returns the error recorded for the last message received by the RPC and resets it.
+func takeUTF8Error(ctx context.Context) error {
+	c, ok := ctx.Value(utf8CheckCtxKey{}).(*utf8Check)
+	if !ok {
+		return nil
+	}
+
+	err := c.err
+	c.err = nil
+	return err
+}
+
+func (h *invalidUTF8Handler) unaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := takeUTF8Error(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (h *invalidUTF8Handler) streamInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, invalidUTF8Stream{ServerStream: ss})
+}
+
+type invalidUTF8Stream struct {
+	grpc.ServerStream
+}
+
+func (s invalidUTF8Stream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return takeUTF8Error(s.Context())
+}
+
+// unmarshalSanitized is called when the message in data failed to decode. It decodes the message with invalid UTF-8 replaced
+// by the Unicode replacement character and returns false if the failure wasn't caused by invalid UTF-8.
+func unmarshalSanitized(data []byte, msg proto.Message) bool {
+	w := &utf8Walker{sanitize: true}
+	sanitized, err := w.walk(data, msg.ProtoReflect().Descriptor(), "")
+	if err != nil || w.invalidField == "" {
+		return false
+	}
+
+	return proto.Unmarshal(sanitized, msg) == nil
+}
+
+// utf8Walker finds the string fields of the wire-format encoding of a message that are not valid UTF-8.
+type utf8Walker struct {
+	// invalidField is the path of the first field found to contain invalid UTF-8.
+	invalidField string
+	// collidingField is the path of the first map entry with a key that is the same as the key of another entry of the map after replacing invalid UTF-8.
+	collidingField string
+	// sanitize enables rewriting the encoding with invalid UTF-8 replaced by the Unicode replacement character.
+	sanitize bool
+	// checkKeys enables detecting colliding map keys.
+	checkKeys bool
+}
+
+func (w *utf8Walker) walk(b []byte, md protoreflect.MessageDescriptor, path string) ([]byte, error) {
+	var out []byte
+	if w.sanitize {
+		out = make([]byte, 0, len(b))
+	}
+
+	listIndices := make(map[protowire.Number]int)
+	var mapKeys map[protowire.Number]map[string]bool
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+
+		fd := md.Fields().ByNumber(num)
+		if typ != protowire.BytesType || fd == nil {
+			m := protowire.ConsumeFieldValue(num, typ, b[n:])
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+
+			out = w.appendRaw(out, b[:n+m])
+			b = b[n+m:]
+			continue
+		}
+
+		v, m := protowire.ConsumeBytes(b[n:])
+		if m < 0 {
+			return nil, protowire.ParseError(m)
+		}
+
+		fieldPath := w.fieldPath(md, fd, v, path, listIndices)
+		if w.checkKeys && fd.IsMap() {
+			if mapKeys == nil {
+				mapKeys = make(map[protowire.Number]map[string]bool)
+			}
+			w.checkKey(mapKeys, fd.Number(), v, fieldPath)
+		}
+
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			if !utf8.Valid(v) {
+				if w.invalidField == "" {
+					w.invalidField = fieldPath
+				}
+				if w.sanitize {
+					v = bytes.ToValidUTF8(v, utf8Replacement)
+				}
+			}
+		case protoreflect.MessageKind:
+			var err error
+			if v, err = w.walk(v, fd.Message(), fieldPath); err != nil {
+				return nil, err
+			}
+		default:
+		}
+
+		if w.sanitize {
+			out = append(out, b[:n]...)
+			out = protowire.AppendBytes(out, v)
+		}
+		b = b[n+m:]
+	}
+
+	return out, nil
+}
+
+func (w *utf8Walker) appendRaw(out, raw []byte) []byte {
+	if !w.sanitize {
+		return out
+	}
+
+	return append(out, raw...)
+}
+
+// checkKey records the key of the encoded map entry and notes a collision if another entry of the same map has the same key
+// after replacing invalid UTF-8. Repeated keys that are valid UTF-8 are not collisions because the last entry always wins.
+func (w *utf8Walker) checkKey(mapKeys map[protowire.Number]map[string]bool, num protowire.Number, entry []byte, fieldPath string) {
+	key, ok := mapEntryKey(entry)
+	if !ok {
+		return
+	}
+
+	keys, ok := mapKeys[num]
+	if !ok {
+		keys = make(map[string]bool)
+		mapKeys[num] = keys
+	}
+
+	valid := utf8.Valid(key)
+	sanitized := string(bytes.ToValidUTF8(key, utf8Replacement))
+	if seenInvalid, seen := keys[sanitized]; seen && (seenInvalid || !valid) && w.collidingField == "" {
+		w.collidingField = fieldPath
+	}
+
+	keys[sanitized] = keys[sanitized] || !valid
+}
+
+// fieldPath builds a readable path to the field, treating map keys as field names and hiding the internals of google.protobuf.Struct values.
+func (w *utf8Walker) fieldPath(md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor, v []byte, path string, listIndices map[protowire.Number]int) string {
+	switch {
+	case md.IsMapEntry(), md.FullName() == valueFullName:
+		return path
+	case fd.IsMap():
+		name := path
+		if md.FullName() != structFullName {
+			name = joinPath(path, string(fd.Name()))
+		}
+		key, _ := mapEntryKey(v)
+		return joinPath(name, strings.ToValidUTF8(string(key), string(utf8.RuneError)))
+	case fd.IsList():
+		idx := listIndices[fd.Number()]
+		listIndices[fd.Number()] = idx + 1
+
+		name := path
+		if md.FullName() != listValueFullName {
+			name = joinPath(path, string(fd.Name()))
+		}
+		return name + "[" + strconv.Itoa(idx) + "]"
+	default:
+		return joinPath(path, string(fd.Name()))
+	}
+}
+
+func invalidUTF8FieldMsg(path string) string {
+	return "field " + path + " contains invalid UTF-8"
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// mapEntryKey returns the string key of an encoded map entry.
+func mapEntryKey(entry []byte) ([]byte, bool) {
+	for len(entry) > 0 {
+		num, typ, n := protowire.ConsumeTag(entry)
+		if n < 0 {
+			return nil, false
+		}
+
+		if num == 1 && typ == protowire.BytesType {
+			k, m := protowire.ConsumeBytes(entry[n:])
+			if m < 0 {
+				return nil, false
+			}
+			return k, true
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, entry[n:])
+		if m < 0 {
+			return nil, false
+		}
+		entry = entry[n+m:]
+	}
+
+	return nil, false
+}
+
+// utf8SanitizingMarshaler replaces invalid UTF-8 in HTTP request bodies before they are decoded.
+type utf8SanitizingMarshaler struct {
+	runtime.Marshaler
+}
+
+func (m utf8SanitizingMarshaler) Unmarshal(data []byte, v any) error {
+	return m.Marshaler.Unmarshal(bytes.ToValidUTF8(data, utf8Replacement), v)
+}
+
+func (m utf8SanitizingMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return bufferedDecoder(r, m.Unmarshal)
+}
+
+// utf8RejectingMarshaler reports the field of HTTP request bodies that contains invalid UTF-8 instead of the position of the
+// offending byte reported by the JSON decoder.
+type utf8RejectingMarshaler struct {
+	runtime.Marshaler
+}
+
+func (m utf8RejectingMarshaler) Unmarshal(data []byte, v any) error {
+	err := m.Marshaler.Unmarshal(data, v)
+	if err == nil || utf8.Valid(data) {
+		return err
+	}
+
+	if path, ok := invalidUTF8JSONField(data); ok {
+		return errors.New(invalidUTF8FieldMsg(path))
+	}
+
+	return err
+}
+
+func (m utf8RejectingMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return bufferedDecoder(r, m.Unmarshal)
+}
+
+func bufferedDecoder(r io.Reader, unmarshal func([]byte, any) error) runtime.Decoder {
+	return runtime.DecoderFunc(func(v any) error {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		return unmarshal(data, v)
+	})
+}
+
+// jsonContainer is an object or array that the JSON field finder is in.
+type jsonContainer struct {
+	path    string
+	key     string
+	index   int
+	array   bool
+	wantKey bool
+}
+
+// valuePath returns the path of the current value of the container.
+func (c *jsonContainer) valuePath() string {
+	if c.array {
+		return c.path + "[" + strconv.Itoa(c.index) + "]"
+	}
+
+	return joinPath(c.path, c.key)
+}
+
+// next moves on to the next value of the container.
+func (c *jsonContainer) next() {
+	if c.array {
+		c.index++
+		return
+	}
+
+	c.wantKey = true
+}
+
+// invalidUTF8JSONField returns the path of the first key or value of the JSON document that contains invalid UTF-8.
+// The decoder replaces invalid UTF-8 in the strings it returns, so the raw bytes of each token are checked instead.
+func invalidUTF8JSONField(data []byte) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var stack []*jsonContainer
+	var offset int64
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", false
+		}
+
+		end := dec.InputOffset()
+		raw := data[offset:end]
+		offset = end
+
+		var top *jsonContainer
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		if delim, ok := tok.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				c := &jsonContainer{array: delim == '[', wantKey: delim == '{'}
+				if top != nil {
+					c.path = top.valuePath()
+				}
+				stack = append(stack, c)
+			default:
+				stack = stack[:len(stack)-1]
+				if len(stack) == 0 {
+					return "", false
+				}
+				stack[len(stack)-1].next()
+			}
+			continue
+		}
+
+		if top == nil {
+			return "", false
+		}
+
+		if top.wantKey {
+			top.key, _ = tok.(string)
+			top.wantKey = false
+			if !utf8.Valid(raw) {
+				return joinPath(top.path, top.key), true
+			}
+			continue
+		}
+
+		if !utf8.Valid(raw) {
+			return top.valuePath(), true
+		}
+		top.next()
+	}
+}
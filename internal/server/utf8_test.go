@@ -0,0 +1,291 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+)
+
+const placeholder = "XX"
+
+// mkInvalidUTF8Request returns the encoding of a request containing invalid UTF-8 in a principal attribute and a nested resource attribute.
+func mkInvalidUTF8Request(t *testing.T) []byte {
+	t.Helper()
+
+	req := &requestv1.CheckResourcesRequest{
+		RequestId: "test",
+		Principal: &enginev1.Principal{
+			Id:    "alice",
+			Roles: []string{"user"},
+			Attr:  map[string]*structpb.Value{"name": structpb.NewStringValue("alice" + placeholder)},
+		},
+		Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+			{
+				Actions: []string{"view"},
+				Resource: &enginev1.Resource{
+					Kind: "document",
+					Id:   "doc1",
+					Attr: map[string]*structpb.Value{
+						"tags": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+							structpb.NewStringValue("ok"),
+							structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"label": structpb.NewStringValue(placeholder)}}),
+						}}),
+					},
+				},
+			},
+		},
+	}
+
+	data, err := proto.Marshal(req)
+	require.NoError(t, err)
+
+	return bytes.ReplaceAll(data, []byte(placeholder), []byte{0xff, 0xfe})
+}
+
+func TestCodecInvalidUTF8(t *testing.T) {
+	data := mkInvalidUTF8Request(t)
+
+	t.Run("no_sanitizing", func(t *testing.T) {
+		codec := Codec{}
+		require.Error(t, codec.Unmarshal(data, &requestv1.CheckResourcesRequest{}))
+	})
+
+	t.Run("sanitizing", func(t *testing.T) {
+		handler := newInvalidUTF8Handler(invalidUTF8Reject)
+		req := &requestv1.CheckResourcesRequest{}
+		require.NoError(t, newServerCodec(handler).Unmarshal(data, req))
+
+		_, sanitized := handler.sanitized.Load(req)
+		require.True(t, sanitized, "Sanitized message must be handed over to the handler")
+
+		require.Equal(t, "alice�", req.Principal.Attr["name"].GetStringValue())
+		label := req.Resources[0].Resource.Attr["tags"].GetListValue().Values[1].GetStructValue().Fields["label"]
+		require.Equal(t, "�", label.GetStringValue())
+		require.Equal(t, "test", req.RequestId)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		data, err := proto.Marshal(&requestv1.CheckResourcesRequest{RequestId: "test", Principal: &enginev1.Principal{Id: "alice"}})
+		require.NoError(t, err)
+
+		handler := newInvalidUTF8Handler(invalidUTF8Reject)
+		req := &requestv1.CheckResourcesRequest{}
+		require.NoError(t, newServerCodec(handler).Unmarshal(data, req))
+
+		_, sanitized := handler.sanitized.Load(req)
+		require.False(t, sanitized)
+	})
+
+	t.Run("other_errors", func(t *testing.T) {
+		require.Error(t, newServerCodec(newInvalidUTF8Handler(invalidUTF8Reject)).Unmarshal(data[:len(data)-3], &requestv1.CheckResourcesRequest{}))
+	})
+}
+
+func TestInvalidUTF8Handler(t *testing.T) {
+	// receive mimics the gRPC server decoding the request and reporting the payload to the stats handler before calling the interceptors.
+	receive := func(t *testing.T, handler *invalidUTF8Handler, data []byte) error {
+		t.Helper()
+
+		ctx := handler.TagRPC(context.Background(), &stats.RPCTagInfo{})
+		req := &requestv1.CheckResourcesRequest{}
+		require.NoError(t, newServerCodec(handler).Unmarshal(data, req))
+		handler.HandleRPC(ctx, &stats.InPayload{Payload: req, Data: data})
+
+		_, pending := handler.sanitized.Load(req)
+		require.False(t, pending, "Sanitized message must only be inspected once")
+
+		called := false
+		_, err := handler.unaryInterceptor(ctx, req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+			called = true
+			return nil, nil
+		})
+		require.Equal(t, err == nil, called)
+
+		// The outcome is only reported once.
+		require.NoError(t, takeUTF8Error(ctx))
+		return err
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		err := receive(t, newInvalidUTF8Handler(invalidUTF8Reject), mkInvalidUTF8Request(t))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, "field principal.attr.name contains invalid UTF-8", status.Convert(err).Message())
+	})
+
+	t.Run("reject_nested", func(t *testing.T) {
+		req := &requestv1.CheckResourcesRequest{
+			Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+				{
+					Actions: []string{"view"},
+					Resource: &enginev1.Resource{
+						Kind: "document",
+						Attr: map[string]*structpb.Value{
+							"tags": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+								structpb.NewStringValue("ok"),
+								structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"label": structpb.NewStringValue(placeholder)}}),
+							}}),
+						},
+					},
+				},
+			},
+		}
+
+		data, err := proto.Marshal(req)
+		require.NoError(t, err)
+		data = bytes.ReplaceAll(data, []byte(placeholder), []byte{0xff, 0xfe})
+
+		err = receive(t, newInvalidUTF8Handler(invalidUTF8Reject), data)
+		require.Equal(t, "field resources[0].resource.attr.tags[1].label contains invalid UTF-8", status.Convert(err).Message())
+	})
+
+	t.Run("reject_plain_string", func(t *testing.T) {
+		// Messages with generated decoders are decoded without validating UTF-8, so the stats handler must catch these too.
+		data, err := proto.Marshal(&requestv1.CheckResourcesRequest{RequestId: "test", Principal: &enginev1.Principal{Id: placeholder}})
+		require.NoError(t, err)
+		data = bytes.ReplaceAll(data, []byte(placeholder), []byte{0xff, 0xfe})
+
+		err = receive(t, newInvalidUTF8Handler(invalidUTF8Reject), data)
+		require.Equal(t, "field principal.id contains invalid UTF-8", status.Convert(err).Message())
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		data, err := proto.Marshal(&requestv1.CheckResourcesRequest{RequestId: "test", Principal: &enginev1.Principal{Id: "alice"}})
+		require.NoError(t, err)
+
+		require.NoError(t, receive(t, newInvalidUTF8Handler(invalidUTF8Reject), data))
+	})
+
+	t.Run("not_sanitized", func(t *testing.T) {
+		// Only the messages that the codec had to sanitize are inspected.
+		handler := newInvalidUTF8Handler(invalidUTF8Reject)
+		ctx := handler.TagRPC(context.Background(), &stats.RPCTagInfo{})
+		handler.HandleRPC(ctx, &stats.InPayload{Payload: &requestv1.CheckResourcesRequest{}, Data: mkInvalidUTF8Request(t)})
+		require.NoError(t, takeUTF8Error(ctx))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		require.NoError(t, receive(t, newInvalidUTF8Handler(invalidUTF8Replace), mkInvalidUTF8Request(t)))
+	})
+
+	t.Run("replace_colliding_keys", func(t *testing.T) {
+		for _, keys := range [][]string{{"label\xff", "label\xfe"}, {"label\xff", "label�"}, {"label�", "label\xff"}} {
+			data := mkAttrKeysRequest(t, keys...)
+			err := receive(t, newInvalidUTF8Handler(invalidUTF8Replace), data)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.Equal(t, "field principal.attr.label� is ambiguous because another key becomes the same after replacing invalid UTF-8", status.Convert(err).Message())
+		}
+	})
+
+	t.Run("replace_distinct_keys", func(t *testing.T) {
+		require.NoError(t, receive(t, newInvalidUTF8Handler(invalidUTF8Replace), mkAttrKeysRequest(t, "label\xff", "name\xff", "label")))
+	})
+}
+
+// mkAttrKeysRequest returns the encoding of a request with a principal attribute for each of the keys, which don't have to be valid UTF-8.
+func mkAttrKeysRequest(t *testing.T, keys ...string) []byte {
+	t.Helper()
+
+	value, err := proto.Marshal(structpb.NewBoolValue(true))
+	require.NoError(t, err)
+
+	attrField := (&enginev1.Principal{}).ProtoReflect().Descriptor().Fields().ByName("attr").Number()
+	var principal []byte
+	for _, k := range keys {
+		var entry []byte
+		entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+		entry = protowire.AppendString(entry, k)
+		entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+		entry = protowire.AppendBytes(entry, value)
+
+		principal = protowire.AppendTag(principal, attrField, protowire.BytesType)
+		principal = protowire.AppendBytes(principal, entry)
+	}
+
+	principalField := (&requestv1.CheckResourcesRequest{}).ProtoReflect().Descriptor().Fields().ByName("principal").Number()
+	data := protowire.AppendTag(nil, principalField, protowire.BytesType)
+	return protowire.AppendBytes(data, principal)
+}
+
+func TestUTF8SanitizingMarshaler(t *testing.T) {
+	body := []byte(`{"requestId":"test","principal":{"id":"alice","roles":["user"],"attr":{"name":"alice` + "\xff" + `"}}}`)
+
+	t.Run("default", func(t *testing.T) {
+		m := &runtime.JSONPb{}
+		req := &requestv1.CheckResourcesRequest{}
+		require.Error(t, m.NewDecoder(bytes.NewReader(body)).Decode(req))
+	})
+
+	t.Run("sanitizing", func(t *testing.T) {
+		m := utf8SanitizingMarshaler{Marshaler: &runtime.JSONPb{}}
+		req := &requestv1.CheckResourcesRequest{}
+		require.NoError(t, m.NewDecoder(strings.NewReader(string(body))).Decode(req))
+		require.Equal(t, "alice�", req.Principal.Attr["name"].GetStringValue())
+	})
+}
+
+func TestUTF8RejectingMarshaler(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "attr_value",
+			body: `{"requestId":"test","principal":{"id":"alice","roles":["user"],"attr":{"name":"alice` + "\xff" + `"}}}`,
+			want: "field principal.attr.name contains invalid UTF-8",
+		},
+		{
+			name: "attr_key",
+			body: `{"requestId":"test","principal":{"id":"alice","roles":["user"],"attr":{"name` + "\xff" + `":"alice"}}}`,
+			want: "field principal.attr.name� contains invalid UTF-8",
+		},
+		{
+			name: "nested",
+			body: `{"resources":[{"actions":["view"],"resource":{"kind":"document","id":"doc1","attr":{"tags":["ok",{"label":"` + "\xff\xfe" + `"}]}}}]}`,
+			want: "field resources[0].resource.attr.tags[1].label contains invalid UTF-8",
+		},
+		{
+			name: "plain_string",
+			body: `{"requestId":"test","principal":{"id":"alice` + "\xff" + `","roles":["user"]}}`,
+			want: "field principal.id contains invalid UTF-8",
+		},
+	}
+
+	m := utf8RejectingMarshaler{Marshaler: &runtime.JSONPb{}}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := m.NewDecoder(strings.NewReader(tc.body)).Decode(&requestv1.CheckResourcesRequest{})
+			require.EqualError(t, err, tc.want)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		req := &requestv1.CheckResourcesRequest{}
+		require.NoError(t, m.Unmarshal([]byte(`{"requestId":"test","principal":{"id":"alice�","roles":["user"]}}`), req))
+		require.Equal(t, "alice�", req.Principal.Id)
+	})
+
+	t.Run("other_errors", func(t *testing.T) {
+		err := m.Unmarshal([]byte(`{"requestId":`), &requestv1.CheckResourcesRequest{})
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "UTF-8")
+	})
+}
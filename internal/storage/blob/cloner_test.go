@@ -33,6 +33,7 @@ func TestCloneResult(t *testing.T) {
 		"derived_roles/derived_roles_03.yaml",
 		"derived_roles/derived_roles_04.yaml",
 		"derived_roles/derived_roles_05.yaml",
+		"derived_roles/derived_roles_06.yaml",
 		"export_variables/export_variables_01.yaml",
 		"principal_policies/policy_01.yaml",
 		"principal_policies/policy_02.yaml",
@@ -61,5 +62,6 @@ func TestCloneResult(t *testing.T) {
 		"resource_policies/policy_12.yaml",
 		"resource_policies/policy_13.yaml",
 		"resource_policies/policy_14.yaml",
+		"resource_policies/policy_15.yaml",
 	}, result.updateOrAdd)
 }
@@ -67,7 +67,12 @@ func init() {
 			return nil, err
 		}
 
-		return NewStore(ctx, conf, c)
+		storageConf := new(storage.Conf)
+		if err := confW.GetSection(storageConf); err != nil {
+			return nil, fmt.Errorf("failed to read storage configuration: %w", err)
+		}
+
+		return NewStore(ctx, conf, c, index.WithMaxPolicies(storageConf.MaxPolicies), index.WithResourceKinds(storageConf.AllowedResourceKinds))
 	})
 }
 
@@ -155,19 +160,21 @@ type bucketCloner interface {
 
 type Store struct {
 	*storage.SubscriptionManager
-	log    *zap.SugaredLogger
-	conf   *Conf
-	idx    index.Index
-	cloner bucketCloner
-	fsys   fs.FS
+	log       *zap.SugaredLogger
+	conf      *Conf
+	idx       index.Index
+	cloner    bucketCloner
+	fsys      fs.FS
+	buildOpts []index.BuildOpt
 }
 
 func (s *Store) Subscribe(sub storage.Subscriber) {
 	s.SubscriptionManager.Subscribe(sub)
 }
 
-func NewStore(ctx context.Context, conf *Conf, cloner bucketCloner) (*Store, error) {
+func NewStore(ctx context.Context, conf *Conf, cloner bucketCloner, opts ...index.BuildOpt) (*Store, error) {
 	s := &Store{
+		buildOpts:           opts,
 		log:                 zap.S().Named(DriverName).With("bucket", conf.Bucket, "workDir", conf.WorkDir),
 		conf:                conf,
 		cloner:              cloner,
@@ -196,7 +203,7 @@ func (s *Store) init(ctx context.Context) error {
 	}
 
 	var err error
-	s.idx, err = index.Build(ctx, s.fsys, index.WithRootDir("."))
+	s.idx, err = index.Build(ctx, s.fsys, append([]index.BuildOpt{index.WithRootDir(".")}, s.buildOpts...)...)
 	if err != nil {
 		s.log.Errorw("Failed to build index", "error", err)
 		return err
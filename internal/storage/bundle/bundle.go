@@ -11,6 +11,7 @@ import (
 	"io"
 	"io/fs"
 	"os"
+	"sort"
 	"strings"
 
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
@@ -235,6 +236,32 @@ func (b *Bundle) loadPolicySet(idHex, fileName string) (*runtimev1.RunnablePolic
 	return rps, nil
 }
 
+// Diagnostics returns the errors encountered while loading the policies in the bundle.
+// Policies in a bundle are compiled when the bundle is built, so the compiler warnings are not available.
+func (b *Bundle) Diagnostics(ctx context.Context) ([]compile.Diagnostic, error) {
+	fqns := make([]string, 0, len(b.manifest.PolicyIndex))
+	for fqn := range b.manifest.PolicyIndex {
+		fqns = append(fqns, fqn)
+	}
+	sort.Strings(fqns)
+
+	var diags []compile.Diagnostic
+	for _, fqn := range fqns {
+		if _, err := b.GetFirstMatch(ctx, []namer.ModuleID{namer.GenModuleIDFromFQN(fqn)}); err != nil {
+			diags = append(diags, compile.Diagnostic{
+				CompileErrors_Err: &runtimev1.CompileErrors_Err{
+					File:        namer.PolicyKeyFromFQN(fqn),
+					Error:       err.Error(),
+					Description: "Failed to load policy from bundle",
+				},
+				Severity: compile.SeverityError,
+			})
+		}
+	}
+
+	return diags, nil
+}
+
 func (b *Bundle) ListPolicyIDs(_ context.Context, _ storage.ListPolicyIDsParams) ([]string, error) {
 	output := make([]string, len(b.manifest.PolicyIndex))
 
@@ -77,10 +77,18 @@ type Conf struct {
 	Local *LocalSourceConf `yaml:"local"`
 	// Credentials holds bundle source credentials.
 	Credentials CredentialsConf `yaml:"credentials"`
+	// Validation holds configuration for testing new bundles before they are activated.
+	Validation *ValidationConf `yaml:"validation"`
 	// CacheSize defines the number of policies to cache in memory.
 	CacheSize uint `yaml:"cacheSize" conf:",example=1024"`
 }
 
+// ValidationConf holds configuration for validating bundles before activating them.
+type ValidationConf struct {
+	// TestsDir is the path to a directory of policy tests that a bundle must pass before it replaces the active bundle.
+	TestsDir string `yaml:"testsDir" conf:"required,example=/path/to/tests"`
+}
+
 // CredentialsConf holds credentials for accessing the bundle service.
 type CredentialsConf struct {
 	// PDPID is the unique identifier for this Cerbos instance. Defaults to the value of the CERBOS_HUB_PDP_ID environment variable.
@@ -181,6 +189,10 @@ func (conf *Conf) Validate() (outErr error) {
 		outErr = multierr.Append(outErr, err)
 	}
 
+	if err := conf.Validation.validate(); err != nil {
+		outErr = multierr.Append(outErr, err)
+	}
+
 	// SecretKey was renamed to WorkspaceSecret in Cerbos 0.31.0
 	if conf.Credentials.WorkspaceSecret == "" && conf.Credentials.SecretKey != "" {
 		conf.Credentials.WorkspaceSecret = conf.Credentials.SecretKey
@@ -211,6 +223,31 @@ func (lc *LocalSourceConf) validate() error {
 	return nil
 }
 
+func (vc *ValidationConf) validate() error {
+	if vc == nil {
+		return nil
+	}
+
+	stat, err := os.Stat(vc.TestsDir)
+	if err != nil {
+		return fmt.Errorf("failed to stat validation.testsDir %q: %w", vc.TestsDir, err)
+	}
+
+	if !stat.IsDir() {
+		return fmt.Errorf("validation.testsDir %q is not a directory", vc.TestsDir)
+	}
+
+	return nil
+}
+
+func (vc *ValidationConf) testsDir() string {
+	if vc == nil {
+		return ""
+	}
+
+	return vc.TestsDir
+}
+
 func (lc *LocalSourceConf) setDefaults() error {
 	if lc == nil {
 		return errors.New("configuration is undefined")
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"sync"
+	"sync/atomic"
 
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cloud-api/credentials"
@@ -26,10 +29,11 @@ var (
 
 // LocalSource loads a bundle from local disk.
 type LocalSource struct {
-	bundle  *Bundle
-	cleanup func() error
-	params  LocalParams
-	mu      sync.RWMutex
+	bundle     *Bundle
+	cleanup    func() error
+	params     LocalParams
+	mu         sync.RWMutex
+	generation atomic.Uint64
 }
 
 func NewLocalSourceFromConf(_ context.Context, conf *Conf) (*LocalSource, error) {
@@ -42,6 +46,7 @@ func NewLocalSourceFromConf(_ context.Context, conf *Conf) (*LocalSource, error)
 		SecretKey:  conf.Credentials.WorkspaceSecret,
 		TempDir:    conf.Local.TempDir,
 		CacheSize:  conf.CacheSize,
+		TestsDir:   conf.Validation.testsDir(),
 	})
 }
 
@@ -49,7 +54,9 @@ type LocalParams struct {
 	BundlePath string
 	TempDir    string
 	SecretKey  string
-	CacheSize  uint
+	// TestsDir is the directory containing the tests that a bundle must pass before it is activated.
+	TestsDir  string
+	CacheSize uint
 }
 
 func NewLocalSource(params LocalParams) (*LocalSource, error) {
@@ -58,14 +65,14 @@ func NewLocalSource(params LocalParams) (*LocalSource, error) {
 	}
 
 	ls := &LocalSource{params: params}
-	if err := ls.loadBundle(); err != nil {
+	if err := ls.loadBundle(context.Background()); err != nil {
 		return nil, err
 	}
 
 	return ls, nil
 }
 
-func (ls *LocalSource) loadBundle() error {
+func (ls *LocalSource) loadBundle(ctx context.Context) error {
 	workDir, err := os.MkdirTemp(ls.params.TempDir, "cerbos-bundle-*")
 	if err != nil {
 		return fmt.Errorf("failed to create temporary directory: %w", err)
@@ -108,10 +115,18 @@ func (ls *LocalSource) loadBundle() error {
 		return outErr
 	}
 
+	if err := validateBundle(ctx, "local", ls.params.TestsDir, bundle); err != nil {
+		if err := cleanupFn(); err != nil {
+			zap.L().Warn("Failed to cleanup rejected bundle", zap.Error(err))
+		}
+		return fmt.Errorf("bundle %q was not activated: %w", bundlePath, err)
+	}
+
 	ls.mu.Lock()
 	prevCleanupFn := ls.cleanup
 	ls.cleanup = cleanupFn
 	ls.bundle = bundle
+	ls.generation.Add(1)
 	ls.mu.Unlock()
 
 	if prevCleanupFn != nil {
@@ -155,8 +170,20 @@ func (ls *LocalSource) GetFirstMatch(ctx context.Context, candidates []namer.Mod
 	return ps, err
 }
 
-func (ls *LocalSource) Reload(_ context.Context) error {
-	return ls.loadBundle()
+func (ls *LocalSource) Diagnostics(ctx context.Context) (diags []compile.Diagnostic, err error) {
+	ls.mu.RLock()
+	diags, err = ls.bundle.Diagnostics(ctx)
+	ls.mu.RUnlock()
+	return diags, err
+}
+
+// PolicyFingerprint returns a value that changes every time the bundle is loaded.
+func (ls *LocalSource) PolicyFingerprint() string {
+	return strconv.FormatUint(ls.generation.Load(), 10)
+}
+
+func (ls *LocalSource) Reload(ctx context.Context) error {
+	return ls.loadBundle(ctx)
 }
 
 func (ls *LocalSource) Close() error {
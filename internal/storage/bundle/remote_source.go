@@ -17,6 +17,7 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/storage"
@@ -225,6 +226,13 @@ func (s *RemoteSource) swapBundle(bundlePath string) error {
 		return fmt.Errorf("failed to open bundle: %w", err)
 	}
 
+	if err := validateBundle(context.Background(), "remote", s.conf.Validation.testsDir(), bundle); err != nil {
+		if err := bundle.Release(); err != nil {
+			s.log.Warn("Failed to release rejected bundle", zap.Error(err))
+		}
+		return fmt.Errorf("bundle was not activated: %w", err)
+	}
+
 	s.mu.Lock()
 	oldBundle := s.bundle
 	s.bundle = bundle
@@ -253,6 +261,11 @@ func (s *RemoteSource) activeBundleVersion() string {
 	return s.bundle.manifest.Meta.Identifier
 }
 
+// PolicyFingerprint returns the identifier of the active bundle.
+func (s *RemoteSource) PolicyFingerprint() string {
+	return s.activeBundleVersion()
+}
+
 func (s *RemoteSource) startWatchLoop(ctx context.Context, noBundleBackoff backoff.BackOff) {
 	s.log.Info("Starting watch")
 	wait, err := s.startWatch(ctx)
@@ -426,6 +439,17 @@ func (s *RemoteSource) GetFirstMatch(ctx context.Context, candidates []namer.Mod
 	return s.bundle.GetFirstMatch(ctx, candidates)
 }
 
+func (s *RemoteSource) Diagnostics(ctx context.Context) ([]compile.Diagnostic, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.bundle == nil {
+		return nil, ErrBundleNotLoaded
+	}
+
+	return s.bundle.Diagnostics(ctx)
+}
+
 func (s *RemoteSource) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/metrics"
 	"github.com/cerbos/cerbos/internal/observability/tracing"
@@ -57,6 +58,10 @@ func (is instrumentedSource) GetFirstMatch(ctx context.Context, candidates []nam
 	})
 }
 
+func (is instrumentedSource) Diagnostics(ctx context.Context) ([]compile.Diagnostic, error) {
+	return diagnostics(ctx, is.source)
+}
+
 func (is instrumentedSource) Reload(ctx context.Context) error {
 	if r, ok := is.source.(storage.Reloadable); ok {
 		return r.Reload(ctx)
@@ -71,6 +76,10 @@ func (is instrumentedSource) Close() error {
 	return nil
 }
 
+func (is instrumentedSource) PolicyFingerprint() string {
+	return policyFingerprint(is.source)
+}
+
 func (is instrumentedSource) SourceKind() string {
 	if s, ok := is.source.(Source); ok {
 		return s.SourceKind()
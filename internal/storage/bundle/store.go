@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/compile"
 	"github.com/cerbos/cerbos/internal/config"
 	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/storage"
@@ -86,6 +87,32 @@ type Source interface {
 	SourceKind() string
 }
 
+// fingerprinter is implemented by sources that can identify the bundle they currently serve.
+type fingerprinter interface {
+	PolicyFingerprint() string
+}
+
+func policyFingerprint(source storage.BinaryStore) string {
+	if f, ok := source.(fingerprinter); ok {
+		return f.PolicyFingerprint()
+	}
+
+	return ""
+}
+
+// diagnoser is implemented by sources that can report the problems with the bundle they currently serve.
+type diagnoser interface {
+	Diagnostics(context.Context) ([]compile.Diagnostic, error)
+}
+
+func diagnostics(ctx context.Context, source storage.BinaryStore) ([]compile.Diagnostic, error) {
+	if d, ok := source.(diagnoser); ok {
+		return d.Diagnostics(ctx)
+	}
+
+	return nil, nil
+}
+
 type HybridStore struct {
 	log             *zap.Logger
 	local           storage.BinaryStore
@@ -122,6 +149,20 @@ func (hs *HybridStore) GetFirstMatch(ctx context.Context, candidates []namer.Mod
 	return hs.withActiveSource().GetFirstMatch(ctx, candidates)
 }
 
+// Diagnostics returns the problems with the bundle served by the active source.
+func (hs *HybridStore) Diagnostics(ctx context.Context) ([]compile.Diagnostic, error) {
+	return diagnostics(ctx, hs.withActiveSource())
+}
+
+// PolicyFingerprint identifies the bundle served by the active source.
+func (hs *HybridStore) PolicyFingerprint() string {
+	if hs.remoteIsHealthy() {
+		return "remote:" + policyFingerprint(hs.remote)
+	}
+
+	return "local:" + policyFingerprint(hs.local)
+}
+
 func (hs *HybridStore) SourceKind() string {
 	return "hybrid"
 }
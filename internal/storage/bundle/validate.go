@@ -0,0 +1,83 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/verify"
+)
+
+var ErrValidationFailed = errors.New("bundle failed validation tests")
+
+// validationTarget is the subset of Bundle functionality required to run tests against it.
+type validationTarget interface {
+	engine.PolicyLoader
+	schema.Loader
+}
+
+// validateBundle runs the policy tests in testsDir against the candidate bundle and returns an error if any of them fail.
+// An empty testsDir disables validation.
+func validateBundle(ctx context.Context, source, testsDir string, bundle validationTarget) error {
+	if testsDir == "" {
+		return nil
+	}
+
+	err := runValidationTests(ctx, testsDir, bundle)
+	if err != nil {
+		zap.L().Named("bundle").Error("Bundle failed validation", zap.String("source", source), zap.String("tests", testsDir), zap.Error(err))
+		_ = stats.RecordWithTags(context.Background(),
+			[]tag.Mutator{tag.Upsert(metrics.KeyBundleSource, source)},
+			metrics.BundleValidationFailuresCount.M(1),
+		)
+	}
+
+	return err
+}
+
+func runValidationTests(ctx context.Context, testsDir string, bundle validationTarget) error {
+	schemaConf, err := schema.GetConf()
+	if err != nil {
+		return fmt.Errorf("failed to read schema configuration: %w", err)
+	}
+
+	schemaMgr := schema.NewFromConf(ctx, bundle, schemaConf)
+	eng, err := engine.NewEphemeral(bundle, schemaMgr)
+	if err != nil {
+		return fmt.Errorf("failed to create engine to run tests: %w", err)
+	}
+
+	results, err := verify.Verify(ctx, os.DirFS(testsDir), eng, verify.Config{})
+	if err != nil {
+		return fmt.Errorf("failed to run tests from %q: %w", testsDir, err)
+	}
+
+	switch results.Summary.OverallResult {
+	case policyv1.TestResults_RESULT_FAILED, policyv1.TestResults_RESULT_ERRORED:
+		return fmt.Errorf("%w: %d of %d tests did not pass", ErrValidationFailed, results.Summary.TestsCount-passedCount(results), results.Summary.TestsCount)
+	default:
+		return nil
+	}
+}
+
+func passedCount(results *policyv1.TestResults) uint32 {
+	for _, c := range results.Summary.ResultCounts {
+		if c.Result == policyv1.TestResults_RESULT_PASSED {
+			return c.Count
+		}
+	}
+
+	return 0
+}
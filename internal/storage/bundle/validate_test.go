@@ -0,0 +1,154 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/observability/metrics"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+const validationTestSuite = `---
+name: album_object resource policy tests
+principals:
+  user:
+    id: user
+    roles: ["user"]
+resources:
+  album:
+    id: album
+    kind: album:object
+    attr:
+      public: true
+tests:
+  - name: User can view public album
+    input:
+      principals: ["user"]
+      resources: ["album"]
+      actions: ["view"]
+    expected:
+      - principal: user
+        resource: album
+        actions:
+          view: %s
+`
+
+type storeTarget struct {
+	*compile.Manager
+	store *disk.Store
+}
+
+func (st storeTarget) LoadSchema(ctx context.Context, id string) (io.ReadCloser, error) {
+	return st.store.LoadSchema(ctx, id)
+}
+
+func TestValidateBundle(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.PathToDir(t, "store")})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+	target := storeTarget{Manager: compile.NewManagerFromDefaultConf(ctx, store, schemaMgr), store: store}
+
+	mkTestsDir := func(t *testing.T, effect string) string {
+		t.Helper()
+
+		dir := t.TempDir()
+		suite := fmt.Sprintf(validationTestSuite, effect)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "album_test.yaml"), []byte(suite), 0o600))
+		return dir
+	}
+
+	t.Run("passing", func(t *testing.T) {
+		require.NoError(t, validateBundle(ctx, "local", mkTestsDir(t, "EFFECT_ALLOW"), target))
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		require.NoError(t, view.Register(metrics.BundleValidationFailuresCountView))
+		t.Cleanup(func() { view.Unregister(metrics.BundleValidationFailuresCountView) })
+
+		require.ErrorIs(t, validateBundle(ctx, "local", mkTestsDir(t, "EFFECT_DENY"), target), ErrValidationFailed)
+
+		rows, err := view.RetrieveData(metrics.BundleValidationFailuresCountView.Name)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(1), rows[0].Data.(*view.CountData).Value)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		require.NoError(t, validateBundle(ctx, "local", "", target))
+	})
+}
+
+func TestLocalSourceValidation(t *testing.T) {
+	dir := test.PathToDir(t, "bundle")
+	bundlePath := filepath.Join(dir, "bundle.crbp")
+	keyBytes, err := os.ReadFile(filepath.Join(dir, "secret_key.txt"))
+	require.NoError(t, err, "Failed to read secret key")
+	key := string(bytes.TrimSpace(keyBytes))
+	tempDir := t.TempDir()
+
+	testsDir := t.TempDir()
+	writeTestSuite := func(t *testing.T, effect string) {
+		t.Helper()
+		suite := fmt.Sprintf(validationTestSuite, effect)
+		require.NoError(t, os.WriteFile(filepath.Join(testsDir, "album_test.yaml"), []byte(suite), 0o600))
+	}
+
+	mkSource := func() (*LocalSource, error) {
+		return NewLocalSource(LocalParams{
+			BundlePath: bundlePath,
+			TempDir:    tempDir,
+			SecretKey:  key,
+			TestsDir:   testsDir,
+		})
+	}
+
+	t.Run("failing_initial_bundle", func(t *testing.T) {
+		writeTestSuite(t, "EFFECT_DENY")
+
+		_, err := mkSource()
+		require.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("failing_reload", func(t *testing.T) {
+		writeTestSuite(t, "EFFECT_ALLOW")
+
+		ls, err := mkSource()
+		require.NoError(t, err, "Failed to create local source")
+		t.Cleanup(func() {
+			require.NoError(t, ls.Close(), "Failed to close local source")
+		})
+
+		fingerprint := ls.PolicyFingerprint()
+
+		writeTestSuite(t, "EFFECT_DENY")
+		require.ErrorIs(t, ls.Reload(context.Background()), ErrValidationFailed)
+		require.Equal(t, fingerprint, ls.PolicyFingerprint(), "Candidate bundle must not be activated")
+
+		ids, err := ls.ListPolicyIDs(context.Background(), storage.ListPolicyIDsParams{IncludeDisabled: true})
+		require.NoError(t, err, "Current bundle must remain usable")
+		require.NotEmpty(t, ids)
+
+		writeTestSuite(t, "EFFECT_ALLOW")
+		require.NoError(t, ls.Reload(context.Background()))
+		require.NotEqual(t, fingerprint, ls.PolicyFingerprint())
+	})
+}
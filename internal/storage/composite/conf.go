@@ -0,0 +1,57 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package composite
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const confKey = storage.ConfKey + ".composite"
+
+// Conf is required (if driver is set to 'composite') configuration for composite storage driver.
+// +desc=This section is required only if storage.driver is composite.
+type Conf struct {
+	// Drivers is the list of storage drivers to combine, in priority order. When several drivers have a policy with the same ID, the one listed first wins. Each driver is configured in its own section.
+	Drivers []string `yaml:"drivers" conf:"required,example=['disk']"`
+}
+
+func (conf *Conf) Key() string {
+	return confKey
+}
+
+func (conf *Conf) Validate() (outErr error) {
+	if len(conf.Drivers) == 0 {
+		return errors.New("drivers is required")
+	}
+
+	seen := make(map[string]struct{}, len(conf.Drivers))
+	for _, d := range conf.Drivers {
+		switch d {
+		case "":
+			outErr = multierr.Append(outErr, errors.New("driver name cannot be empty"))
+		case DriverName:
+			outErr = multierr.Append(outErr, errors.New("composite driver cannot include itself"))
+		}
+
+		if _, ok := seen[d]; ok {
+			outErr = multierr.Append(outErr, fmt.Errorf("driver %q is listed more than once", d))
+		}
+		seen[d] = struct{}{}
+	}
+
+	return outErr
+}
+
+func GetConf() (*Conf, error) {
+	conf := &Conf{}
+	err := config.GetSection(conf)
+
+	return conf, err
+}
@@ -0,0 +1,303 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package composite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/multierr"
+
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/storage"
+)
+
+const DriverName = "composite"
+
+var (
+	_ storage.SourceStore = (*Store)(nil)
+	_ storage.Reloadable  = (*Store)(nil)
+)
+
+func init() {
+	storage.RegisterDriver(DriverName, func(ctx context.Context, confW *config.Wrapper) (storage.Store, error) {
+		conf := new(Conf)
+		if err := confW.GetSection(conf); err != nil {
+			return nil, fmt.Errorf("failed to read composite configuration: %w", err)
+		}
+
+		return NewStore(ctx, conf, confW)
+	})
+}
+
+// Store layers several source stores. Lookups are answered by the first store, in priority order, that has the requested item.
+// Each underlying store must be self-contained: the imports and parent scopes of a policy are resolved within the store that provides it.
+type Store struct {
+	stores []storage.SourceStore
+}
+
+func NewStore(ctx context.Context, conf *Conf, confW *config.Wrapper) (*Store, error) {
+	stores := make([]storage.SourceStore, len(conf.Drivers))
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	for i, driver := range conf.Drivers {
+		i, driver := i, driver
+		p.Go(func(ctx context.Context) error {
+			cons, err := storage.GetDriverConstructor(driver)
+			if err != nil {
+				return err
+			}
+
+			store, err := cons(ctx, confW)
+			if err != nil {
+				return fmt.Errorf("failed to create %s store: %w", driver, err)
+			}
+
+			ss, ok := store.(storage.SourceStore)
+			if !ok {
+				return fmt.Errorf("%s store does not provide policies in source format", driver)
+			}
+
+			stores[i] = ss
+			return nil
+		})
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	return NewStoreFromSources(stores...), nil
+}
+
+// NewStoreFromSources creates a composite store from the given stores, listed in priority order.
+func NewStoreFromSources(stores ...storage.SourceStore) *Store {
+	return &Store{stores: stores}
+}
+
+func (s *Store) Driver() string {
+	return DriverName
+}
+
+func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID) (*policy.CompilationUnit, error) {
+	// Candidates are in order of preference, so a more specific policy from a lower priority store wins over a less specific one from a higher priority store.
+	for _, candidate := range candidates {
+		for _, store := range s.stores {
+			cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{candidate})
+			if err != nil {
+				return nil, err
+			}
+
+			if cu != nil {
+				return cu, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Store) GetCompilationUnits(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID]*policy.CompilationUnit, error) {
+	result := make(map[namer.ModuleID]*policy.CompilationUnit, len(ids))
+	remaining := ids
+	for _, store := range s.stores {
+		if len(remaining) == 0 {
+			break
+		}
+
+		units, err := store.GetCompilationUnits(ctx, remaining...)
+		if err != nil {
+			return nil, err
+		}
+
+		next := make([]namer.ModuleID, 0, len(remaining))
+		for _, id := range remaining {
+			if cu, ok := units[id]; ok {
+				result[id] = cu
+			} else {
+				next = append(next, id)
+			}
+		}
+		remaining = next
+	}
+
+	return result, nil
+}
+
+func (s *Store) GetDependents(ctx context.Context, ids ...namer.ModuleID) (map[namer.ModuleID][]namer.ModuleID, error) {
+	result := make(map[namer.ModuleID][]namer.ModuleID, len(ids))
+	seen := make(map[namer.ModuleID]map[namer.ModuleID]struct{}, len(ids))
+	for _, store := range s.stores {
+		deps, err := store.GetDependents(ctx, ids...)
+		if err != nil {
+			return nil, err
+		}
+
+		for id, dependents := range deps {
+			if seen[id] == nil {
+				seen[id] = make(map[namer.ModuleID]struct{}, len(dependents))
+			}
+
+			for _, d := range dependents {
+				if _, ok := seen[id][d]; ok {
+					continue
+				}
+				seen[id][d] = struct{}{}
+				result[id] = append(result[id], d)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Store) ListPolicyIDs(ctx context.Context, params storage.ListPolicyIDsParams) ([]string, error) {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, store := range s.stores {
+		storeIDs, err := store.ListPolicyIDs(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range storeIDs {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (s *Store) LoadPolicy(ctx context.Context, ids ...string) ([]*policy.Wrapper, error) {
+	// Assign each requested ID to the highest priority store that has it.
+	pending := make(map[string][]int, len(ids))
+	for i, id := range ids {
+		pending[id] = append(pending[id], i)
+	}
+
+	result := make([]*policy.Wrapper, len(ids))
+	for _, store := range s.stores {
+		if len(pending) == 0 {
+			break
+		}
+
+		storeIDs, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{IncludeDisabled: true})
+		if err != nil {
+			return nil, err
+		}
+
+		var toLoad []string
+		for _, id := range storeIDs {
+			if _, ok := pending[id]; ok {
+				toLoad = append(toLoad, id)
+			}
+		}
+
+		if len(toLoad) == 0 {
+			continue
+		}
+
+		policies, err := store.LoadPolicy(ctx, toLoad...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, id := range toLoad {
+			for _, idx := range pending[id] {
+				result[idx] = policies[i]
+			}
+			delete(pending, id)
+		}
+	}
+
+	for id := range pending {
+		return nil, fmt.Errorf("policy %s not found", id)
+	}
+
+	return result, nil
+}
+
+func (s *Store) ListSchemaIDs(ctx context.Context) ([]string, error) {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, store := range s.stores {
+		storeIDs, err := store.ListSchemaIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range storeIDs {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (s *Store) LoadSchema(ctx context.Context, id string) (io.ReadCloser, error) {
+	var firstErr error
+	for _, store := range s.stores {
+		schema, err := store.LoadSchema(ctx, id)
+		if err == nil {
+			return schema, nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return nil, firstErr
+}
+
+func (s *Store) Subscribe(subscriber storage.Subscriber) {
+	for _, store := range s.stores {
+		store.Subscribe(subscriber)
+	}
+}
+
+func (s *Store) Unsubscribe(subscriber storage.Subscriber) {
+	for _, store := range s.stores {
+		store.Unsubscribe(subscriber)
+	}
+}
+
+func (s *Store) Reload(ctx context.Context) error {
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	for _, store := range s.stores {
+		if rs, ok := store.(storage.Reloadable); ok {
+			p.Go(func(ctx context.Context) error { return rs.Reload(ctx) })
+		}
+	}
+
+	return p.Wait()
+}
+
+func (s *Store) Close() (outErr error) {
+	for _, store := range s.stores {
+		if c, ok := store.(io.Closer); ok {
+			outErr = multierr.Append(outErr, c.Close())
+		}
+	}
+
+	return outErr
+}
@@ -0,0 +1,200 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package composite_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	"github.com/cerbos/cerbos/internal/config"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/storage"
+	"github.com/cerbos/cerbos/internal/storage/composite"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+)
+
+const policyTemplate = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: %s
+  rules:
+    - name: %s
+      actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+func TestCompositeStore(t *testing.T) {
+	ctx := context.Background()
+
+	overrideDir := t.TempDir()
+	writeFile(t, overrideDir, "leave_request.yaml", mkPolicy("leave_request", "team-override"))
+	writeFile(t, overrideDir, "_schemas/leave_request.json", `{"source": "override"}`)
+
+	baselineDir := t.TempDir()
+	writeFile(t, baselineDir, "leave_request.yaml", mkPolicy("leave_request", "baseline"))
+	writeFile(t, baselineDir, "purchase_order.yaml", mkPolicy("purchase_order", "baseline"))
+	writeFile(t, baselineDir, "_schemas/leave_request.json", `{"source": "baseline"}`)
+	writeFile(t, baselineDir, "_schemas/purchase_order.json", `{"source": "baseline"}`)
+
+	store := composite.NewStoreFromSources(mkDiskStore(t, overrideDir), mkDiskStore(t, baselineDir))
+
+	leaveRequest := namer.ResourcePolicyModuleID("leave_request", "default", "")
+	purchaseOrder := namer.ResourcePolicyModuleID("purchase_order", "default", "")
+
+	t.Run("higher_priority_overrides", func(t *testing.T) {
+		cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{leaveRequest})
+		require.NoError(t, err)
+		require.NotNil(t, cu)
+		require.Equal(t, "team-override", ruleName(cu.Definitions[leaveRequest]))
+	})
+
+	t.Run("falls_back_to_lower_priority", func(t *testing.T) {
+		cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{purchaseOrder})
+		require.NoError(t, err)
+		require.NotNil(t, cu)
+		require.Equal(t, "baseline", ruleName(cu.Definitions[purchaseOrder]))
+	})
+
+	t.Run("no_match", func(t *testing.T) {
+		cu, err := store.GetFirstMatch(ctx, []namer.ModuleID{namer.ResourcePolicyModuleID("expense", "default", "")})
+		require.NoError(t, err)
+		require.Nil(t, cu)
+	})
+
+	t.Run("compilation_units", func(t *testing.T) {
+		units, err := store.GetCompilationUnits(ctx, leaveRequest, purchaseOrder)
+		require.NoError(t, err)
+		require.Len(t, units, 2)
+		require.Equal(t, "team-override", ruleName(units[leaveRequest].Definitions[leaveRequest]))
+		require.Equal(t, "baseline", ruleName(units[purchaseOrder].Definitions[purchaseOrder]))
+	})
+
+	t.Run("list_and_load_policies", func(t *testing.T) {
+		ids, err := store.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"leave_request.yaml", "purchase_order.yaml"}, ids)
+
+		policies, err := store.LoadPolicy(ctx, ids...)
+		require.NoError(t, err)
+		require.Len(t, policies, 2)
+		require.Equal(t, "team-override", ruleName(policies[0].Policy))
+		require.Equal(t, "baseline", ruleName(policies[1].Policy))
+
+		_, err = store.LoadPolicy(ctx, "missing.yaml")
+		require.Error(t, err)
+	})
+
+	t.Run("schemas", func(t *testing.T) {
+		ids, err := store.ListSchemaIDs(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"leave_request.json", "purchase_order.json"}, ids)
+
+		require.JSONEq(t, `{"source": "override"}`, loadSchema(t, store, "leave_request.json"))
+		require.JSONEq(t, `{"source": "baseline"}`, loadSchema(t, store, "purchase_order.json"))
+
+		_, err = store.LoadSchema(ctx, "missing.json")
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestDriverInstantiation(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "leave_request.yaml", mkPolicy("leave_request", "baseline"))
+
+	conf := map[string]any{
+		"storage": map[string]any{
+			"driver": "composite",
+			"composite": map[string]any{
+				"drivers": []string{"disk"},
+			},
+			"disk": map[string]any{
+				"directory": dir,
+			},
+		},
+	}
+	require.NoError(t, config.LoadMap(conf))
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := storage.New(ctx)
+	require.NoError(t, err)
+	require.Equal(t, composite.DriverName, store.Driver())
+
+	_, ok := store.(storage.SourceStore)
+	require.True(t, ok)
+}
+
+func TestConfValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		drivers []string
+		wantErr bool
+	}{
+		{name: "valid", drivers: []string{"disk", "git"}},
+		{name: "empty", wantErr: true},
+		{name: "duplicate", drivers: []string{"disk", "disk"}, wantErr: true},
+		{name: "self", drivers: []string{"disk", composite.DriverName}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conf := &composite.Conf{Drivers: tc.drivers}
+			if tc.wantErr {
+				require.Error(t, conf.Validate())
+			} else {
+				require.NoError(t, conf.Validate())
+			}
+		})
+	}
+}
+
+func mkPolicy(resource, rule string) string {
+	return fmt.Sprintf(policyTemplate, resource, rule)
+}
+
+func ruleName(p *policyv1.Policy) string {
+	return p.GetResourcePolicy().Rules[0].Name
+}
+
+func writeFile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+}
+
+func mkDiskStore(t *testing.T, dir string) *disk.Store {
+	t.Helper()
+
+	store, err := disk.NewStore(context.Background(), &disk.Conf{Directory: dir})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
+
+func loadSchema(t *testing.T, store storage.Store, id string) string {
+	t.Helper()
+
+	r, err := store.LoadSchema(context.Background(), id)
+	require.NoError(t, err)
+	defer r.Close()
+
+	contents, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(contents)
+}
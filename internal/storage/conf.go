@@ -6,6 +6,7 @@ package storage
 import (
 	"fmt"
 
+	"go.uber.org/multierr"
 	"gopkg.in/yaml.v3"
 
 	"github.com/cerbos/cerbos/internal/config"
@@ -23,12 +24,43 @@ type Conf struct {
 type confHolder struct {
 	// Driver defines which storage driver to use.
 	Driver string `yaml:"driver" conf:"required,example=\"disk\""`
+	// MaxPolicies is the maximum number of policies that the store is allowed to load. Initialization fails if the limit is exceeded and new policies are rejected once the limit is reached. Only supported by the blob, disk and git drivers. Zero means no limit.
+	MaxPolicies uint `yaml:"maxPolicies" conf:",example=10000"`
+	// AllowedResourceKinds restricts the store to resource policies for the listed resource kinds. Resource policies for other kinds are not loaded and requests for them are denied by default. Only supported by the blob, disk and git drivers. Empty means all resource kinds are loaded.
+	AllowedResourceKinds []string `yaml:"allowedResourceKinds" conf:",example=['leave_request']"`
 }
 
 func (c *Conf) Key() string {
 	return ConfKey
 }
 
+// indexedDrivers are the drivers that load the policies into an in-memory index, which applies the policy restrictions of this section.
+// The composite and overlay drivers delegate to other drivers, which read this section themselves.
+var indexedDrivers = map[string]struct{}{
+	"blob":      {},
+	"composite": {},
+	"disk":      {},
+	"git":       {},
+	"overlay":   {},
+}
+
+func (c *Conf) Validate() error {
+	if _, ok := indexedDrivers[c.Driver]; ok {
+		return nil
+	}
+
+	var errs error
+	if c.MaxPolicies > 0 {
+		errs = multierr.Append(errs, fmt.Errorf("storage.maxPolicies is not supported by the %s driver", c.Driver))
+	}
+
+	if len(c.AllowedResourceKinds) > 0 {
+		errs = multierr.Append(errs, fmt.Errorf("storage.allowedResourceKinds is not supported by the %s driver", c.Driver))
+	}
+
+	return errs
+}
+
 func (c *Conf) UnmarshalYAML(unmarshal func(any) error) error {
 	// We want to avoid defining all the storage driver configuration structs as fields of the Conf
 	// struct to maintain the "plugin" nature of those drivers (and avoid circular package references).
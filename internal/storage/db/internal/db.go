@@ -225,7 +225,8 @@ func (s *dbStorage) AddOrUpdate(ctx context.Context, policies ...policy.Wrapper)
 	events := make([]storage.Event, len(policies))
 	err := s.db.WithTx(func(tx *goqu.TxDatabase) error {
 		for i, p := range policies {
-			if err := s.opts.upsertPolicy(ctx, tx, p); err != nil {
+			def := PolicyDefWrapper{Policy: p.Policy, Compress: s.opts.compressPolicies}
+			if err := s.opts.upsertPolicy(ctx, tx, p, def); err != nil {
 				return fmt.Errorf("failed to upsert %s: %w", p.FQN, err)
 			}
 
@@ -16,14 +16,15 @@ import (
 type DBOpt func(*dbOpt)
 
 type (
-	upsertPolicyFunc func(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) error
+	upsertPolicyFunc func(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper, def PolicyDefWrapper) error
 	upsertSchemaFunc func(ctx context.Context, tx *goqu.TxDatabase, schema Schema) error
 )
 
 type dbOpt struct {
-	upsertPolicy upsertPolicyFunc
-	upsertSchema upsertSchemaFunc
-	regexpCache  *util.RegexpCache
+	upsertPolicy     upsertPolicyFunc
+	upsertSchema     upsertSchemaFunc
+	regexpCache      *util.RegexpCache
+	compressPolicies bool
 }
 
 func newDbOpt() *dbOpt {
@@ -54,3 +55,11 @@ func WithRegexpCacheOverride(c *util.RegexpCache) DBOpt {
 		opt.regexpCache = c
 	}
 }
+
+// WithPolicyCompression stores policy definitions gzip-compressed.
+// Definitions are decompressed transparently when reading, regardless of this setting.
+func WithPolicyCompression(compress bool) DBOpt {
+	return func(opt *dbOpt) {
+		opt.compressPolicies = compress
+	}
+}
@@ -4,8 +4,11 @@
 package internal
 
 import (
+	"bytes"
+	"compress/gzip"
 	"database/sql/driver"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/jackc/pgtype"
@@ -68,12 +71,33 @@ type PolicyAncestor struct {
 	AncestorID namer.ModuleID `db:"ancestor_id"`
 }
 
+// gzipMagic is the header of gzip streams. A serialized policy can never start with these bytes because
+// 0x1f is not a valid protobuf tag, so compressed and uncompressed definitions can be told apart when reading.
+var gzipMagic = []byte{0x1f, 0x8b}
+
 type PolicyDefWrapper struct {
 	*policyv1.Policy
+	// Compress stores the definition gzip-compressed.
+	Compress bool
 }
 
 func (pdw PolicyDefWrapper) Value() (driver.Value, error) {
-	return pdw.Policy.MarshalVT()
+	def, err := pdw.Policy.MarshalVT()
+	if err != nil || !pdw.Compress {
+		return def, err
+	}
+
+	buf := new(bytes.Buffer)
+	zw := gzip.NewWriter(buf)
+	if _, err := zw.Write(def); err != nil {
+		return nil, fmt.Errorf("failed to compress policy definition: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress policy definition: %w", err)
+	}
+
+	return buf.Bytes(), nil
 }
 
 func (pdw *PolicyDefWrapper) Scan(src any) error {
@@ -89,6 +113,17 @@ func (pdw *PolicyDefWrapper) Scan(src any) error {
 		return fmt.Errorf("unexpected type for policy definition: %T", src)
 	}
 
+	if bytes.HasPrefix(source, gzipMagic) {
+		zr, err := gzip.NewReader(bytes.NewReader(source))
+		if err != nil {
+			return fmt.Errorf("failed to decompress policy definition: %w", err)
+		}
+
+		if source, err = io.ReadAll(zr); err != nil {
+			return fmt.Errorf("failed to decompress policy definition: %w", err)
+		}
+	}
+
 	pdw.Policy = &policyv1.Policy{}
 	if err := pdw.Policy.UnmarshalVT(source); err != nil {
 		return fmt.Errorf("failed to unmarshal policy definition: %w", err)
@@ -0,0 +1,51 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/cerbos/cerbos/internal/storage/db/internal"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+func TestPolicyDefWrapper(t *testing.T) {
+	p := test.GenResourcePolicy(test.NoMod())
+
+	uncompressed, err := internal.PolicyDefWrapper{Policy: p}.Value()
+	require.NoError(t, err)
+
+	compressed, err := internal.PolicyDefWrapper{Policy: p, Compress: true}.Value()
+	require.NoError(t, err)
+	require.NotEqual(t, uncompressed, compressed)
+
+	testCases := []struct {
+		src  any
+		name string
+	}{
+		{name: "compressed", src: compressed},
+		{name: "uncompressed", src: uncompressed},
+		{name: "uncompressed_string", src: string(uncompressed.([]byte))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var have internal.PolicyDefWrapper
+			require.NoError(t, have.Scan(tc.src))
+			require.Empty(t, cmp.Diff(p, have.Policy, protocmp.Transform()))
+		})
+	}
+
+	t.Run("corrupt", func(t *testing.T) {
+		corrupt := append([]byte{}, compressed.([]byte)[:8]...)
+
+		var have internal.PolicyDefWrapper
+		require.Error(t, have.Scan(corrupt))
+	})
+}
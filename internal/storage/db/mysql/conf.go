@@ -20,6 +20,8 @@ type Conf struct {
 	DSN string `yaml:"dsn" conf:"required,example=\"user:password@tcp(localhost:3306)/db?interpolateParams=true\""`
 	// SkipSchemaCheck skips checking for required database tables on startup.
 	SkipSchemaCheck bool `yaml:"skipSchemaCheck" conf:",example=false"`
+	// CompressPolicies stores policy definitions gzip-compressed to reduce the size of the rows. Policies that were stored without compression can still be read.
+	CompressPolicies bool `yaml:"compressPolicies" conf:",example=false"`
 }
 
 type TLSConf struct {
@@ -64,7 +64,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 
 	conf.ConnPool.Configure(db)
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("mysql", db), internal.WithUpsertPolicy(upsertPolicy))
+	s, err := internal.NewDBStorage(ctx, goqu.New("mysql", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithPolicyCompression(conf.CompressPolicies))
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +153,7 @@ func registerServerPubKeys(conf *Conf) error {
 	return nil
 }
 
-func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) error {
+func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper, def internal.PolicyDefWrapper) error {
 	pr := internal.Policy{
 		ID:          p.ID,
 		Kind:        p.Kind.String(),
@@ -162,7 +162,7 @@ func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) er
 		Scope:       p.Scope,
 		Description: p.Description,
 		Disabled:    p.Disabled,
-		Definition:  internal.PolicyDefWrapper{Policy: p.Policy},
+		Definition:  def,
 	}
 
 	if _, err := tx.Insert(internal.PolicyTbl).Prepared(true).Rows(pr).Executor().ExecContext(ctx); err != nil {
@@ -65,7 +65,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 
 	conf.ConnPool.Configure(db)
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("postgres", db), internal.WithUpsertPolicy(upsertPolicy))
+	s, err := internal.NewDBStorage(ctx, goqu.New("postgres", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithPolicyCompression(conf.CompressPolicies))
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 	return &Store{DBStorage: s}, nil
 }
 
-func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) error {
+func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper, def internal.PolicyDefWrapper) error {
 	pr := internal.Policy{
 		ID:          p.ID,
 		Kind:        p.Kind.String(),
@@ -88,7 +88,7 @@ func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) er
 		Scope:       p.Scope,
 		Description: p.Description,
 		Disabled:    p.Disabled,
-		Definition:  internal.PolicyDefWrapper{Policy: p.Policy},
+		Definition:  def,
 	}
 	res, err := tx.Insert(goqu.T(internal.PolicyTbl).As("p")).
 		Prepared(true).
@@ -12,6 +12,8 @@ const confKey = storage.ConfKey + ".sqlite3"
 type Conf struct {
 	// Data source name
 	DSN string `yaml:"dsn" conf:"required,example=\":memory:?_fk=true\""`
+	// CompressPolicies stores policy definitions gzip-compressed to reduce the size of the rows. Policies that were stored without compression can still be read.
+	CompressPolicies bool `yaml:"compressPolicies" conf:",example=false"`
 }
 
 func (c *Conf) Key() string {
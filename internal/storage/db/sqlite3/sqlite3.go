@@ -101,7 +101,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 		return nil, fmt.Errorf("failed to migrate schema: %w", err)
 	}
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("sqlite3", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithRegexpCacheOverride(&nameRegexpCache))
+	s, err := internal.NewDBStorage(ctx, goqu.New("sqlite3", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithPolicyCompression(conf.CompressPolicies), internal.WithRegexpCacheOverride(&nameRegexpCache))
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +132,7 @@ func runMigrations(db *sql.DB) error {
 	return nil
 }
 
-func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) error {
+func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper, def internal.PolicyDefWrapper) error {
 	pr := internal.Policy{
 		ID:          p.ID,
 		Kind:        p.Kind.String(),
@@ -141,7 +141,7 @@ func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) er
 		Scope:       p.Scope,
 		Description: p.Description,
 		Disabled:    p.Disabled,
-		Definition:  internal.PolicyDefWrapper{Policy: p.Policy},
+		Definition:  def,
 	}
 
 	if _, err := tx.Insert(internal.PolicyTbl).Prepared(true).Rows(pr).Executor().ExecContext(ctx); err != nil {
@@ -5,12 +5,17 @@ package sqlite3_test
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
 	"github.com/cerbos/cerbos/internal/storage/db/internal"
 	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/test"
 )
 
 func TestSQLite(t *testing.T) {
@@ -26,3 +31,43 @@ func TestSQLite(t *testing.T) {
 
 	t.Run("suite", internal.TestSuite(store))
 }
+
+func TestSQLiteWithCompression(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	t.Run("suite", func(t *testing.T) {
+		store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: "file::memory:?_fk=true", CompressPolicies: true})
+		require.NoError(t, err)
+
+		internal.TestSuite(store)(t)
+	})
+
+	t.Run("legacy_rows", func(t *testing.T) {
+		dsn := fmt.Sprintf("file:%s?_fk=true", filepath.Join(t.TempDir(), "cerbos.db"))
+
+		legacyStore, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: dsn})
+		require.NoError(t, err)
+
+		legacy := policy.Wrap(test.GenResourcePolicy(test.Suffix("_legacy")))
+		require.NoError(t, legacyStore.AddOrUpdate(ctx, legacy))
+
+		store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: dsn, CompressPolicies: true})
+		require.NoError(t, err)
+
+		compressed := policy.Wrap(test.GenResourcePolicy(test.Suffix("_compressed")))
+		require.NoError(t, store.AddOrUpdate(ctx, compressed))
+
+		for _, want := range []policy.Wrapper{legacy, compressed} {
+			have, err := store.LoadPolicy(ctx, namer.PolicyKey(want.Policy))
+			require.NoError(t, err)
+			require.Len(t, have, 1)
+			require.Equal(t, want.Name, have[0].Name)
+
+			units, err := store.GetCompilationUnits(ctx, want.ID)
+			require.NoError(t, err)
+			require.Contains(t, units, want.ID)
+			require.Contains(t, units[want.ID].Definitions, want.ID)
+		}
+	})
+}
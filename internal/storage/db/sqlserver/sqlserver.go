@@ -63,7 +63,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 
 	conf.ConnPool.Configure(db)
 
-	s, err := internal.NewDBStorage(ctx, goqu.New("sqlserver", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithUpsertSchema(upsertSchema))
+	s, err := internal.NewDBStorage(ctx, goqu.New("sqlserver", db), internal.WithUpsertPolicy(upsertPolicy), internal.WithPolicyCompression(conf.CompressPolicies), internal.WithUpsertSchema(upsertSchema))
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +85,7 @@ func (s *Store) Driver() string {
 	return DriverName
 }
 
-func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper) error {
+func upsertPolicy(ctx context.Context, tx *goqu.TxDatabase, p policy.Wrapper, def internal.PolicyDefWrapper) error {
 	stm, err := tx.Prepare(`
 UPDATE dbo.[policy] WITH (UPDLOCK, SERIALIZABLE) SET "definition"=@definition, "description"=@description,"disabled"=@disabled,"kind"=@kind,"version"=@version,"scope"=@scope where [id] = @id AND [name] = @name
 IF @@ROWCOUNT = 0
@@ -99,7 +99,7 @@ END
 
 	defer stm.Close()
 
-	definition, err := internal.PolicyDefWrapper{Policy: p.Policy}.Value()
+	definition, err := def.Value()
 	if err != nil {
 		return fmt.Errorf("failed to get definition value: %w", err)
 	}
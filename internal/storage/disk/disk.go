@@ -32,7 +32,12 @@ func init() {
 			return nil, fmt.Errorf("failed to read disk configuration: %w", err)
 		}
 
-		return NewStore(ctx, conf)
+		storageConf := new(storage.Conf)
+		if err := confW.GetSection(storageConf); err != nil {
+			return nil, fmt.Errorf("failed to read storage configuration: %w", err)
+		}
+
+		return NewStore(ctx, conf, index.WithMaxPolicies(storageConf.MaxPolicies), index.WithResourceKinds(storageConf.AllowedResourceKinds))
 	})
 }
 
@@ -42,7 +47,7 @@ type Store struct {
 	*storage.SubscriptionManager
 }
 
-func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+func NewStore(ctx context.Context, conf *Conf, opts ...index.BuildOpt) (*Store, error) {
 	dir, err := filepath.Abs(conf.Directory)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine absolute path of directory [%s]: %w", conf.Directory, err)
@@ -55,7 +60,7 @@ func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
 		return nil, err
 	}
 
-	idx, err := index.Build(ctx, fsys)
+	idx, err := index.Build(ctx, fsys, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,12 @@ func init() {
 			return nil, fmt.Errorf("failed to read git configuration: %w", err)
 		}
 
-		return NewStore(ctx, conf)
+		storageConf := new(storage.Conf)
+		if err := confW.GetSection(storageConf); err != nil {
+			return nil, fmt.Errorf("failed to read storage configuration: %w", err)
+		}
+
+		return NewStore(ctx, conf, index.WithMaxPolicies(storageConf.MaxPolicies), index.WithResourceKinds(storageConf.AllowedResourceKinds))
 	})
 }
 
@@ -55,11 +60,13 @@ type Store struct {
 	repo *git.Repository
 	sf   singleflight.Group
 	*storage.SubscriptionManager
-	subDir string
+	subDir    string
+	buildOpts []index.BuildOpt
 }
 
-func NewStore(ctx context.Context, conf *Conf) (*Store, error) {
+func NewStore(ctx context.Context, conf *Conf, opts ...index.BuildOpt) (*Store, error) {
 	s := &Store{
+		buildOpts:           opts,
 		log:                 zap.S().Named("git.store").With("dir", conf.CheckoutDir),
 		conf:                conf,
 		subDir:              conf.getSubDir(),
@@ -228,7 +235,7 @@ func (s *Store) cloneRepo(ctx context.Context) error {
 }
 
 func (s *Store) loadAll(ctx context.Context) error {
-	idx, err := index.Build(ctx, os.DirFS(s.conf.CheckoutDir), index.WithRootDir(s.subDir))
+	idx, err := index.Build(ctx, os.DirFS(s.conf.CheckoutDir), append([]index.BuildOpt{index.WithRootDir(s.subDir)}, s.buildOpts...)...)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ package index
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -24,8 +25,12 @@ import (
 	"github.com/cerbos/cerbos/internal/util"
 )
 
-// errSchemasInWrongDir signals that schemas folder is in the wrong place.
-var errSchemasInWrongDir = fmt.Errorf("%s directory must be under the root of the storage directory", util.SchemasDirectory)
+var (
+	// errSchemasInWrongDir signals that schemas folder is in the wrong place.
+	errSchemasInWrongDir = fmt.Errorf("%s directory must be under the root of the storage directory", util.SchemasDirectory)
+	// ErrTooManyPolicies signals that the number of policies exceeds the configured maximum.
+	ErrTooManyPolicies = errors.New("too many policies")
+)
 
 const maxLoggableBuildErrors = 5
 
@@ -41,8 +46,25 @@ func (ibe *BuildError) Error() string {
 }
 
 type buildOptions struct {
+	resourceKinds        map[string]struct{}
 	rootDir              string
 	buildFailureLogLevel zapcore.Level
+	maxPolicies          uint
+}
+
+// excludes returns true if the policy is a resource policy for a kind that is not in the resource kind allowlist.
+func (o buildOptions) excludes(p *policyv1.Policy) bool {
+	if len(o.resourceKinds) == 0 {
+		return false
+	}
+
+	rp := p.GetResourcePolicy()
+	if rp == nil {
+		return false
+	}
+
+	_, ok := o.resourceKinds[rp.Resource]
+	return !ok
 }
 
 type BuildOpt func(*buildOptions)
@@ -53,6 +75,29 @@ func WithBuildFailureLogLevel(level zapcore.Level) BuildOpt {
 	}
 }
 
+// WithMaxPolicies sets the maximum number of policies allowed in the index. Zero means no limit.
+func WithMaxPolicies(maxPolicies uint) BuildOpt {
+	return func(o *buildOptions) {
+		o.maxPolicies = maxPolicies
+	}
+}
+
+// WithResourceKinds restricts the index to resource policies for the given resource kinds.
+// Other policy kinds are not affected. An empty list means all resource kinds are included.
+func WithResourceKinds(kinds []string) BuildOpt {
+	return func(o *buildOptions) {
+		if len(kinds) == 0 {
+			o.resourceKinds = nil
+			return
+		}
+
+		o.resourceKinds = make(map[string]struct{}, len(kinds))
+		for _, k := range kinds {
+			o.resourceKinds[k] = struct{}{}
+		}
+	}
+}
+
 func WithRootDir(rootDir string) BuildOpt {
 	return func(o *buildOptions) {
 		o.rootDir = rootDir
@@ -133,8 +178,17 @@ func build(ctx context.Context, fsys fs.FS, opts buildOptions) (Index, error) {
 			return nil
 		}
 
+		if opts.excludes(p) {
+			return nil
+		}
+
 		ib.addPolicy(filePath, policy.Wrap(policy.WithMetadata(p, filePath, nil, filePath)))
 
+		// Bail out early instead of loading and compiling an unbounded number of policies.
+		if opts.maxPolicies > 0 && uint(len(ib.modIDToFile)) > opts.maxPolicies {
+			return fmt.Errorf("%w: the store contains more than the maximum of %d policies allowed by the storage.maxPolicies setting", ErrTooManyPolicies, opts.maxPolicies)
+		}
+
 		return nil
 	})
 	if err != nil {
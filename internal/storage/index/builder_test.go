@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"path/filepath"
@@ -44,7 +45,7 @@ func TestBuildIndexWithDisk(t *testing.T) {
 
 	t.Run("check_contents", func(t *testing.T) {
 		data := idxImpl.Inspect()
-		require.Len(t, data, 33)
+		require.Len(t, data, 35)
 
 		rp1 := filepath.Join("resource_policies", "policy_01.yaml")
 		rp2 := filepath.Join("resource_policies", "policy_02.yaml")
@@ -168,6 +169,82 @@ func TestBuildIndexWithDisk(t *testing.T) {
 	})
 }
 
+func TestBuildIndexWithMaxPolicies(t *testing.T) {
+	dir := test.PathToDir(t, "store")
+
+	build := func(t *testing.T, opts ...BuildOpt) (Index, error) {
+		t.Helper()
+
+		fsys, err := util.OpenDirectoryFS(dir)
+		require.NoError(t, err)
+
+		return Build(context.Background(), fsys, opts...)
+	}
+
+	idx, err := build(t)
+	require.NoError(t, err)
+
+	policyIDs, err := idx.ListPolicyIDs(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, idx.Clear())
+
+	numPolicies := uint(len(policyIDs))
+	require.Greater(t, numPolicies, uint(1))
+
+	t.Run("exceeds_limit", func(t *testing.T) {
+		_, err := build(t, WithMaxPolicies(numPolicies-1))
+		require.ErrorIs(t, err, ErrTooManyPolicies)
+		require.ErrorContains(t, err, fmt.Sprintf("more than the maximum of %d policies", numPolicies-1))
+	})
+
+	t.Run("within_limit", func(t *testing.T) {
+		idx, err := build(t, WithMaxPolicies(numPolicies))
+		require.NoError(t, err)
+		require.NotNil(t, idx)
+
+		defer idx.Clear() //nolint:errcheck
+
+		rp := policy.Wrap(test.GenResourcePolicy(test.PrefixAndSuffix("x", "x")))
+
+		_, err = idx.AddOrUpdate(Entry{File: "resource_policies/new.yaml", Policy: rp})
+		require.ErrorIs(t, err, ErrTooManyPolicies, "Adding a policy beyond the limit should fail")
+		require.NotContains(t, idx.GetFiles(), "resource_policies/new.yaml")
+
+		_, err = idx.AddOrUpdate(Entry{File: "resource_policies/policy_01.yaml", Policy: rp})
+		require.NoError(t, err, "Updating an existing file should not count towards the limit")
+
+		_, err = idx.Delete(Entry{File: "resource_policies/policy_01.yaml"})
+		require.NoError(t, err)
+
+		_, err = idx.AddOrUpdate(Entry{File: "resource_policies/new.yaml", Policy: rp})
+		require.NoError(t, err, "Deleting a policy should make room for a new one")
+	})
+}
+
+func TestBuildIndexWithResourceKinds(t *testing.T) {
+	fsys, err := util.OpenDirectoryFS(test.PathToDir(t, "store"))
+	require.NoError(t, err)
+
+	idx, err := Build(context.Background(), fsys, WithResourceKinds([]string{"leave_request"}))
+	require.NoError(t, err)
+
+	defer idx.Clear() //nolint:errcheck
+
+	files := idx.GetFiles()
+	require.NotContains(t, files, "resource_policies/policy_03.yaml", "Excluded resource kind should not be loaded")
+	require.Contains(t, files, "resource_policies/policy_01.yaml")
+	require.Contains(t, files, "derived_roles/derived_roles_01.yaml")
+	require.Contains(t, files, "principal_policies/policy_01.yaml")
+
+	t.Run("add_excluded_kind", func(t *testing.T) {
+		rp := policy.Wrap(test.GenResourcePolicy(test.PrefixAndSuffix("x", "x")))
+		evt, err := idx.AddOrUpdate(Entry{File: "resource_policies/excluded.yaml", Policy: rp})
+		require.NoError(t, err)
+		require.Equal(t, storage.EventNop, evt.Kind)
+		require.NotContains(t, idx.GetFiles(), "resource_policies/excluded.yaml")
+	})
+}
+
 func TestBuildIndex(t *testing.T) {
 	testCases := test.LoadTestCases(t, "index")
 
@@ -261,6 +261,11 @@ func (idx *index) AddOrUpdate(entry Entry) (evt storage.Event, err error) {
 		return storage.Event{Kind: storage.EventNop}, ErrInvalidEntry
 	}
 
+	if idx.buildOpts.excludes(entry.Policy.Policy) {
+		// Remove the file in case it previously contained a policy for an allowed resource kind.
+		return idx.Delete(entry)
+	}
+
 	modID := entry.Policy.ID
 	evt = storage.NewPolicyEvent(storage.EventAddOrUpdatePolicy, modID)
 	crudKind := "create"
@@ -273,8 +278,15 @@ func (idx *index) AddOrUpdate(entry Entry) (evt storage.Event, err error) {
 		return evt, fmt.Errorf("policy is already defined in %s: %w", otherFile, ErrDuplicatePolicy)
 	}
 
+	oldModID, exists := idx.fileToModID[entry.File]
+
+	// Only new files add to the number of policies.
+	if !exists && idx.buildOpts.maxPolicies > 0 && uint(len(idx.modIDToFile)) >= idx.buildOpts.maxPolicies {
+		return evt, fmt.Errorf("%w: adding %s would exceed the maximum of %d policies allowed by the storage.maxPolicies setting", ErrTooManyPolicies, entry.File, idx.buildOpts.maxPolicies)
+	}
+
 	// if this is an existing file, clear its state first
-	if oldModID, ok := idx.fileToModID[entry.File]; ok {
+	if exists {
 		// go through the dependencies and remove self from the dependents list of each dependency.
 		if deps, ok := idx.dependencies[oldModID]; ok {
 			for dep := range deps {
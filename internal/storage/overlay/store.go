@@ -175,6 +175,23 @@ func (s *Store) GetFirstMatch(ctx context.Context, candidates []namer.ModuleID)
 	)
 }
 
+// PolicyFingerprint identifies the policies served by the policy loader that is currently in use.
+func (s *Store) PolicyFingerprint() string {
+	if s.circuitBreaker.State() == gobreaker.StateOpen {
+		return "fallback:" + policyFingerprint(s.fallbackPolicyLoader)
+	}
+
+	return "base:" + policyFingerprint(s.basePolicyLoader)
+}
+
+func policyFingerprint(pl engine.PolicyLoader) string {
+	if f, ok := pl.(engine.PolicyFingerprinter); ok {
+		return f.PolicyFingerprint()
+	}
+
+	return ""
+}
+
 //
 // Store interface methods
 //
@@ -33,3 +33,58 @@ func TestDriverInstantiation(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, disk.DriverName, store.Driver())
 }
+
+func TestConfValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		conf    map[string]any
+		wantErr string
+	}{
+		{
+			name: "max_policies_with_disk",
+			conf: map[string]any{"driver": "disk", "maxPolicies": 10},
+		},
+		{
+			name:    "max_policies_with_sqlite3",
+			conf:    map[string]any{"driver": "sqlite3", "maxPolicies": 10},
+			wantErr: "storage.maxPolicies is not supported by the sqlite3 driver",
+		},
+		{
+			name:    "max_policies_with_bundle",
+			conf:    map[string]any{"driver": "bundle", "maxPolicies": 10},
+			wantErr: "storage.maxPolicies is not supported by the bundle driver",
+		},
+		{
+			name: "allowed_resource_kinds_with_git",
+			conf: map[string]any{"driver": "git", "allowedResourceKinds": []any{"leave_request"}},
+		},
+		{
+			name:    "allowed_resource_kinds_with_mysql",
+			conf:    map[string]any{"driver": "mysql", "allowedResourceKinds": []any{"leave_request"}},
+			wantErr: "storage.allowedResourceKinds is not supported by the mysql driver",
+		},
+		{
+			name:    "both_with_bundle",
+			conf:    map[string]any{"driver": "bundle", "maxPolicies": 10, "allowedResourceKinds": []any{"leave_request"}},
+			wantErr: "storage.maxPolicies is not supported by the bundle driver; storage.allowedResourceKinds is not supported by the bundle driver",
+		},
+		{
+			name: "no_restrictions",
+			conf: map[string]any{"driver": "postgres"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, config.LoadMap(map[string]any{"storage": tc.conf}))
+
+			_, err := storage.GetConf()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
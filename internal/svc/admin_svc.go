@@ -6,12 +6,17 @@ package svc
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -27,8 +32,12 @@ import (
 	schemav1 "github.com/cerbos/cerbos/api/genpb/cerbos/schema/v1"
 	svcv1 "github.com/cerbos/cerbos/api/genpb/cerbos/svc/v1"
 	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
 	"github.com/cerbos/cerbos/internal/observability/logging"
 	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
 	"github.com/cerbos/cerbos/internal/storage"
 	"github.com/cerbos/cerbos/internal/storage/db"
 )
@@ -42,27 +51,33 @@ var (
 
 // CerbosAdminService implements the Cerbos administration service.
 type CerbosAdminService struct {
-	store    storage.Store
-	auditLog audit.Log
+	store        storage.Store
+	policyLoader engine.PolicyLoader
+	auditLog     audit.Log
 	*svcv1.UnimplementedCerbosAdminServiceServer
-	adminUser       string
-	adminPasswdHash []byte
+	credentials *adminCredentials
+	schemaRefs  *schemaReferences
 }
 
-func NewCerbosAdminService(store storage.Store, auditLog audit.Log, adminUser string, adminPasswdHash []byte) *CerbosAdminService {
+func NewCerbosAdminService(store storage.Store, policyLoader engine.PolicyLoader, auditLog audit.Log, adminUser string, adminPasswdHash []byte) *CerbosAdminService {
 	svc := &CerbosAdminService{
+		policyLoader:                          policyLoader,
 		auditLog:                              auditLog,
-		adminUser:                             adminUser,
-		adminPasswdHash:                       adminPasswdHash,
+		credentials:                           newAdminCredentials(adminUser, adminPasswdHash),
 		UnimplementedCerbosAdminServiceServer: &svcv1.UnimplementedCerbosAdminServiceServer{},
 		store:                                 store,
+		schemaRefs:                            &schemaReferences{},
+	}
+
+	if ss, ok := store.(storage.SourceStore); ok {
+		ss.Subscribe(svc.schemaRefs)
 	}
 
 	return svc
 }
 
 func (cas *CerbosAdminService) AddOrUpdatePolicy(ctx context.Context, req *requestv1.AddOrUpdatePolicyRequest) (*responsev1.AddOrUpdatePolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -94,7 +109,7 @@ func (cas *CerbosAdminService) AddOrUpdatePolicy(ctx context.Context, req *reque
 }
 
 func (cas *CerbosAdminService) AddOrUpdateSchema(ctx context.Context, req *requestv1.AddOrUpdateSchemaRequest) (*responsev1.AddOrUpdateSchemaResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -117,7 +132,7 @@ func (cas *CerbosAdminService) AddOrUpdateSchema(ctx context.Context, req *reque
 }
 
 func (cas *CerbosAdminService) ListPolicies(ctx context.Context, req *requestv1.ListPoliciesRequest) (*responsev1.ListPoliciesResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -151,7 +166,7 @@ func (cas *CerbosAdminService) ListPolicies(ctx context.Context, req *requestv1.
 }
 
 func (cas *CerbosAdminService) GetPolicy(ctx context.Context, req *requestv1.GetPolicyRequest) (*responsev1.GetPolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -182,7 +197,7 @@ func (cas *CerbosAdminService) GetPolicy(ctx context.Context, req *requestv1.Get
 }
 
 func (cas *CerbosAdminService) DisablePolicy(ctx context.Context, req *requestv1.DisablePolicyRequest) (*responsev1.DisablePolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -210,7 +225,7 @@ func (cas *CerbosAdminService) DisablePolicy(ctx context.Context, req *requestv1
 }
 
 func (cas *CerbosAdminService) EnablePolicy(ctx context.Context, req *requestv1.EnablePolicyRequest) (*responsev1.EnablePolicyResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -235,7 +250,7 @@ func (cas *CerbosAdminService) EnablePolicy(ctx context.Context, req *requestv1.
 }
 
 func (cas *CerbosAdminService) ListSchemas(ctx context.Context, _ *requestv1.ListSchemasRequest) (*responsev1.ListSchemasResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -256,7 +271,7 @@ func (cas *CerbosAdminService) ListSchemas(ctx context.Context, _ *requestv1.Lis
 }
 
 func (cas *CerbosAdminService) GetSchema(ctx context.Context, req *requestv1.GetSchemaRequest) (*responsev1.GetSchemaResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -292,7 +307,7 @@ func (cas *CerbosAdminService) GetSchema(ctx context.Context, req *requestv1.Get
 }
 
 func (cas *CerbosAdminService) DeleteSchema(ctx context.Context, req *requestv1.DeleteSchemaRequest) (*responsev1.DeleteSchemaResponse, error) {
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -310,9 +325,156 @@ func (cas *CerbosAdminService) DeleteSchema(ctx context.Context, req *requestv1.
 	return &responsev1.DeleteSchemaResponse{DeletedSchemas: deletedSchemas}, nil
 }
 
+func (cas *CerbosAdminService) ListSchemaReferences(ctx context.Context, req *requestv1.ListSchemaReferencesRequest) (*responsev1.ListSchemaReferencesResponse, error) {
+	if err := cas.CheckCredentials(ctx); err != nil {
+		return nil, err
+	}
+
+	if cas.store == nil {
+		return nil, status.Error(codes.NotFound, "store is not configured")
+	}
+
+	ss, ok := cas.store.(storage.SourceStore)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "Configured store does not contain policy sources")
+	}
+
+	refs, err := cas.schemaRefs.get(ctx, ss)
+	if err != nil {
+		logging.ReqScopeLog(ctx).Error("Could not determine schema references", zap.Error(err))
+		return nil, status.Error(codes.Internal, "could not load policies")
+	}
+
+	return &responsev1.ListSchemaReferencesResponse{PolicyIds: slices.Clone(refs[req.Id])}, nil
+}
+
+// schemaReferences caches the identifiers of the policies referencing each schema so that the policies don't have to be loaded
+// on every request. The cache is rebuilt on the next request after a policy change.
+type schemaReferences struct {
+	refs       map[string][]string
+	builtAt    uint64
+	generation atomic.Uint64
+	mu         sync.Mutex
+}
+
+func (sr *schemaReferences) SubscriberID() string {
+	return "svc.schemaReferences"
+}
+
+func (sr *schemaReferences) OnStorageEvent(events ...storage.Event) {
+	for _, evt := range events {
+		//nolint:exhaustive
+		switch evt.Kind {
+		case storage.EventAddOrUpdatePolicy, storage.EventDeleteOrDisablePolicy, storage.EventReload:
+			sr.generation.Add(1)
+			return
+		}
+	}
+}
+
+// get returns the sorted identifiers of the policies referencing each schema, keyed by schema ID.
+func (sr *schemaReferences) get(ctx context.Context, ss storage.SourceStore) (map[string][]string, error) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	// Events received while the references are being collected bump the generation, so the result is discarded on the next call.
+	generation := sr.generation.Load()
+	if sr.refs != nil && sr.builtAt == generation {
+		return sr.refs, nil
+	}
+
+	policyIDs, err := ss.ListPolicyIDs(ctx, storage.ListPolicyIDsParams{IncludeDisabled: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	wrappers, err := ss.LoadPolicy(ctx, policyIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies: %w", err)
+	}
+
+	refs := make(map[string][]string)
+	for _, wrapper := range wrappers {
+		seen := make(map[string]struct{})
+		for _, ref := range policy.SchemaReferences(wrapper.Policy) {
+			id := schema.IDFromURL(ref)
+			if _, ok := seen[id]; ok || id == "" {
+				continue
+			}
+
+			seen[id] = struct{}{}
+			refs[id] = append(refs[id], policyStoreIdentifier(wrapper))
+		}
+	}
+
+	for _, ids := range refs {
+		sort.Strings(ids)
+	}
+
+	sr.refs, sr.builtAt = refs, generation
+	return refs, nil
+}
+
+func policyStoreIdentifier(wrapper *policy.Wrapper) string {
+	if id := wrapper.Metadata.GetStoreIdentifier(); id != "" {
+		return id
+	}
+
+	return namer.PolicyKey(wrapper.Policy)
+}
+
+// compileDiagnosticsSource is implemented by policy loaders that can report the problems with the policies they serve.
+type compileDiagnosticsSource interface {
+	Diagnostics(context.Context) ([]compile.Diagnostic, error)
+}
+
+func (cas *CerbosAdminService) GetCompilationDiagnostics(ctx context.Context, _ *requestv1.GetCompilationDiagnosticsRequest) (*responsev1.GetCompilationDiagnosticsResponse, error) {
+	if err := cas.CheckCredentials(ctx); err != nil {
+		return nil, err
+	}
+
+	ds, ok := cas.policyLoader.(compileDiagnosticsSource)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "Configured store does not support compilation diagnostics")
+	}
+
+	diags, err := ds.Diagnostics(ctx)
+	if err != nil {
+		logging.ReqScopeLog(ctx).Error("Failed to collect compilation diagnostics", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to collect compilation diagnostics")
+	}
+
+	resp := &responsev1.GetCompilationDiagnosticsResponse{
+		Diagnostics: make([]*responsev1.GetCompilationDiagnosticsResponse_Diagnostic, len(diags)),
+	}
+
+	for i, d := range diags {
+		diag := &responsev1.GetCompilationDiagnosticsResponse_Diagnostic{
+			File:        d.File,
+			Error:       d.Error,
+			Description: d.Description,
+		}
+
+		switch d.Severity {
+		case compile.SeverityError:
+			diag.Severity = responsev1.GetCompilationDiagnosticsResponse_Diagnostic_SEVERITY_ERROR
+		case compile.SeverityWarning:
+			diag.Severity = responsev1.GetCompilationDiagnosticsResponse_Diagnostic_SEVERITY_WARNING
+		}
+
+		if d.Position != nil {
+			diag.Position = &responsev1.GetCompilationDiagnosticsResponse_Diagnostic_Position{Line: d.Position.Line, Column: d.Position.Column}
+		}
+
+		resp.Diagnostics[i] = diag
+	}
+
+	return resp, nil
+}
+
 func (cas *CerbosAdminService) ReloadStore(ctx context.Context, req *requestv1.ReloadStoreRequest) (*responsev1.ReloadStoreResponse, error) {
 	log := logging.ReqScopeLog(ctx)
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return nil, err
 	}
 
@@ -345,7 +507,7 @@ func (cas *CerbosAdminService) ReloadStore(ctx context.Context, req *requestv1.R
 func (cas *CerbosAdminService) ListAuditLogEntries(req *requestv1.ListAuditLogEntriesRequest, stream svcv1.CerbosAdminService_ListAuditLogEntriesServer) error {
 	ctx := stream.Context()
 
-	if err := cas.checkCredentials(ctx); err != nil {
+	if err := cas.CheckCredentials(ctx); err != nil {
 		return err
 	}
 
@@ -452,7 +614,25 @@ func mkDecisionLogStream(it audit.DecisionLogIterator) auditLogStream {
 	}
 }
 
-func (cas *CerbosAdminService) checkCredentials(ctx context.Context) error {
+// CheckCredentials returns an error if the request doesn't carry the admin API credentials.
+func (cas *CerbosAdminService) CheckCredentials(ctx context.Context) error {
+	return cas.credentials.check(ctx)
+}
+
+// adminCredentials checks that requests carry basic auth credentials matching the admin user and password hash.
+// Comparing a password with a bcrypt hash is deliberately slow, so the digest of the last credentials that passed the check
+// is kept to avoid repeating the comparison for every request made with the same credentials.
+type adminCredentials struct {
+	verified   atomic.Pointer[[sha256.Size]byte]
+	user       []byte
+	passwdHash []byte
+}
+
+func newAdminCredentials(adminUser string, adminPasswdHash []byte) *adminCredentials {
+	return &adminCredentials{user: []byte(adminUser), passwdHash: adminPasswdHash}
+}
+
+func (ac *adminCredentials) check(ctx context.Context) error {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return errAuthRequired
@@ -473,18 +653,25 @@ func (cas *CerbosAdminService) checkCredentials(ctx context.Context) error {
 		return status.Error(codes.Unauthenticated, "failed to decode credentials")
 	}
 
-	parts := bytes.Split(bytes.TrimSpace(decoded), authSep)
+	decoded = bytes.TrimSpace(decoded)
+	parts := bytes.Split(decoded, authSep)
 	if len(parts) != 2 { //nolint:gomnd
 		return status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
-	if !bytes.Equal(parts[0], []byte(cas.adminUser)) {
+	digest := sha256.Sum256(decoded)
+	if verified := ac.verified.Load(); verified != nil && subtle.ConstantTimeCompare(verified[:], digest[:]) == 1 {
+		return nil
+	}
+
+	if !bytes.Equal(parts[0], ac.user) {
 		return status.Error(codes.Unauthenticated, "incorrect credentials")
 	}
 
-	if err := bcrypt.CompareHashAndPassword(cas.adminPasswdHash, parts[1]); err != nil {
+	if err := bcrypt.CompareHashAndPassword(ac.passwdHash, parts[1]); err != nil {
 		return status.Error(codes.Unauthenticated, "incorrect credentials")
 	}
 
+	ac.verified.Store(&digest)
 	return nil
 }
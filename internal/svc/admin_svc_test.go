@@ -0,0 +1,136 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/storage/db/sqlite3"
+	"github.com/cerbos/cerbos/internal/svc"
+	"github.com/cerbos/cerbos/internal/test"
+)
+
+const (
+	adminUser   = "cerbos"
+	adminPasswd = "cerbosAdmin"
+
+	invalidExprPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: expense
+  rules:
+    - actions: ["view"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: |-
+            R.attr.owner == P.id &&
+              R.attr.amount >
+`
+)
+
+func TestGetCompilationDiagnostics(t *testing.T) {
+	store, _, compileMgr := mkPolicyLoader(t, map[string]string{
+		"article.yaml": defaultPrincipalTestPolicy,
+		"expense.yaml": invalidExprPolicy,
+	})
+
+	passwdHash, err := bcrypt.GenerateFromPassword([]byte(adminPasswd), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	authCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(adminUser+":"+adminPasswd))))
+
+	t.Run("compiled_policies", func(t *testing.T) {
+		cas := svc.NewCerbosAdminService(store, compileMgr, audit.NewNopLog(), adminUser, passwdHash)
+
+		for i := 0; i < 2; i++ {
+			resp, err := cas.GetCompilationDiagnostics(authCtx, &requestv1.GetCompilationDiagnosticsRequest{})
+			require.NoError(t, err)
+			require.Len(t, resp.Diagnostics, 1, "Policies that haven't been requested yet must be compiled")
+
+			errDiag := resp.Diagnostics[0]
+			require.Equal(t, "expense.yaml", errDiag.File)
+			require.Equal(t, responsev1.GetCompilationDiagnosticsResponse_Diagnostic_SEVERITY_ERROR, errDiag.Severity)
+			require.NotNil(t, errDiag.Position)
+			require.Equal(t, uint32(2), errDiag.Position.Line)
+		}
+
+		rps, err := compileMgr.GetPolicySet(ctx, namer.ResourcePolicyModuleID("article", "default", ""))
+		require.NoError(t, err)
+		require.NotNil(t, rps)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		cas := svc.NewCerbosAdminService(store, compileMgr, audit.NewNopLog(), adminUser, passwdHash)
+
+		_, err := cas.GetCompilationDiagnostics(ctx, &requestv1.GetCompilationDiagnosticsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("precompiled_policies", func(t *testing.T) {
+		cas := svc.NewCerbosAdminService(store, nil, audit.NewNopLog(), adminUser, passwdHash)
+
+		_, err := cas.GetCompilationDiagnostics(authCtx, &requestv1.GetCompilationDiagnosticsRequest{})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestListSchemaReferences(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := sqlite3.NewStore(ctx, &sqlite3.Conf{DSN: "file::memory:?_fk=true"})
+	require.NoError(t, err)
+
+	passwdHash, err := bcrypt.GenerateFromPassword([]byte(adminPasswd), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	authCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(adminUser+":"+adminPasswd))))
+	cas := svc.NewCerbosAdminService(store, nil, audit.NewNopLog(), adminUser, passwdHash)
+
+	addPolicy := func(t *testing.T, resource string) {
+		t.Helper()
+
+		p := test.NewResourcePolicyBuilder(resource, "default").WithRules(test.NewResourceRule("view").WithRoles("user").Build()).Build()
+		p.GetResourcePolicy().Schemas = &policyv1.Schemas{ResourceSchema: &policyv1.Schemas_Schema{Ref: "cerbos:///document.json"}}
+
+		_, err := cas.AddOrUpdatePolicy(authCtx, &requestv1.AddOrUpdatePolicyRequest{Policies: []*policyv1.Policy{p}})
+		require.NoError(t, err)
+	}
+
+	listRefs := func(t *testing.T, id string) []string {
+		t.Helper()
+
+		resp, err := cas.ListSchemaReferences(authCtx, &requestv1.ListSchemaReferencesRequest{Id: id})
+		require.NoError(t, err)
+		return resp.PolicyIds
+	}
+
+	addPolicy(t, "report")
+	require.Equal(t, []string{"resource.report.vdefault"}, listRefs(t, "document.json"))
+	require.Empty(t, listRefs(t, "other.json"))
+
+	addPolicy(t, "invoice")
+	require.Eventually(t, func() bool {
+		return len(listRefs(t, "document.json")) == 2
+	}, 5*time.Second, 50*time.Millisecond, "References must be updated after a policy change")
+	require.Equal(t, []string{"resource.invoice.vdefault", "resource.report.vdefault"}, listRefs(t, "document.json"))
+}
@@ -6,10 +6,15 @@ package svc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
@@ -27,8 +32,9 @@ var _ svcv1.CerbosServiceServer = (*CerbosService)(nil)
 
 // CerbosService implements the policy checking service.
 type CerbosService struct {
-	eng     *engine.Engine
-	auxData *auxdata.AuxData
+	eng      *engine.Engine
+	auxData  *auxdata.AuxData
+	overlays *PolicyOverlays
 	*svcv1.UnimplementedCerbosServiceServer
 	reqLimits RequestLimits
 }
@@ -36,12 +42,17 @@ type CerbosService struct {
 type RequestLimits struct {
 	MaxActionsPerResource  uint
 	MaxResourcesPerRequest uint
+	// MaxAttributeDepth is the maximum nesting depth of principal and resource attributes.
+	// The server configuration requires it to be between 1 and 100. The check is skipped if it's zero.
+	MaxAttributeDepth uint
 }
 
-func NewCerbosService(eng *engine.Engine, auxData *auxdata.AuxData, reqLimits RequestLimits) *CerbosService {
+// NewCerbosService creates the policy checking service. Requests with policy overlays are rejected if overlays is nil.
+func NewCerbosService(eng *engine.Engine, auxData *auxdata.AuxData, reqLimits RequestLimits, overlays *PolicyOverlays) *CerbosService {
 	return &CerbosService{
 		eng:                              eng,
 		auxData:                          auxData,
+		overlays:                         overlays,
 		reqLimits:                        reqLimits,
 		UnimplementedCerbosServiceServer: &svcv1.UnimplementedCerbosServiceServer{},
 	}
@@ -50,16 +61,38 @@ func NewCerbosService(eng *engine.Engine, auxData *auxdata.AuxData, reqLimits Re
 func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.PlanResourcesRequest) (*responsev1.PlanResourcesResponse, error) {
 	log := logging.ReqScopeLog(ctx)
 
+	principal, err := cs.principalOrDefault(request.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cs.checkPrincipalAttrDepth(principal); err != nil {
+		log.Error("Request too deeply nested", zap.Error(err))
+		return nil, err
+	}
+
+	if err := cs.checkAttrDepth(request.Resource.GetAttr(), "attributes of resource kind %q", request.Resource.GetKind()); err != nil {
+		log.Error("Request too deeply nested", zap.Error(err))
+		return nil, err
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, request.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
 		return nil, status.Error(codes.InvalidArgument, "invalid auxData")
 	}
 
+	if len(request.PolicyOverlay) > 0 {
+		if ctx, err = cs.overlays.contextWithOverlay(ctx, request.PolicyOverlay); err != nil {
+			log.Error("Failed to load policy overlay", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	input := &enginev1.PlanResourcesInput{
 		RequestId:   request.RequestId,
 		Action:      request.Action,
-		Principal:   request.Principal,
+		Principal:   principal,
 		Resource:    request.Resource,
 		AuxData:     auxData,
 		IncludeMeta: request.IncludeMeta,
@@ -70,6 +103,9 @@ func (cs *CerbosService) PlanResources(ctx context.Context, request *requestv1.P
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
 			return nil, status.Errorf(codes.FailedPrecondition, "Resources query plan failed due to invalid policy")
 		}
+		if errors.Is(err, engine.ErrConcurrencyLimitExceeded) {
+			return nil, status.Errorf(codes.ResourceExhausted, "Too many concurrent requests")
+		}
 		return nil, status.Errorf(codes.Internal, "Resources query plan request failed")
 	}
 
@@ -106,6 +142,18 @@ func (cs *CerbosService) CheckResourceSet(ctx context.Context, req *requestv1.Ch
 		return nil, err
 	}
 
+	if err := cs.checkPrincipalAttrDepth(req.Principal); err != nil {
+		log.Error("Request too deeply nested", zap.Error(err))
+		return nil, err
+	}
+
+	for key, res := range req.Resource.Instances {
+		if err := cs.checkAttrDepth(res.Attr, "attributes of resource %q", key); err != nil {
+			log.Error("Request too deeply nested", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
@@ -140,6 +188,9 @@ func (cs *CerbosService) CheckResourceSet(ctx context.Context, req *requestv1.Ch
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
 			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
 		}
+		if errors.Is(err, engine.ErrConcurrencyLimitExceeded) {
+			return nil, status.Errorf(codes.ResourceExhausted, "Too many concurrent requests")
+		}
 		return nil, status.Errorf(codes.Internal, "Policy check failed")
 	}
 
@@ -160,6 +211,11 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 		return nil, err
 	}
 
+	if err := cs.checkPrincipalAttrDepth(req.Principal); err != nil {
+		log.Error("Request too deeply nested", zap.Error(err))
+		return nil, err
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
@@ -173,6 +229,11 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 			return nil, err
 		}
 
+		if err := cs.checkAttrDepth(res.Resource.GetAttr(), "attributes of resource %q", res.Resource.GetId()); err != nil {
+			log.Error("Request too deeply nested", zap.Error(err))
+			return nil, err
+		}
+
 		inputs[i] = &enginev1.CheckInput{
 			RequestId: req.RequestId,
 			Actions:   res.Actions,
@@ -188,6 +249,9 @@ func (cs *CerbosService) CheckResourceBatch(ctx context.Context, req *requestv1.
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
 			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
 		}
+		if errors.Is(err, engine.ErrConcurrencyLimitExceeded) {
+			return nil, status.Errorf(codes.ResourceExhausted, "Too many concurrent requests")
+		}
 		return nil, status.Errorf(codes.Internal, "Policy check failed")
 	}
 
@@ -220,6 +284,16 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 		return nil, err
 	}
 
+	principal, err := cs.principalOrDefault(req.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cs.checkPrincipalAttrDepth(principal); err != nil {
+		log.Error("Request too deeply nested", zap.Error(err))
+		return nil, err
+	}
+
 	auxData, err := cs.auxData.Extract(ctx, req.AuxData)
 	if err != nil {
 		log.Error("Failed to extract auxData", zap.Error(err))
@@ -233,21 +307,41 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 			return nil, err
 		}
 
+		if err := cs.checkAttrDepth(res.Resource.GetAttr(), "attributes of resource %q", res.Resource.GetId()); err != nil {
+			log.Error("Request too deeply nested", zap.Error(err))
+			return nil, err
+		}
+
 		inputs[i] = &enginev1.CheckInput{
 			RequestId: req.RequestId,
 			Actions:   res.Actions,
-			Principal: req.Principal,
+			Principal: principal,
 			Resource:  res.Resource,
 			AuxData:   auxData,
 		}
 	}
 
-	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs)
+	var checkOpts []engine.CheckOpt
+	if req.IncludeMeta {
+		checkOpts = append(checkOpts, engine.WithScopeChain())
+	}
+
+	if len(req.PolicyOverlay) > 0 {
+		if ctx, err = cs.overlays.contextWithOverlay(ctx, req.PolicyOverlay); err != nil {
+			log.Error("Failed to load policy overlay", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	outputs, err := cs.eng.Check(logging.ToContext(ctx, log), inputs, checkOpts...)
 	if err != nil {
 		log.Error("Policy check failed", zap.Error(err))
 		if errors.Is(err, compile.PolicyCompilationErr{}) {
 			return nil, status.Errorf(codes.FailedPrecondition, "Check failed due to invalid policy")
 		}
+		if errors.Is(err, engine.ErrConcurrencyLimitExceeded) {
+			return nil, status.Errorf(codes.ResourceExhausted, "Too many concurrent requests")
+		}
 		return nil, status.Errorf(codes.Internal, "Policy check failed")
 	}
 
@@ -272,6 +366,8 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 		if req.IncludeMeta {
 			entry.Meta = &responsev1.CheckResourcesResponse_ResultEntry_Meta{
 				EffectiveDerivedRoles: out.EffectiveDerivedRoles,
+				PrincipalScopeChain:   out.PrincipalScopeChain,
+				ResourceScopeChain:    out.ResourceScopeChain,
 				Actions:               make(map[string]*responsev1.CheckResourcesResponse_ResultEntry_Meta_EffectMeta, len(out.Actions)),
 			}
 		}
@@ -288,6 +384,13 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 					MatchedScope:  actionEffect.Scope,
 				}
 			}
+
+			if len(actionEffect.RemediationHints) > 0 {
+				if entry.Remediation == nil {
+					entry.Remediation = make(map[string]*responsev1.CheckResourcesResponse_ResultEntry_Remediation)
+				}
+				entry.Remediation[action] = &responsev1.CheckResourcesResponse_ResultEntry_Remediation{Hints: actionEffect.RemediationHints}
+			}
 		}
 
 		result.Results[i] = entry
@@ -296,6 +399,75 @@ func (cs *CerbosService) CheckResources(ctx context.Context, req *requestv1.Chec
 	return result, nil
 }
 
+// principalOrDefault returns the principal from the request or the default principal if the request didn't specify one.
+func (cs *CerbosService) principalOrDefault(p *enginev1.Principal) (*enginev1.Principal, error) {
+	if p != nil {
+		return p, nil
+	}
+
+	if dp := cs.eng.DefaultPrincipal(); dp != nil {
+		return dp, nil
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "principal is required")
+}
+
+// ResourceKindUnaryServerInterceptor infers the kind of the resources in check requests that don't specify one using the
+// resource kind inference rules configured for the engine. The kind is required by the request validator, so this interceptor must run before it.
+// The request is copied before the kinds are filled in so that the message received from the client is not modified.
+func ResourceKindUnaryServerInterceptor(eng *engine.Engine) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !eng.InfersResourceKinds() {
+			return handler(ctx, req)
+		}
+
+		switch r := req.(type) {
+		case *requestv1.CheckResourcesRequest:
+			if !slices.ContainsFunc(r.Resources, func(e *requestv1.CheckResourcesRequest_ResourceEntry) bool { return e.GetResource().GetKind() == "" }) {
+				break
+			}
+
+			r = proto.Clone(r).(*requestv1.CheckResourcesRequest) //nolint:forcetypeassert
+			for _, e := range r.Resources {
+				if err := inferResourceKind(eng, e.GetResource()); err != nil {
+					return nil, err
+				}
+			}
+			req = r
+
+		case *requestv1.CheckResourceBatchRequest:
+			if !slices.ContainsFunc(r.Resources, func(e *requestv1.CheckResourceBatchRequest_BatchEntry) bool { return e.GetResource().GetKind() == "" }) {
+				break
+			}
+
+			r = proto.Clone(r).(*requestv1.CheckResourceBatchRequest) //nolint:forcetypeassert
+			for _, e := range r.Resources {
+				if err := inferResourceKind(eng, e.GetResource()); err != nil {
+					return nil, err
+				}
+			}
+			req = r
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// inferResourceKind sets the kind of the resource from its ID if it doesn't have one.
+func inferResourceKind(eng *engine.Engine, r *enginev1.Resource) error {
+	if r == nil || r.Kind != "" {
+		return nil
+	}
+
+	kind, ok := eng.InferResourceKind(r.Id)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "kind of resource %q is required because it can't be inferred from the ID", r.Id)
+	}
+
+	r.Kind = kind
+	return nil
+}
+
 func (cs *CerbosService) checkNumResourcesLimit(n int) error {
 	if n > int(cs.reqLimits.MaxResourcesPerRequest) {
 		return status.Errorf(codes.InvalidArgument,
@@ -314,6 +486,57 @@ func (cs *CerbosService) checkNumActionsLimit(n int) error {
 	return nil
 }
 
+func (cs *CerbosService) checkPrincipalAttrDepth(p *enginev1.Principal) error {
+	return cs.checkAttrDepth(p.GetAttr(), "attributes of principal %q", p.GetId())
+}
+
+// checkAttrDepth returns an error if the attributes are nested deeper than the configured limit.
+// A map of scalar values has a depth of 1 and each nested map or list adds a level.
+func (cs *CerbosService) checkAttrDepth(attr map[string]*structpb.Value, desc string, args ...any) error {
+	limit := cs.reqLimits.MaxAttributeDepth
+	if limit == 0 || len(attr) == 0 {
+		return nil
+	}
+
+	for _, v := range attr {
+		if exceedsDepth(v, limit-1) {
+			return status.Errorf(codes.InvalidArgument,
+				"%s exceed the configured maximum nesting depth (%d)", fmt.Sprintf(desc, args...), limit)
+		}
+	}
+
+	return nil
+}
+
+// exceedsDepth reports whether the value contains maps or lists nested more than remaining levels deep.
+// It stops descending as soon as the limit is exceeded so that adversarial inputs can't exhaust the stack.
+func exceedsDepth(v *structpb.Value, remaining uint) bool {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StructValue:
+		if remaining == 0 {
+			return true
+		}
+
+		for _, fv := range k.StructValue.GetFields() {
+			if exceedsDepth(fv, remaining-1) {
+				return true
+			}
+		}
+	case *structpb.Value_ListValue:
+		if remaining == 0 {
+			return true
+		}
+
+		for _, lv := range k.ListValue.GetValues() {
+			if exceedsDepth(lv, remaining-1) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (CerbosService) ServerInfo(_ context.Context, _ *requestv1.ServerInfoRequest) (*responsev1.ServerInfoResponse, error) {
 	return &responsev1.ServerInfoResponse{
 		Version:   util.Version,
@@ -0,0 +1,492 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc_test
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	effectv1 "github.com/cerbos/cerbos/api/genpb/cerbos/effect/v1"
+	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
+	responsev1 "github.com/cerbos/cerbos/api/genpb/cerbos/response/v1"
+	"github.com/cerbos/cerbos/internal/audit"
+	"github.com/cerbos/cerbos/internal/auxdata"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/disk"
+	"github.com/cerbos/cerbos/internal/svc"
+	"github.com/cerbos/cerbos/internal/test"
+	"github.com/cerbos/cerbos/internal/validator"
+)
+
+const defaultPrincipalTestPolicy = `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: article
+  rules:
+    - name: anyone-can-read
+      actions: ["read"]
+      roles: ["anonymous", "user"]
+      effect: EFFECT_ALLOW
+    - name: users-can-comment
+      actions: ["comment"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`
+
+func TestDefaultPrincipal(t *testing.T) {
+	checkReq := func(principal *enginev1.Principal) *requestv1.CheckResourcesRequest {
+		return &requestv1.CheckResourcesRequest{
+			RequestId: "test",
+			Principal: principal,
+			Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+				{
+					Actions:  []string{"read", "comment"},
+					Resource: &enginev1.Resource{Kind: "article", Id: "a1"},
+				},
+			},
+		}
+	}
+
+	planReq := func(principal *enginev1.Principal) *requestv1.PlanResourcesRequest {
+		return &requestv1.PlanResourcesRequest{
+			RequestId: "test",
+			Action:    "comment",
+			Principal: principal,
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "article"},
+		}
+	}
+
+	t.Run("configured", func(t *testing.T) {
+		cs := mkCerbosService(t, &engine.DefaultPrincipalConf{Roles: []string{"anonymous"}})
+
+		t.Run("anonymous_check", func(t *testing.T) {
+			resp, err := cs.CheckResources(context.Background(), checkReq(nil))
+			require.NoError(t, err)
+			require.Len(t, resp.Results, 1)
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["read"])
+			require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["comment"])
+		})
+
+		t.Run("explicit_principal_check", func(t *testing.T) {
+			resp, err := cs.CheckResources(context.Background(), checkReq(&enginev1.Principal{Id: "alice", Roles: []string{"user"}}))
+			require.NoError(t, err)
+			require.Len(t, resp.Results, 1)
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["read"])
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["comment"])
+		})
+
+		t.Run("anonymous_plan", func(t *testing.T) {
+			resp, err := cs.PlanResources(context.Background(), planReq(nil))
+			require.NoError(t, err)
+			require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_DENIED, resp.Filter.Kind)
+		})
+
+		t.Run("explicit_principal_plan", func(t *testing.T) {
+			resp, err := cs.PlanResources(context.Background(), planReq(&enginev1.Principal{Id: "alice", Roles: []string{"user"}}))
+			require.NoError(t, err)
+			require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, resp.Filter.Kind)
+		})
+	})
+
+	t.Run("not_configured", func(t *testing.T) {
+		cs := mkCerbosService(t, nil)
+
+		_, err := cs.CheckResources(context.Background(), checkReq(nil))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = cs.PlanResources(context.Background(), planReq(nil))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMaxAttributeDepth(t *testing.T) {
+	const limit = 4
+
+	// nested returns attributes with the given depth, alternating between maps and lists.
+	nested := func(depth int) map[string]*structpb.Value {
+		var v any = "leaf"
+		for i := 1; i < depth; i++ {
+			if i%2 == 0 {
+				v = []any{v}
+			} else {
+				v = map[string]any{"child": v}
+			}
+		}
+
+		val, err := structpb.NewValue(v)
+		require.NoError(t, err)
+		return map[string]*structpb.Value{"attr": val}
+	}
+
+	cs := mkCerbosServiceWithLimits(t, nil, svc.RequestLimits{MaxActionsPerResource: 10, MaxResourcesPerRequest: 10, MaxAttributeDepth: limit}, nil)
+
+	checkReq := func(principalAttr, resourceAttr map[string]*structpb.Value) *requestv1.CheckResourcesRequest {
+		return &requestv1.CheckResourcesRequest{
+			RequestId: "test",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}, Attr: principalAttr},
+			Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+				{
+					Actions:  []string{"read"},
+					Resource: &enginev1.Resource{Kind: "article", Id: "a1", Attr: resourceAttr},
+				},
+			},
+		}
+	}
+
+	t.Run("at_limit", func(t *testing.T) {
+		resp, err := cs.CheckResources(context.Background(), checkReq(nested(limit), nested(limit)))
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["read"])
+	})
+
+	t.Run("principal_exceeds_limit", func(t *testing.T) {
+		_, err := cs.CheckResources(context.Background(), checkReq(nested(limit+1), nil))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, `attributes of principal "alice" exceed the configured maximum nesting depth (4)`, status.Convert(err).Message())
+	})
+
+	t.Run("resource_exceeds_limit", func(t *testing.T) {
+		_, err := cs.CheckResources(context.Background(), checkReq(nil, nested(limit+1)))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, `attributes of resource "a1" exceed the configured maximum nesting depth (4)`, status.Convert(err).Message())
+	})
+
+	t.Run("plan_exceeds_limit", func(t *testing.T) {
+		_, err := cs.PlanResources(context.Background(), &requestv1.PlanResourcesRequest{
+			RequestId: "test",
+			Action:    "read",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:  &enginev1.PlanResourcesInput_Resource{Kind: "article", Attr: nested(limit + 1)},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), `attributes of resource kind "article"`)
+	})
+}
+
+func TestResourceKindInference(t *testing.T) {
+	eng := mkSvcEngine(t, func(conf *engine.Conf) {
+		conf.ResourceKindInference = []engine.ResourceKindRule{
+			{Pattern: `^(article):`, Kind: "$1"},
+			{Pattern: `^post-`, Kind: "article"},
+		}
+	})
+	cs := svc.NewCerbosService(eng, auxdata.NewFromConf(context.Background(), &auxdata.Conf{}), svc.RequestLimits{MaxActionsPerResource: 10, MaxResourcesPerRequest: 10}, nil)
+
+	checkReq := func(resources ...*enginev1.Resource) *requestv1.CheckResourcesRequest {
+		req := &requestv1.CheckResourcesRequest{
+			RequestId: "test",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+		}
+
+		for _, r := range resources {
+			req.Resources = append(req.Resources, &requestv1.CheckResourcesRequest_ResourceEntry{Actions: []string{"comment"}, Resource: r})
+		}
+
+		return req
+	}
+
+	// The interceptor runs in front of the request validator, which rejects the resources without a kind.
+	intercept := func(eng *engine.Engine, req any) (any, error) {
+		return svc.ResourceKindUnaryServerInterceptor(eng)(context.Background(), req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			if err := validator.Validate(req.(proto.Message)); err != nil { //nolint:forcetypeassert
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			switch r := req.(type) {
+			case *requestv1.CheckResourcesRequest:
+				return cs.CheckResources(ctx, r)
+			case *requestv1.CheckResourceBatchRequest:
+				return cs.CheckResourceBatch(ctx, r)
+			default:
+				return req, nil
+			}
+		})
+	}
+
+	t.Run("inferred", func(t *testing.T) {
+		req := checkReq(&enginev1.Resource{Id: "article:123"}, &enginev1.Resource{Id: "post-456"})
+		have, err := intercept(eng, req)
+		require.NoError(t, err)
+
+		resp, ok := have.(*responsev1.CheckResourcesResponse)
+		require.True(t, ok)
+		require.Len(t, resp.Results, 2)
+
+		for _, r := range resp.Results {
+			require.Equal(t, "article", r.Resource.Kind)
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, r.Actions["comment"])
+		}
+
+		require.Empty(t, req.Resources[0].Resource.Kind, "Request must not be modified")
+	})
+
+	t.Run("inferred_batch", func(t *testing.T) {
+		req := &requestv1.CheckResourceBatchRequest{
+			RequestId: "test",
+			Principal: &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resources: []*requestv1.CheckResourceBatchRequest_BatchEntry{
+				{Actions: []string{"comment"}, Resource: &enginev1.Resource{Id: "article:123"}},
+			},
+		}
+
+		have, err := intercept(eng, req)
+		require.NoError(t, err)
+
+		resp, ok := have.(*responsev1.CheckResourceBatchResponse)
+		require.True(t, ok)
+		require.Len(t, resp.Results, 1)
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["comment"])
+	})
+
+	t.Run("explicit_kind", func(t *testing.T) {
+		have, err := intercept(eng, checkReq(&enginev1.Resource{Kind: "comment", Id: "article:123"}))
+		require.NoError(t, err)
+
+		resp, ok := have.(*responsev1.CheckResourcesResponse)
+		require.True(t, ok)
+		require.Equal(t, "comment", resp.Results[0].Resource.Kind)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["comment"])
+	})
+
+	t.Run("no_match", func(t *testing.T) {
+		_, err := intercept(eng, checkReq(&enginev1.Resource{Id: "article:123"}, &enginev1.Resource{Id: "doc:789"}))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, `kind of resource "doc:789" is required because it can't be inferred from the ID`, status.Convert(err).Message())
+	})
+
+	t.Run("not_configured", func(t *testing.T) {
+		_, err := intercept(mkSvcEngine(t, func(*engine.Conf) {}), checkReq(&enginev1.Resource{Id: "article:123"}))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "resources[0].resource.kind")
+	})
+}
+
+func TestPolicyOverlay(t *testing.T) {
+	passwdHash, err := bcrypt.GenerateFromPassword([]byte(adminPasswd), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	limits := svc.RequestLimits{MaxActionsPerResource: 10, MaxResourcesPerRequest: 10}
+	eng := mkSvcEngine(t, func(*engine.Conf) {})
+	overlays := svc.NewPolicyOverlays(0, adminUser, passwdHash, eng.SchemaManager(), compile.OptionsFromConf(compile.DefaultConf()))
+	cs := svc.NewCerbosService(eng, auxdata.NewFromConf(context.Background(), &auxdata.Conf{}), limits, overlays)
+
+	authCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(adminUser+":"+adminPasswd))))
+
+	readPolicy := func(t *testing.T, src string) *policyv1.Policy {
+		t.Helper()
+
+		p, err := policy.ReadPolicy(strings.NewReader(src))
+		require.NoError(t, err)
+		return p
+	}
+
+	checkReq := func(overlay ...*policyv1.Policy) *requestv1.CheckResourcesRequest {
+		return &requestv1.CheckResourcesRequest{
+			RequestId:     "test",
+			IncludeMeta:   true,
+			Principal:     &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			PolicyOverlay: overlay,
+			Resources: []*requestv1.CheckResourcesRequest_ResourceEntry{
+				{
+					Actions:  []string{"read", "publish"},
+					Resource: &enginev1.Resource{Kind: "article", Id: "a1"},
+				},
+			},
+		}
+	}
+
+	overlay := readPolicy(t, `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: article
+  rules:
+    - actions: ["publish"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+    - actions: ["read"]
+      roles: ["user"]
+      effect: EFFECT_DENY
+`)
+
+	t.Run("with_overlay", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			resp, err := cs.CheckResources(authCtx, checkReq(overlay))
+			require.NoError(t, err)
+			require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["publish"])
+			require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["read"])
+		}
+	})
+
+	t.Run("without_overlay", func(t *testing.T) {
+		resp, err := cs.CheckResources(context.Background(), checkReq())
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["publish"])
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["read"])
+	})
+
+	t.Run("unmatched_overlay", func(t *testing.T) {
+		other := readPolicy(t, `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: comment
+  rules:
+    - actions: ["*"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+`)
+
+		resp, err := cs.CheckResources(authCtx, checkReq(other))
+		require.NoError(t, err)
+		require.Equal(t, effectv1.Effect_EFFECT_DENY, resp.Results[0].Actions["publish"])
+		require.Equal(t, effectv1.Effect_EFFECT_ALLOW, resp.Results[0].Actions["read"])
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := cs.CheckResources(context.Background(), checkReq(overlay))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("incorrect_credentials", func(t *testing.T) {
+		_, err := cs.CheckResources(authCtx, checkReq(overlay))
+		require.NoError(t, err)
+
+		wrongCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(adminUser+":wrong"))))
+		_, err = cs.CheckResources(wrongCtx, checkReq(overlay))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("invalid_overlay", func(t *testing.T) {
+		invalid := readPolicy(t, `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: article
+  importDerivedRoles: ["missing"]
+  rules:
+    - actions: ["publish"]
+      derivedRoles: ["owner"]
+      effect: EFFECT_ALLOW
+`)
+
+		_, err := cs.CheckResources(authCtx, checkReq(invalid))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		planReq := &requestv1.PlanResourcesRequest{
+			RequestId:     "test",
+			Action:        "publish",
+			Principal:     &enginev1.Principal{Id: "alice", Roles: []string{"user"}},
+			Resource:      &enginev1.PlanResourcesInput_Resource{Kind: "article"},
+			PolicyOverlay: []*policyv1.Policy{overlay},
+		}
+
+		resp, err := cs.PlanResources(authCtx, planReq)
+		require.NoError(t, err)
+		require.Equal(t, enginev1.PlanResourcesFilter_KIND_ALWAYS_ALLOWED, resp.Filter.Kind)
+
+		_, err = cs.PlanResources(context.Background(), planReq)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("disabled_cel_extension", func(t *testing.T) {
+		withSets := readPolicy(t, `---
+apiVersion: api.cerbos.dev/v1
+resourcePolicy:
+  version: default
+  resource: article
+  rules:
+    - actions: ["publish"]
+      roles: ["user"]
+      effect: EFFECT_ALLOW
+      condition:
+        match:
+          expr: sets.contains(P.attr.groups, ["editors"])
+`)
+
+		_, err := cs.CheckResources(authCtx, checkReq(withSets))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cs := mkCerbosServiceWithLimits(t, nil, limits, nil)
+
+		_, err := cs.CheckResources(authCtx, checkReq(overlay))
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func mkCerbosService(t *testing.T, defaultPrincipal *engine.DefaultPrincipalConf) *svc.CerbosService {
+	t.Helper()
+
+	return mkCerbosServiceWithLimits(t, defaultPrincipal, svc.RequestLimits{MaxActionsPerResource: 10, MaxResourcesPerRequest: 10}, nil)
+}
+
+func mkCerbosServiceWithLimits(t *testing.T, defaultPrincipal *engine.DefaultPrincipalConf, reqLimits svc.RequestLimits, overlays *svc.PolicyOverlays) *svc.CerbosService {
+	t.Helper()
+
+	return mkCerbosServiceWithEngineConf(t, func(conf *engine.Conf) { conf.DefaultPrincipal = defaultPrincipal }, reqLimits, overlays)
+}
+
+func mkCerbosServiceWithEngineConf(t *testing.T, confFn func(*engine.Conf), reqLimits svc.RequestLimits, overlays *svc.PolicyOverlays) *svc.CerbosService {
+	t.Helper()
+
+	return svc.NewCerbosService(mkSvcEngine(t, confFn), auxdata.NewFromConf(context.Background(), &auxdata.Conf{}), reqLimits, overlays)
+}
+
+func mkSvcEngine(t *testing.T, confFn func(*engine.Conf)) *engine.Engine {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	_, schemaMgr, compileMgr := mkPolicyLoader(t, map[string]string{"article.yaml": defaultPrincipalTestPolicy})
+
+	conf := &engine.Conf{}
+	conf.SetDefaults()
+	conf.NumWorkers = 0
+	confFn(conf)
+	require.NoError(t, conf.Validate())
+
+	return engine.NewFromConf(ctx, conf, engine.Components{
+		PolicyLoader:      compileMgr,
+		SchemaMgr:         schemaMgr,
+		AuditLog:          audit.NewNopLog(),
+		MetadataExtractor: audit.NewMetadataExtractorFromConf(&audit.Conf{}),
+	})
+}
+
+// mkPolicyLoader creates a disk store containing the given policies and a compile manager that loads the policies from it.
+func mkPolicyLoader(t *testing.T, policies map[string]string) (*disk.Store, schema.Manager, *compile.Manager) {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	store, err := disk.NewStore(ctx, &disk.Conf{Directory: test.WritePolicies(t, policies)})
+	require.NoError(t, err)
+
+	schemaMgr := schema.NewFromConf(ctx, store, schema.NewConf(schema.EnforcementNone))
+
+	return store, schemaMgr, compile.NewManagerFromDefaultConf(ctx, store, schemaMgr)
+}
@@ -85,6 +85,16 @@ func ExtractRequestFields(fullMethod string, req any) map[string]any {
 			metaTagKey: map[string]string{playgroundIDTagKey: pgReq.PlaygroundId},
 		}
 
+	case "/cerbos.svc.v1.CerbosPlaygroundService/PlaygroundEvaluateExpression":
+		pgReq, ok := req.(*requestv1.PlaygroundEvaluateExpressionRequest)
+		if !ok || pgReq.PlaygroundId == "" {
+			return nil
+		}
+
+		return map[string]any{
+			metaTagKey: map[string]string{playgroundIDTagKey: pgReq.PlaygroundId},
+		}
+
 	default:
 		return nil
 	}
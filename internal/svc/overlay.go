@@ -0,0 +1,137 @@
+// Copyright 2021-2023 Zenauth Ltd.
+// SPDX-License-Identifier: Apache-2.0
+
+package svc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/afero"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	policyv1 "github.com/cerbos/cerbos/api/genpb/cerbos/policy/v1"
+	runtimev1 "github.com/cerbos/cerbos/api/genpb/cerbos/runtime/v1"
+	"github.com/cerbos/cerbos/internal/cache"
+	"github.com/cerbos/cerbos/internal/compile"
+	"github.com/cerbos/cerbos/internal/engine"
+	"github.com/cerbos/cerbos/internal/namer"
+	"github.com/cerbos/cerbos/internal/policy"
+	"github.com/cerbos/cerbos/internal/schema"
+	"github.com/cerbos/cerbos/internal/storage/index"
+	"github.com/cerbos/cerbos/internal/util"
+)
+
+const defaultPolicyOverlayCacheSize = 64
+
+var errPolicyOverlayDisabled = status.Error(codes.FailedPrecondition, "policy overlays are not enabled")
+
+// PolicyOverlays compiles the policy overlays included in check and plan requests.
+// Compiled overlays are cached by the hash of their contents, so repeated requests with the same overlay are only compiled once.
+type PolicyOverlays struct {
+	cache       *cache.Cache[uint64, *overlayLoader]
+	schemaMgr   schema.Manager
+	credentials *adminCredentials
+	compileOpts []compile.Option
+}
+
+// NewPolicyOverlays creates a PolicyOverlays that only accepts overlays from callers authenticated with the given admin credentials.
+// The overlays are compiled with the given schema manager and compiler options, which should be the ones used for the stored policies.
+func NewPolicyOverlays(cacheSize uint, adminUser string, adminPasswdHash []byte, schemaMgr schema.Manager, compileOpts []compile.Option) *PolicyOverlays {
+	if cacheSize == 0 {
+		cacheSize = defaultPolicyOverlayCacheSize
+	}
+
+	return &PolicyOverlays{
+		cache:       cache.New[uint64, *overlayLoader]("policy_overlay", cacheSize),
+		schemaMgr:   schemaMgr,
+		credentials: newAdminCredentials(adminUser, adminPasswdHash),
+		compileOpts: compileOpts,
+	}
+}
+
+// contextWithOverlay returns a context that evaluates the requests made with it against the given overlay policies.
+func (po *PolicyOverlays) contextWithOverlay(ctx context.Context, policies []*policyv1.Policy) (context.Context, error) {
+	if po == nil {
+		return nil, errPolicyOverlayDisabled
+	}
+
+	if err := po.credentials.check(ctx); err != nil {
+		return nil, err
+	}
+
+	key := util.HashPBs(policies, nil)
+	if ol, ok := po.cache.Get(key); ok {
+		return engine.ContextWithPolicyOverlay(ctx, ol), nil
+	}
+
+	ol, err := compileOverlay(ctx, policies, po.schemaMgr, po.compileOpts)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy overlay: %v", err)
+	}
+
+	po.cache.Set(key, ol)
+	return engine.ContextWithPolicyOverlay(ctx, ol), nil
+}
+
+// overlayLoader serves the policy sets compiled from an overlay.
+type overlayLoader struct {
+	policySets map[namer.ModuleID]*runtimev1.RunnablePolicySet
+}
+
+func compileOverlay(ctx context.Context, policies []*policyv1.Policy, schemaMgr schema.Manager, compileOpts []compile.Option) (*overlayLoader, error) {
+	fsys := afero.NewMemMapFs()
+	for i, p := range policies {
+		if err := policy.Validate(p); err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		f, err := fsys.Create(fmt.Sprintf("overlay_%d.yaml", i))
+		if err != nil {
+			return nil, err
+		}
+
+		err = policy.WritePolicy(f, p)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	idx, err := index.Build(ctx, afero.NewIOFS(fsys), index.WithBuildFailureLogLevel(zapcore.DebugLevel))
+	if err != nil {
+		return nil, err
+	}
+
+	unitsCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	ol := &overlayLoader{policySets: make(map[namer.ModuleID]*runtimev1.RunnablePolicySet)}
+	for unit := range idx.GetAllCompilationUnits(unitsCtx) {
+		rps, err := compile.Compile(unit, schemaMgr, compileOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if rps != nil {
+			ol.policySets[unit.ModID] = rps
+		}
+	}
+
+	return ol, nil
+}
+
+func (ol *overlayLoader) GetFirstMatch(_ context.Context, candidates []namer.ModuleID) (*runtimev1.RunnablePolicySet, error) {
+	for _, modID := range candidates {
+		if rps, ok := ol.policySets[modID]; ok {
+			return rps, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	enginev1 "github.com/cerbos/cerbos/api/genpb/cerbos/engine/v1"
 	requestv1 "github.com/cerbos/cerbos/api/genpb/cerbos/request/v1"
@@ -39,15 +40,19 @@ var _ svcv1.CerbosPlaygroundServiceServer = (*CerbosPlaygroundService)(nil)
 // CerbosPlaygroundService implements the playground API.
 type CerbosPlaygroundService struct {
 	*svcv1.UnimplementedCerbosPlaygroundServiceServer
-	auxData   *auxdata.AuxData
-	reqLimits RequestLimits
+	auxData       *auxdata.AuxData
+	reqLimits     RequestLimits
+	celExtensions []string
 }
 
-func NewCerbosPlaygroundService(reqLimits RequestLimits) *CerbosPlaygroundService {
+// NewCerbosPlaygroundService creates the playground service. The expressions evaluated by PlaygroundEvaluateExpression can use
+// the given CEL extension libraries, or the default ones if celExtensions is nil.
+func NewCerbosPlaygroundService(reqLimits RequestLimits, celExtensions []string) *CerbosPlaygroundService {
 	return &CerbosPlaygroundService{
 		UnimplementedCerbosPlaygroundServiceServer: &svcv1.UnimplementedCerbosPlaygroundServiceServer{},
-		auxData:   auxdata.NewWithoutVerification(context.Background()),
-		reqLimits: reqLimits,
+		auxData:       auxdata.NewWithoutVerification(context.Background()),
+		reqLimits:     reqLimits,
+		celExtensions: celExtensions,
 	}
 }
 
@@ -177,6 +182,41 @@ func (cs *CerbosPlaygroundService) PlaygroundEvaluate(ctx context.Context, req *
 	return processEngineOutput(ctx, req.PlaygroundId, output)
 }
 
+func (cs *CerbosPlaygroundService) PlaygroundEvaluateExpression(ctx context.Context, req *requestv1.PlaygroundEvaluateExpressionRequest) (*responsev1.PlaygroundEvaluateExpressionResponse, error) {
+	log := logging.ReqScopeLog(ctx).Named("playground")
+
+	procCtx, cancelFunc := context.WithTimeout(ctx, playgroundRequestTimeout)
+	defer cancelFunc()
+
+	input := engine.ExpressionInput{
+		Principal:     req.Principal,
+		Resource:      req.Resource,
+		Globals:       (&structpb.Struct{Fields: req.Globals}).AsMap(),
+		CELExtensions: cs.celExtensions,
+	}
+
+	result, err := engine.EvaluateExpression(procCtx, req.Expr, input)
+	if err != nil {
+		log.Debug("Failed to evaluate expression", zap.Error(err))
+		SetHTTPStatusCode(ctx, http.StatusBadRequest)
+		return &responsev1.PlaygroundEvaluateExpressionResponse{
+			PlaygroundId: req.PlaygroundId,
+			Outcome: &responsev1.PlaygroundEvaluateExpressionResponse_Failure{
+				Failure: &responsev1.PlaygroundFailure{
+					Errors: []*responsev1.PlaygroundFailure_Error{{Error: err.Error()}},
+				},
+			},
+		}, nil
+	}
+
+	return &responsev1.PlaygroundEvaluateExpressionResponse{
+		PlaygroundId: req.PlaygroundId,
+		Outcome: &responsev1.PlaygroundEvaluateExpressionResponse_Success{
+			Success: result,
+		},
+	}, nil
+}
+
 func (cs *CerbosPlaygroundService) PlaygroundProxy(ctx context.Context, req *requestv1.PlaygroundProxyRequest) (*responsev1.PlaygroundProxyResponse, error) {
 	log := logging.ReqScopeLog(ctx).Named("playground")
 
@@ -203,7 +243,7 @@ func (cs *CerbosPlaygroundService) PlaygroundProxy(ctx context.Context, req *req
 		return nil, status.Error(codes.Internal, "failed to create engine")
 	}
 
-	cerbosSvc := NewCerbosService(eng, cs.auxData, cs.reqLimits)
+	cerbosSvc := NewCerbosService(eng, cs.auxData, cs.reqLimits, nil)
 	switch proxyReq := req.ProxyRequest.(type) {
 	case *requestv1.PlaygroundProxyRequest_CheckResourceSet:
 		resp, err := cerbosSvc.CheckResourceSet(ctx, proxyReq.CheckResourceSet)
@@ -128,6 +128,18 @@ func PathToDir(tb testing.TB, dir string) string {
 	return filepath.Join(filepath.Dir(currFile), "testdata", dir)
 }
 
+// WritePolicies writes the policies to a temporary directory and returns its path. The keys of the map are the file names.
+func WritePolicies(tb testing.TB, policies map[string]string) string {
+	tb.Helper()
+
+	dir := tb.TempDir()
+	for name, contents := range policies {
+		require.NoError(tb, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o600))
+	}
+
+	return dir
+}
+
 func DataFS() fs.FS {
 	_, currFile, _, ok := runtime.Caller(0)
 	if !ok {
@@ -27,6 +27,20 @@ func HashPB(h Hashable, ignore map[string]struct{}) uint64 {
 	return res
 }
 
+// HashPBs returns a hash of the messages in the given order.
+func HashPBs[T Hashable](hs []T, ignore map[string]struct{}) uint64 {
+	d := hashPool.Get().(*xxhash.Digest) //nolint:forcetypeassert
+	for _, h := range hs {
+		h.HashPB(d, ignore)
+	}
+	res := d.Sum64()
+
+	d.Reset()
+	hashPool.Put(d)
+
+	return res
+}
+
 func HashStr(s string) uint64 {
 	return xxhash.Sum64String(s)
 }